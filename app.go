@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/genaccounts"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -47,16 +48,21 @@ var (
 		params.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
+		crisis.AppModuleBasic{},
 
 		assetmanagement.AppModule{},
 	)
 
 	// account permissions
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:     nil,
-		distr.ModuleName:          nil,
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		auth.FeeCollectorName:           nil,
+		distr.ModuleName:                nil,
+		staking.BondedPoolName:          {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:       {supply.Burner, supply.Staking},
+		assetmanagement.ModuleName:      {supply.Minter, supply.Burner},
+		assetmanagement.FrozenPoolName:  nil,
+		assetmanagement.ClaimsPoolName:  nil,
+		assetmanagement.VestingPoolName: nil,
 	}
 )
 
@@ -73,6 +79,8 @@ type fantomAssetManagementApp struct {
 	*bam.BaseApp
 	cdc *codec.Codec
 
+	invCheckPeriod uint
+
 	// keys to access the substores
 	keys  map[string]*sdk.KVStoreKey
 	tkeys map[string]*sdk.TransientStoreKey
@@ -85,13 +93,14 @@ type fantomAssetManagementApp struct {
 	distrKeeper    distr.Keeper
 	supplyKeeper   supply.Keeper
 	paramsKeeper   params.Keeper
+	crisisKeeper   crisis.Keeper
 	amKeeper       assetmanagement.Keeper
 
 	// Module Manager
 	mm *module.Manager
 }
 
-func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseApp),
+func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp),
 ) *fantomAssetManagementApp {
 
 	// First define the top level codec that will be shared by the different modules
@@ -107,10 +116,11 @@ func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, baseAppOptions ..
 
 	// initialize application with the store keys it requires
 	var app = &fantomAssetManagementApp{
-		BaseApp: bApp,
-		cdc:     cdc,
-		keys:    keys,
-		tkeys:   tkeys,
+		BaseApp:        bApp,
+		cdc:            cdc,
+		invCheckPeriod: invCheckPeriod,
+		keys:           keys,
+		tkeys:          tkeys,
 	}
 
 	// The ParamsKeeper handles parameter storage for the application
@@ -121,6 +131,7 @@ func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, baseAppOptions ..
 	stakingSubspace := app.paramsKeeper.Subspace(staking.DefaultParamspace)
 	distrSubspace := app.paramsKeeper.Subspace(distr.DefaultParamspace)
 	slashingSubspace := app.paramsKeeper.Subspace(slashing.DefaultParamspace)
+	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
 
 	// The AccountKeeper handles address -> account lookups
 	app.accountKeeper = auth.NewAccountKeeper(
@@ -130,12 +141,17 @@ func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, baseAppOptions ..
 		auth.ProtoBaseAccount,
 	)
 
-	// The BankKeeper allows you perform sdk.Coins interactions
-	app.bankKeeper = bank.NewBaseKeeper(
+	// The BankKeeper allows you perform sdk.Coins interactions, it is wrapped so that the holders of the
+	// assetmanagement tokens follow every change of balance
+	app.bankKeeper = assetmanagement.NewBankKeeper(
+		bank.NewBaseKeeper(
+			app.accountKeeper,
+			bankSupspace,
+			bank.DefaultCodespace,
+			app.ModuleAccountAddrs(),
+		),
 		app.accountKeeper,
-		bankSupspace,
-		bank.DefaultCodespace,
-		app.ModuleAccountAddrs(),
+		keys[assetmanagement.StoreKey],
 	)
 
 	// The SupplyKeeper collects transaction fees and renders them to the fee distribution module
@@ -176,6 +192,9 @@ func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, baseAppOptions ..
 		slashing.DefaultCodespace,
 	)
 
+	// The CrisisKeeper halts the chain when a registered invariant is broken
+	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.stakingKeeper = *stakingKeeper.SetHooks(
@@ -186,27 +205,35 @@ func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, baseAppOptions ..
 
 	// The AssetManagementKeeper is the Keeper from the module for this tutorial
 	// It handles interactions with the namestore
-	app.amKeeper = assetmanagement.NewKeeper(
+	amKeeper := assetmanagement.NewKeeper(
 		app.accountKeeper,
 		app.bankKeeper,
+		app.supplyKeeper,
 		keys[assetmanagement.StoreKey],
 		app.cdc,
+		assetmanagement.DefaultCodespace,
+	)
+
+	// register the asset hooks, modules reacting to token lifecycle events add their hooks here
+	app.amKeeper = *amKeeper.SetHooks(
+		assetmanagement.NewMultiAssetHooks(),
 	)
 
 	app.mm = module.NewManager(
 		genaccounts.NewAppModule(app.accountKeeper),
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx),
 		auth.NewAppModule(app.accountKeeper),
-		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
+		assetmanagement.NewBankModule(bank.NewAppModule(app.bankKeeper, app.accountKeeper), app.amKeeper),
 		assetmanagement.NewAppModule(app.amKeeper, app.bankKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
 		distr.NewAppModule(app.distrKeeper, app.supplyKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper),
+		crisis.NewAppModule(&app.crisisKeeper),
 	)
 
 	app.mm.SetOrderBeginBlockers(distr.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, staking.ModuleName, assetmanagement.ModuleName)
 
 	// Sets the order of Genesis - Order matters, genutil is to always come last
 	// NOTE: The genutils moodule must occur after staking so that pools are
@@ -219,10 +246,13 @@ func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, baseAppOptions ..
 		bank.ModuleName,
 		slashing.ModuleName,
 		assetmanagement.ModuleName,
+		supply.ModuleName,
+		crisis.ModuleName,
 		genutil.ModuleName,
 	)
 
-	// register all module routes and module queriers
+	// register all module invariants with the crisis module, and all module routes and module queriers
+	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
 	// The initChainer handles translating the genesis.json file into initial state for the network
@@ -231,11 +261,15 @@ func NewFantomAssetManagementApp(logger log.Logger, db dbm.DB, baseAppOptions ..
 	app.SetEndBlocker(app.EndBlocker)
 
 	// The AnteHandler handles signature verification and transaction pre-processing
+	// and is wrapped to reject bank sends the transfer mode of a token doesn't allow
 	app.SetAnteHandler(
-		auth.NewAnteHandler(
-			app.accountKeeper,
-			app.supplyKeeper,
-			auth.DefaultSigVerificationGasConsumer,
+		assetmanagement.NewAnteHandler(
+			app.amKeeper,
+			auth.NewAnteHandler(
+				app.accountKeeper,
+				app.supplyKeeper,
+				auth.DefaultSigVerificationGasConsumer,
+			),
 		),
 	)
 
@@ -258,6 +292,23 @@ func NewDefaultGenesisState() GenesisState {
 	return ModuleBasics.DefaultGenesis()
 }
 
+// ValidateGenesis validates every module's genesis and then cross-checks the token records against the accounts
+func ValidateGenesis(cdc *codec.Codec, genesisState GenesisState) error {
+	if err := ModuleBasics.ValidateGenesis(genesisState); err != nil {
+		return err
+	}
+
+	var accounts genaccounts.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[genaccounts.ModuleName], &accounts); err != nil {
+		return err
+	}
+	var assets assetmanagement.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[assetmanagement.ModuleName], &assets); err != nil {
+		return err
+	}
+	return assetmanagement.ValidateGenesisAccounts(assets, accounts)
+}
+
 func (app *fantomAssetManagementApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
 
@@ -0,0 +1,331 @@
+package app
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement"
+)
+
+func genesisWithToken(t *testing.T, app *fantomAssetManagementApp, owner sdk.AccAddress) GenesisState {
+	token := assetmanagement.NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+
+	genesisState := NewDefaultGenesisState()
+	genesisState[genaccounts.ModuleName] = app.cdc.MustMarshalJSON(genaccounts.GenesisState{
+		genaccounts.NewGenesisAccountRaw(owner, token.TotalSupply, sdk.NewCoins(), 0, 0, ""),
+	})
+	genesisState[supply.ModuleName] = app.cdc.MustMarshalJSON(supply.NewGenesisState(token.TotalSupply))
+	genesisState[assetmanagement.ModuleName] = app.cdc.MustMarshalJSON(
+		assetmanagement.NewGenesisState([]assetmanagement.Token{*token}, nil))
+
+	require.NoError(t, ValidateGenesis(app.cdc, genesisState))
+	return genesisState
+}
+
+func initChain(t *testing.T, app *fantomAssetManagementApp, genesisState GenesisState) {
+	stateBytes, err := app.cdc.MarshalJSONIndent(genesisState, "", " ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+}
+
+// runBlock runs a block at the given time through the ABCI calls a node makes, with deliver standing in for its
+// transactions
+func runBlock(app *fantomAssetManagementApp, blockTime time.Time, deliver func(ctx sdk.Context)) {
+	header := abci.Header{Height: app.LastBlockHeight() + 1, Time: blockTime}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	if deliver != nil {
+		deliver(app.NewContext(false, header))
+	}
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+}
+
+func TestExportImportAppHash(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	appA := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	initChain(t, appA, genesisWithToken(t, appA, owner))
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := appA.NewContext(false, abci.Header{Time: now})
+	require.NoError(t, appA.amKeeper.FreezeCoins(ctx, owner, sdk.NewCoins(sdk.NewInt64Coin("tst123", 400))))
+
+	// a claim campaign with one of its two recipients paid
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	leaves := [][]byte{assetmanagement.ClaimLeaf(recipient, 50), assetmanagement.ClaimLeaf(owner, 50)}
+	root, proofs := assetmanagement.BuildClaimTree(leaves)
+	id, sdkErr := appA.amKeeper.CreateClaimCampaign(ctx, owner, "tst123", hex.EncodeToString(root), 100,
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.Nil(t, sdkErr)
+	require.Nil(t, appA.amKeeper.Claim(ctx, recipient, id, 50, proofs[0]))
+
+	// and a vesting grant locking part of the owner's balance
+	start := time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)
+	grantID, sdkErr := appA.amKeeper.CreateVestingGrant(ctx, owner, recipient, "tst123", 100, start, start,
+		start.Add(24*time.Hour))
+	require.Nil(t, sdkErr)
+
+	// and a paused emission schedule
+	emission := assetmanagement.NewEmissionSchedule("tst123", owner, 10, 1, 100, 0, sdk.ZeroDec(), 0)
+	require.Nil(t, appA.amKeeper.ScheduleEmission(ctx, owner, emission))
+	require.Nil(t, appA.amKeeper.SetEmissionPaused(ctx, owner, "tst123", true))
+
+	// and an allowance over part of the owner's balance
+	require.Nil(t, appA.amKeeper.Approve(ctx, owner, recipient, "tst123", 25, time.Time{}))
+
+	// and a transfer fee exempting the recipient
+	require.Nil(t, appA.amKeeper.ConfigureTransferFee(ctx, owner, "tst123", 25, 1, 0, owner))
+	require.Nil(t, appA.amKeeper.SetTransferFeeExemption(ctx, owner, "tst123", recipient, true))
+
+	// and a grant letting the recipient freeze the owner's coins
+	require.Nil(t, appA.amKeeper.Grant(ctx, owner, recipient, "tst123", "freeze_coins", 0, time.Time{}))
+
+	// and a council with an action waiting for approvals
+	h := assetmanagement.NewHandler(appA.amKeeper)
+	require.True(t, h(ctx, assetmanagement.NewMsgIssueToken(owner, "Council", "cnl123", "CNL", 100, true)).IsOK())
+	members := []sdk.AccAddress{owner, recipient}
+	require.Nil(t, appA.amKeeper.SetTokenCouncil(ctx, owner, "cnl123", members, 2))
+	action, sdkErr := appA.amKeeper.ProposeAction(ctx, owner, "cnl123",
+		assetmanagement.NewMsgMintCoins(10, "cnl123", assetmanagement.CouncilAddress("cnl123")), now.Add(time.Hour))
+	require.Nil(t, sdkErr)
+
+	// and an open conversion window
+	require.True(t, h(ctx, assetmanagement.NewMsgIssueToken(owner, "Old", "old123", "OLD", 100, false)).IsOK())
+	require.True(t, h(ctx, assetmanagement.NewMsgIssueToken(owner, "New", "new123", "NEW", 100, true)).IsOK())
+	require.Nil(t, appA.amKeeper.OpenConversion(ctx, owner, "old123", "new123", 2, 1,
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	// and a redenominated token, still paused
+	require.True(t, h(ctx, assetmanagement.NewMsgIssueToken(owner, "Redenominated", "red123", "RED", 100, false)).IsOK())
+	require.Nil(t, appA.amKeeper.SetTokenPaused(ctx, owner, "red123", true))
+	_, sdkErr = appA.amKeeper.Redenominate(ctx, owner, "red123", 10, 1)
+	require.Nil(t, sdkErr)
+
+	// and a retired token
+	require.True(t, h(ctx, assetmanagement.NewMsgIssueToken(owner, "Retired", "ret123", "RET", 100, false)).IsOK())
+	require.True(t, h(ctx, assetmanagement.NewMsgBurnCoins(100, "ret123", owner)).IsOK())
+	require.Nil(t, appA.amKeeper.SetTokenStatus(ctx, owner, "ret123", assetmanagement.TokenStatusRetired))
+
+	// and a rule change held back by a timelock
+	require.Nil(t, appA.amKeeper.SetTimelock(ctx, owner, "tst123", time.Hour))
+	res := h(ctx, assetmanagement.NewMsgSetHoldingLimits(owner, "tst123", 500, 0))
+	require.True(t, res.IsOK(), res.Log)
+	appA.Commit()
+
+	exported, _, err := appA.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	var genesisState GenesisState
+	require.NoError(t, appA.cdc.UnmarshalJSON(exported, &genesisState))
+	var accounts genaccounts.GenesisState
+	appA.cdc.MustUnmarshalJSON(genesisState[genaccounts.ModuleName], &accounts)
+	var assets assetmanagement.GenesisState
+	appA.cdc.MustUnmarshalJSON(genesisState[assetmanagement.ModuleName], &assets)
+	require.NoError(t, assetmanagement.ValidateGenesis(assets))
+	require.NoError(t, assetmanagement.ValidateGenesisAccounts(assets, accounts))
+
+	appB := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	initChain(t, appB, genesisState)
+	appB.Commit()
+
+	require.Equal(t, appA.LastCommitID().Hash, appB.LastCommitID().Hash)
+
+	ctx = appB.NewContext(true, abci.Header{})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("tst123", 400)), appB.amKeeper.GetFrozenCoins(ctx, owner))
+	require.Equal(t, sdk.NewInt(400), appB.accountKeeper.GetAccount(ctx, owner).GetCoins().AmountOf("tst123"))
+	require.True(t, appB.amKeeper.HasClaimed(ctx, id, recipient))
+	require.Equal(t, id+1, appB.amKeeper.GetNextCampaignID(ctx))
+	require.Len(t, appB.amKeeper.GetBeneficiaryVestingGrants(ctx, recipient), 1)
+	require.Equal(t, grantID+1, appB.amKeeper.GetNextVestingGrantID(ctx))
+	emission, sdkErr = appB.amKeeper.GetEmission(ctx, "tst123")
+	require.Nil(t, sdkErr)
+	require.True(t, emission.Paused)
+	allowance, sdkErr := appB.amKeeper.GetAllowance(ctx, owner, recipient, "tst123")
+	require.Nil(t, sdkErr)
+	require.Equal(t, sdk.NewInt(25), allowance.Amount)
+	fee, sdkErr := appB.amKeeper.GetTransferFee(ctx, "tst123")
+	require.Nil(t, sdkErr)
+	require.Equal(t, []sdk.AccAddress{recipient}, fee.Exempt)
+	_, sdkErr = appB.amKeeper.GetGrant(ctx, owner, recipient, "tst123", "freeze_coins")
+	require.Nil(t, sdkErr)
+	council, sdkErr := appB.amKeeper.GetCouncil(ctx, "cnl123")
+	require.Nil(t, sdkErr)
+	require.Equal(t, members, council.Members)
+	require.Len(t, appB.amKeeper.GetTokenPendingActions(ctx, "cnl123"), 1)
+	require.Equal(t, action.ID+1, appB.amKeeper.GetNextActionID(ctx))
+	window, sdkErr := appB.amKeeper.GetConversionWindow(ctx, "old123")
+	require.Nil(t, sdkErr)
+	require.Equal(t, "new123", window.Target)
+	require.Len(t, appB.amKeeper.GetTokenRedenominations(ctx, "red123"), 1)
+	token, sdkErr := appB.amKeeper.GetToken(ctx, "red123")
+	require.Nil(t, sdkErr)
+	require.True(t, token.Paused)
+	require.Equal(t, sdk.NewInt(1000), token.TotalSupply.AmountOf("red123"))
+	_, sdkErr = appB.amKeeper.GetArchivedToken(ctx, "ret123")
+	require.Nil(t, sdkErr)
+	require.False(t, appB.amKeeper.IsSymbolPresent(ctx, "ret123"))
+	queued := appB.amKeeper.GetTokenQueuedActions(ctx, "tst123")
+	require.Len(t, queued, 1)
+	require.Equal(t, queued[0].ID+1, appB.amKeeper.GetNextQueuedActionID(ctx))
+}
+
+func TestValidateGenesisSupplyMismatch(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	app := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	genesisState := genesisWithToken(t, app, owner)
+
+	genesisState[genaccounts.ModuleName] = app.cdc.MustMarshalJSON(genaccounts.GenesisState{
+		genaccounts.NewGenesisAccountRaw(owner, sdk.NewCoins(sdk.NewInt64Coin("tst123", 999)), sdk.NewCoins(),
+			0, 0, ""),
+	})
+	require.Error(t, ValidateGenesis(app.cdc, genesisState))
+}
+
+func TestEndBlockExpiresClaimCampaigns(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	app := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	initChain(t, app, genesisWithToken(t, app, owner))
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var id uint64
+	runBlock(app, now, func(ctx sdk.Context) {
+		root, _ := assetmanagement.BuildClaimTree([][]byte{assetmanagement.ClaimLeaf(owner, 100)})
+		var err sdk.Error
+		id, err = app.amKeeper.CreateClaimCampaign(ctx, owner, "tst123", hex.EncodeToString(root), 100,
+			now.Add(time.Hour))
+		require.Nil(t, err)
+	})
+	ctx := app.NewContext(true, abci.Header{})
+	require.Equal(t, int64(900), app.accountKeeper.GetAccount(ctx, owner).GetCoins().AmountOf("tst123").Int64())
+
+	runBlock(app, now.Add(59*time.Minute), nil)
+	_, err := app.amKeeper.GetClaimCampaign(app.NewContext(true, abci.Header{}), id)
+	require.Nil(t, err)
+
+	// the first block past the end time refunds the deposit
+	runBlock(app, now.Add(time.Hour), nil)
+	ctx = app.NewContext(true, abci.Header{})
+	_, err = app.amKeeper.GetClaimCampaign(ctx, id)
+	require.Equal(t, assetmanagement.CodeCampaignDoesNotExist, err.Code())
+	require.Equal(t, int64(1000), app.accountKeeper.GetAccount(ctx, owner).GetCoins().AmountOf("tst123").Int64())
+}
+
+func TestEndBlockProcessesEmissions(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	app := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	initChain(t, app, genesisWithToken(t, app, owner))
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// mints 10 coins in each of blocks 2 and 3
+	runBlock(app, now, func(ctx sdk.Context) {
+		emission := assetmanagement.NewEmissionSchedule("tst123", owner, 10, 2, 3, 0, sdk.ZeroDec(), 0)
+		require.Nil(t, app.amKeeper.ScheduleEmission(ctx, owner, emission))
+	})
+	for i := 1; i <= 3; i++ {
+		runBlock(app, now.Add(time.Duration(i)*time.Minute), nil)
+	}
+
+	ctx := app.NewContext(true, abci.Header{})
+	require.Equal(t, int64(1020), app.accountKeeper.GetAccount(ctx, owner).GetCoins().AmountOf("tst123").Int64())
+	supply, err := app.amKeeper.GetTotalSupply(ctx, "tst123")
+	require.Nil(t, err)
+	require.Equal(t, int64(1020), supply.AmountOf("tst123").Int64())
+	_, err = app.amKeeper.GetEmission(ctx, "tst123")
+	require.Equal(t, assetmanagement.CodeEmissionDoesNotExist, err.Code())
+}
+
+func TestEndBlockClosesConversions(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	app := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	initChain(t, app, genesisWithToken(t, app, owner))
+	h := assetmanagement.NewHandler(app.amKeeper)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runBlock(app, now, func(ctx sdk.Context) {
+		require.True(t, h(ctx, assetmanagement.NewMsgIssueToken(owner, "New", "new123", "NEW", 1000, true)).IsOK())
+		require.Nil(t, app.amKeeper.OpenConversion(ctx, owner, "tst123", "new123", 1, 1, now.Add(time.Hour)))
+	})
+	runBlock(app, now.Add(59*time.Minute), func(ctx sdk.Context) {
+		_, err := app.amKeeper.Convert(ctx, owner, "tst123", 100)
+		require.Nil(t, err)
+	})
+	window, err := app.amKeeper.GetConversionWindow(app.NewContext(true, abci.Header{}), "tst123")
+	require.Nil(t, err)
+	require.False(t, window.Closed)
+
+	// the first block past the deadline closes the window and disables the source token
+	runBlock(app, now.Add(time.Hour), nil)
+	ctx := app.NewContext(true, abci.Header{})
+	window, err = app.amKeeper.GetConversionWindow(ctx, "tst123")
+	require.Nil(t, err)
+	require.True(t, window.Closed)
+	token, err := app.amKeeper.GetToken(ctx, "tst123")
+	require.Nil(t, err)
+	require.True(t, token.Disabled)
+	require.Equal(t, int64(1100), app.accountKeeper.GetAccount(ctx, owner).GetCoins().AmountOf("new123").Int64())
+}
+
+func TestEndBlockRunsQueuedActions(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	app := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	initChain(t, app, genesisWithToken(t, app, owner))
+	h := assetmanagement.NewHandler(app.amKeeper)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runBlock(app, now, func(ctx sdk.Context) {
+		require.True(t, h(ctx, assetmanagement.NewMsgSetTimelock(owner, "tst123", time.Hour)).IsOK())
+		res := h(ctx, assetmanagement.NewMsgSetHoldingLimits(owner, "tst123", 500, 0))
+		require.True(t, res.IsOK(), res.Log)
+	})
+	runBlock(app, now.Add(59*time.Minute), nil)
+	token, err := app.amKeeper.GetToken(app.NewContext(true, abci.Header{}), "tst123")
+	require.Nil(t, err)
+	require.Equal(t, int64(0), token.MaxBalancePerAccount)
+
+	// the first block past the ETA applies the change
+	runBlock(app, now.Add(time.Hour), nil)
+	ctx := app.NewContext(true, abci.Header{})
+	token, err = app.amKeeper.GetToken(ctx, "tst123")
+	require.Nil(t, err)
+	require.Equal(t, int64(500), token.MaxBalancePerAccount)
+	require.Empty(t, app.amKeeper.GetTokenQueuedActions(ctx, "tst123"))
+}
+
+func TestEndBlockExpiresCouncilActions(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	members := []sdk.AccAddress{
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+	app := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	initChain(t, app, genesisWithToken(t, app, owner))
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var id uint64
+	runBlock(app, now, func(ctx sdk.Context) {
+		require.Nil(t, app.amKeeper.SetTokenCouncil(ctx, owner, "tst123", members, 2))
+		action, err := app.amKeeper.ProposeAction(ctx, members[0], "tst123",
+			assetmanagement.NewMsgMintCoins(10, "tst123", assetmanagement.CouncilAddress("tst123")), now.Add(time.Hour))
+		require.Nil(t, err)
+		id = action.ID
+	})
+	runBlock(app, now.Add(59*time.Minute), nil)
+	_, err := app.amKeeper.GetPendingAction(app.NewContext(true, abci.Header{}), id)
+	require.Nil(t, err)
+
+	// the first block past the expiry drops the action nobody else approved
+	runBlock(app, now.Add(time.Hour), nil)
+	_, err = app.amKeeper.GetPendingAction(app.NewContext(true, abci.Header{}), id)
+	require.Equal(t, assetmanagement.CodeActionDoesNotExist, err.Code())
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	app "github.com/dev10/fantom-asset-management"
+	"github.com/dev10/fantom-asset-management/x/assetmanagement"
+)
+
+const (
+	flagClientHome     = "home-client"
+	flagMintable       = "mintable"
+	flagOriginalSymbol = "original-symbol"
+)
+
+// validateGenesisCmd validates the genesis file like the genutil command does, and additionally cross-checks
+// the token records against the accounts section
+func validateGenesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "validates the genesis file at the default location or at the location passed as an arg",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+			// Load default if passed no args, otherwise load passed file
+			var genesis string
+			if len(args) == 0 {
+				genesis = ctx.Config.GenesisFile()
+			} else {
+				genesis = args[0]
+			}
+
+			fmt.Fprintf(os.Stderr, "validating genesis file at %s\n", genesis)
+
+			var genDoc *tmtypes.GenesisDoc
+			if genDoc, err = tmtypes.GenesisDocFromFile(genesis); err != nil {
+				return fmt.Errorf("error loading genesis doc from %s: %s", genesis, err.Error())
+			}
+
+			var genState app.GenesisState
+			if err = cdc.UnmarshalJSON(genDoc.AppState, &genState); err != nil {
+				return fmt.Errorf("error unmarshaling genesis doc %s: %s", genesis, err.Error())
+			}
+
+			if err = app.ValidateGenesis(cdc, genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
+			fmt.Printf("File at %s is a valid genesis file\n", genesis)
+			return nil
+		},
+	}
+}
+
+// addGenesisTokenCmd adds a token to the assetmanagement genesis section and credits its total supply to the owner
+func addGenesisTokenCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome, defaultClientHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-token [name] [symbol] [total_supply] [owner_address_or_key_name]",
+		Short: "Add a token to genesis.json, crediting its total supply to the owner's genesis account",
+		Long: `Add a token to genesis.json, crediting its total supply to the owner's genesis account.
+
+The symbol is stored the way issued symbols are, lowercase and without dashes, eg FTM-A1B becomes ftma1b.
+The original symbol defaults to the part of the symbol before any dash.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			name := args[0]
+			symbol := assetmanagement.NormalizeSymbol(args[1])
+			totalSupply, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid total supply %s: %s", args[2], err)
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				kb, err := keys.NewKeyBaseFromDir(viper.GetString(flagClientHome))
+				if err != nil {
+					return err
+				}
+
+				info, err := kb.Get(args[3])
+				if err != nil {
+					return err
+				}
+
+				owner = info.GetAddress()
+			}
+
+			originalSymbol := viper.GetString(flagOriginalSymbol)
+			if originalSymbol == "" {
+				originalSymbol = strings.ToUpper(strings.SplitN(args[1], "-", 2)[0])
+			}
+
+			msg := assetmanagement.NewMsgIssueToken(owner, name, symbol, originalSymbol, totalSupply,
+				viper.GetBool(flagMintable))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			token := assetmanagement.NewToken(msg.Name, msg.Symbol, msg.OriginalSymbol, msg.TotalSupply,
+				msg.SourceAddress, msg.Mintable)
+
+			// retrieve the app state
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			var assetGenesis assetmanagement.GenesisState
+			cdc.MustUnmarshalJSON(appState[assetmanagement.ModuleName], &assetGenesis)
+			assetGenesis.TokenRecords = append(assetGenesis.TokenRecords, *token)
+
+			// credit the owner, adding a genesis account when there isn't one yet
+			var genesisAccounts genaccounts.GenesisAccounts
+			cdc.MustUnmarshalJSON(appState[genaccounts.ModuleName], &genesisAccounts)
+			credited := false
+			for i, account := range genesisAccounts {
+				if account.Address.Equals(owner) {
+					genesisAccounts[i].Coins = account.Coins.Add(token.TotalSupply)
+					credited = true
+				}
+			}
+			if !credited {
+				genesisAccounts = append(genesisAccounts,
+					genaccounts.NewGenesisAccountRaw(owner, token.TotalSupply, sdk.NewCoins(), 0, 0, ""))
+			}
+
+			// an empty supply is computed from the accounts at genesis, anything else has to include the token
+			var supplyGenesis supply.GenesisState
+			cdc.MustUnmarshalJSON(appState[supply.ModuleName], &supplyGenesis)
+			if !supplyGenesis.Supply.Empty() {
+				supplyGenesis.Supply = supplyGenesis.Supply.Add(token.TotalSupply)
+			}
+
+			if err := assetmanagement.ValidateGenesis(assetGenesis); err != nil {
+				return err
+			}
+			if err := assetmanagement.ValidateGenesisAccounts(assetGenesis, genaccounts.GenesisState(genesisAccounts)); err != nil {
+				return err
+			}
+
+			appState[assetmanagement.ModuleName] = cdc.MustMarshalJSON(assetGenesis)
+			appState[genaccounts.ModuleName] = cdc.MustMarshalJSON(genaccounts.GenesisState(genesisAccounts))
+			appState[supply.ModuleName] = cdc.MustMarshalJSON(supplyGenesis)
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			// export app state
+			genDoc.AppState = appStateJSON
+
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+	cmd.Flags().Bool(flagMintable, false, "is the new token mintable")
+	cmd.Flags().String(flagOriginalSymbol, "", "the shorthand symbol, eg ABC, defaults to the symbol up to any dash")
+	return cmd
+}
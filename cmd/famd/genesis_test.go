@@ -0,0 +1,71 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	app "github.com/dev10/fantom-asset-management"
+	"github.com/dev10/fantom-asset-management/x/assetmanagement"
+)
+
+func TestAddGenesisToken(t *testing.T) {
+	home, err := ioutil.TempDir("", "famd")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+
+	cdc := app.MakeCodec()
+	ctx := server.NewDefaultContext()
+	ctx.Config.SetRoot(home)
+	genFile := ctx.Config.GenesisFile()
+	genDoc := tmtypes.GenesisDoc{ChainID: "fantomchain", AppState: cdc.MustMarshalJSON(app.NewDefaultGenesisState())}
+	require.NoError(t, genutil.ExportGenesisFile(&genDoc, genFile))
+
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set(cli.HomeFlag, home)
+	viper.Set(flagMintable, true)
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	cmd := addGenesisTokenCmd(ctx, cdc, home, home)
+	require.NoError(t, cmd.RunE(cmd, []string{"ABC Token", "ABC-123", "1000", owner.String()}))
+	require.NoError(t, cmd.RunE(cmd, []string{"XYZ Token", "XYZ-123", "50", owner.String()}))
+	// the symbol is taken
+	require.Error(t, cmd.RunE(cmd, []string{"ABC Token", "abc123", "1000", owner.String()}))
+
+	appState, _, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+	require.NoError(t, err)
+	require.NoError(t, app.ValidateGenesis(cdc, app.GenesisState(appState)))
+
+	var assets assetmanagement.GenesisState
+	cdc.MustUnmarshalJSON(appState[assetmanagement.ModuleName], &assets)
+	require.Len(t, assets.TokenRecords, 2)
+	token := assets.TokenRecords[0]
+	require.Equal(t, "abc123", token.Symbol)
+	require.Equal(t, "ABC", token.OriginalSymbol)
+	require.Equal(t, owner, token.Owner)
+	require.True(t, token.Mintable)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("abc123", 1000)), token.TotalSupply)
+
+	// both supplies are credited to the owner's single genesis account
+	var accounts genaccounts.GenesisState
+	cdc.MustUnmarshalJSON(appState[genaccounts.ModuleName], &accounts)
+	require.Len(t, accounts, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("abc123", 1000), sdk.NewInt64Coin("xyz123", 50)),
+		accounts[0].Coins)
+
+	validate := validateGenesisCmd(ctx, cdc)
+	require.NoError(t, validate.RunE(validate, []string{genFile}))
+}
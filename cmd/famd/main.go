@@ -21,6 +21,10 @@ import (
 	dbm "github.com/tendermint/tm-db"
 )
 
+const flagInvCheckPeriod = "inv-check-period"
+
+var invCheckPeriod uint
+
 func main() {
 	cobra.EnableCommandSorting = false
 
@@ -47,15 +51,20 @@ func main() {
 			ctx, cdc, app.ModuleBasics, staking.AppModuleBasic{},
 			genaccounts.AppModuleBasic{}, app.DefaultNodeHome, app.DefaultCLIHome,
 		),
-		genutilcli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics),
+		validateGenesisCmd(ctx, cdc),
+		migrateGenesisCmd(ctx, cdc),
 		// AddGenesisAccountCmd allows users to add accounts to the genesis file
 		genaccscli.AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
+		// addGenesisTokenCmd allows users to add tokens to the genesis file
+		addGenesisTokenCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
 	)
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "FAM", app.DefaultNodeHome)
+	rootCmd.PersistentFlags().UintVar(&invCheckPeriod, flagInvCheckPeriod,
+		0, "Assert registered invariants every N blocks")
 	err := executor.Execute()
 	if err != nil {
 		panic(err)
@@ -63,7 +72,7 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewFantomAssetManagementApp(logger, db)
+	return app.NewFantomAssetManagementApp(logger, db, invCheckPeriod)
 }
 
 func exportAppStateAndTMValidators(
@@ -71,7 +80,7 @@ func exportAppStateAndTMValidators(
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
 
 	if height != -1 {
-		famApp := app.NewFantomAssetManagementApp(logger, db)
+		famApp := app.NewFantomAssetManagementApp(logger, db, uint(1))
 		err := famApp.LoadHeight(height)
 		if err != nil {
 			return nil, nil, err
@@ -79,7 +88,7 @@ func exportAppStateAndTMValidators(
 		return famApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 	}
 
-	famApp := app.NewFantomAssetManagementApp(logger, db)
+	famApp := app.NewFantomAssetManagementApp(logger, db, uint(1))
 
 	return famApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	v1 "github.com/dev10/fantom-asset-management/x/assetmanagement/legacy/v1"
+)
+
+var migrationMap = genutil.MigrationMap{
+	"v1": v1.MigrateAppState,
+}
+
+const (
+	flagGenesisTime = "genesis-time"
+	flagChainID     = "chain-id"
+)
+
+// migrateGenesisCmd migrates an exported genesis to the given version of the app state
+func migrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [target-version] [genesis-file]",
+		Short: "Migrate genesis to a specified target version",
+		Long: `Migrate the source genesis into the target version and print to STDOUT.
+
+Example:
+$ famd migrate v1 /path/to/genesis.json --chain-id=fantomchain --genesis-time=2019-04-22T17:00:00Z
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target := args[0]
+			importGenesis := args[1]
+
+			genDoc, err := types.GenesisDocFromFile(importGenesis)
+			if err != nil {
+				return err
+			}
+
+			var initialState genutil.AppMap
+			if err := cdc.UnmarshalJSON(genDoc.AppState, &initialState); err != nil {
+				return err
+			}
+
+			if migrationMap[target] == nil {
+				return fmt.Errorf("unknown migration function version: %s", target)
+			}
+
+			newGenState := migrationMap[target](initialState)
+			genDoc.AppState = cdc.MustMarshalJSON(newGenState)
+
+			genesisTime := cmd.Flag(flagGenesisTime).Value.String()
+			if genesisTime != "" {
+				var t time.Time
+
+				err := t.UnmarshalText([]byte(genesisTime))
+				if err != nil {
+					return err
+				}
+
+				genDoc.GenesisTime = t
+			}
+
+			chainID := cmd.Flag(flagChainID).Value.String()
+			if chainID != "" {
+				genDoc.ChainID = chainID
+			}
+
+			out, err := cdc.MarshalJSONIndent(genDoc, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(sdk.MustSortJSON(out)))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesisTime, "", "Override genesis_time with this flag")
+	cmd.Flags().String(flagChainID, "", "Override chain_id with this flag")
+
+	return cmd
+}
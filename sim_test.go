@@ -0,0 +1,250 @@
+package app
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement"
+	assetsim "github.com/dev10/fantom-asset-management/x/assetmanagement/simulation"
+)
+
+// List of available flags for the simulator
+var (
+	seed      int64
+	numBlocks int
+	blockSize int
+	enabled   bool
+	verbose   bool
+	lean      bool
+	commit    bool
+	period    int
+)
+
+func init() {
+	flag.Int64Var(&seed, "Seed", 42, "simulation random seed")
+	flag.IntVar(&numBlocks, "NumBlocks", 200, "number of new blocks to simulate from the initial block height")
+	flag.IntVar(&blockSize, "BlockSize", 100, "operations per block")
+	flag.BoolVar(&enabled, "Enabled", false, "enable the simulation")
+	flag.BoolVar(&verbose, "Verbose", false, "verbose log output")
+	flag.BoolVar(&lean, "Lean", false, "lean simulation log output")
+	flag.BoolVar(&commit, "Commit", true, "have the simulation commit")
+	flag.IntVar(&period, "Period", 1, "run slow invariants only once every period assertions")
+}
+
+// Pass this in as an option to use a dbStoreAdapter instead of an IAVLStore for simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+func appStateFn(r *rand.Rand, accs []simulation.Account) (
+	appState json.RawMessage, simAccs []simulation.Account, chainID string, genesisTimestamp time.Time) {
+
+	cdc := MakeCodec()
+	appParams := make(simulation.AppParams)
+	genesisTimestamp = simulation.RandTimestamp(r)
+	genesisState := NewDefaultGenesisState()
+
+	amount := int64(r.Intn(1e12))
+	numInitiallyBonded := int64(r.Intn(250))
+	numAccs := int64(len(accs))
+	if numInitiallyBonded > numAccs {
+		numInitiallyBonded = numAccs
+	}
+
+	simapp.GenGenesisAccounts(cdc, r, accs, genesisTimestamp, amount, numInitiallyBonded, genesisState)
+	simapp.GenAuthGenesisState(cdc, r, appParams, genesisState)
+	simapp.GenBankGenesisState(cdc, r, appParams, genesisState)
+	simapp.GenSupplyGenesisState(cdc, amount, numInitiallyBonded, numAccs, genesisState)
+	simapp.GenDistrGenesisState(cdc, r, appParams, genesisState)
+	stakingGen := simapp.GenStakingGenesisState(cdc, r, accs, amount, numAccs, numInitiallyBonded, appParams, genesisState)
+	simapp.GenSlashingGenesisState(cdc, r, stakingGen, appParams, genesisState)
+	assetsim.RandomizedGenState(cdc, r, accs, genesisState)
+
+	appState, err := cdc.MarshalJSON(genesisState)
+	if err != nil {
+		panic(err)
+	}
+
+	return appState, accs, "simulation", genesisTimestamp
+}
+
+func testAndRunTxs(app *fantomAssetManagementApp) simulation.WeightedOperations {
+	appParams := make(simulation.AppParams)
+
+	ops := simulation.WeightedOperations{
+		{Weight: 100, Op: bank.SimulateMsgSend(app.accountKeeper, app.bankKeeper)},
+		{Weight: 10, Op: bank.SimulateSingleInputMsgMultiSend(app.accountKeeper, app.bankKeeper)},
+		{Weight: 100, Op: stakingsim.SimulateMsgCreateValidator(app.accountKeeper, app.stakingKeeper)},
+		{Weight: 100, Op: stakingsim.SimulateMsgDelegate(app.accountKeeper, app.stakingKeeper)},
+		{Weight: 100, Op: stakingsim.SimulateMsgUndelegate(app.accountKeeper, app.stakingKeeper)},
+	}
+	return append(ops, assetsim.WeightedOperations(appParams, app.cdc, app.amKeeper)...)
+}
+
+func invariants(app *fantomAssetManagementApp) []sdk.Invariant {
+	if period == 1 {
+		return app.crisisKeeper.Invariants()
+	}
+	return simulation.PeriodicInvariants(app.crisisKeeper.Invariants(), period, 0)
+}
+
+func runSimulation(tb testing.TB, app *fantomAssetManagementApp, simSeed int64, invs []sdk.Invariant) error {
+	_, _, err := simulation.SimulateFromSeed(
+		tb, os.Stdout, app.BaseApp, appStateFn, simSeed, testAndRunTxs(app),
+		invs, 1, numBlocks, 0, blockSize, "", false, commit, lean,
+		false, false, app.ModuleAccountAddrs(),
+	)
+	return err
+}
+
+func newSimLogger() log.Logger {
+	if verbose {
+		return log.TestingLogger()
+	}
+	return log.NewNopLogger()
+}
+
+// getSimulationLog decodes a mismatched pair of store entries for the stores this app mounts
+func getSimulationLog(storeName string, cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
+	if storeName == assetmanagement.StoreKey && (len(kvA.Value) != 0 || len(kvB.Value) != 0) {
+		return assetsim.DecodeStore(cdcA, cdcB, kvA, kvB)
+	}
+	return simapp.GetSimulationLog(storeName, cdcA, cdcB, kvA, kvB)
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	if !enabled {
+		t.Skip("Skipping application simulation")
+	}
+
+	dir, _ := ioutil.TempDir("", "goleveldb-app-sim")
+	db, _ := sdk.NewLevelDB("Simulation", dir)
+	defer func() {
+		db.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	app := NewFantomAssetManagementApp(newSimLogger(), db, 0, fauxMerkleModeOpt)
+	require.NoError(t, runSimulation(t, app, seed, invariants(app)))
+}
+
+func TestAppImportExport(t *testing.T) {
+	if !enabled {
+		t.Skip("Skipping application import/export simulation")
+	}
+
+	dir, _ := ioutil.TempDir("", "goleveldb-app-sim")
+	db, _ := sdk.NewLevelDB("Simulation", dir)
+	defer func() {
+		db.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	app := NewFantomAssetManagementApp(newSimLogger(), db, 0, fauxMerkleModeOpt)
+	require.NoError(t, runSimulation(t, app, seed, invariants(app)))
+
+	fmt.Printf("Exporting genesis...\n")
+	appState, _, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("Importing genesis...\n")
+	newDir, _ := ioutil.TempDir("", "goleveldb-app-sim-2")
+	newDB, _ := sdk.NewLevelDB("Simulation-2", newDir)
+	defer func() {
+		newDB.Close()
+		_ = os.RemoveAll(newDir)
+	}()
+
+	newApp := NewFantomAssetManagementApp(log.NewNopLogger(), newDB, 0, fauxMerkleModeOpt)
+
+	var genesisState GenesisState
+	require.NoError(t, app.cdc.UnmarshalJSON(appState, &genesisState))
+
+	ctxB := newApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	newApp.mm.InitGenesis(ctxB, genesisState)
+
+	fmt.Printf("Comparing stores...\n")
+	ctxA := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	storeKeysPrefixes := []struct {
+		A        sdk.StoreKey
+		B        sdk.StoreKey
+		Prefixes [][]byte
+	}{
+		{app.keys[baseapp.MainStoreKey], newApp.keys[baseapp.MainStoreKey], [][]byte{}},
+		{app.keys[auth.StoreKey], newApp.keys[auth.StoreKey], [][]byte{}},
+		{app.keys[staking.StoreKey], newApp.keys[staking.StoreKey],
+			[][]byte{
+				staking.UnbondingQueueKey, staking.RedelegationQueueKey, staking.ValidatorQueueKey,
+			}}, // ordering may change but it doesn't matter
+		{app.keys[slashing.StoreKey], newApp.keys[slashing.StoreKey], [][]byte{}},
+		{app.keys[distr.StoreKey], newApp.keys[distr.StoreKey], [][]byte{}},
+		{app.keys[supply.StoreKey], newApp.keys[supply.StoreKey], [][]byte{}},
+		{app.keys[params.StoreKey], newApp.keys[params.StoreKey], [][]byte{}},
+		{app.keys[assetmanagement.StoreKey], newApp.keys[assetmanagement.StoreKey], [][]byte{}},
+	}
+
+	for _, storeKeysPrefix := range storeKeysPrefixes {
+		storeA := ctxA.KVStore(storeKeysPrefix.A)
+		storeB := ctxB.KVStore(storeKeysPrefix.B)
+		kvA, kvB, count, equal := sdk.DiffKVStores(storeA, storeB, storeKeysPrefix.Prefixes)
+		fmt.Printf("Compared %d key/value pairs between %s and %s\n", count, storeKeysPrefix.A, storeKeysPrefix.B)
+		require.True(t, equal, getSimulationLog(storeKeysPrefix.A.Name(), app.cdc, newApp.cdc, kvA, kvB))
+	}
+}
+
+func TestAppStateDeterminism(t *testing.T) {
+	if !enabled {
+		t.Skip("Skipping application simulation")
+	}
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 5
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		simSeed := rand.Int63()
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			app := NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+
+			fmt.Printf(
+				"Running non-determinism simulation; seed: %d/%d (%d), attempt: %d/%d\n",
+				i+1, numSeeds, simSeed, j+1, numTimesToRunPerSeed,
+			)
+
+			require.NoError(t, runSimulation(t, app, simSeed, []sdk.Invariant{}))
+			appHashList[j] = app.LastCommitID().Hash
+		}
+
+		for k := 1; k < numTimesToRunPerSeed; k++ {
+			require.Equal(t, appHashList[0], appHashList[k], "appHash list: %v", appHashList)
+		}
+	}
+}
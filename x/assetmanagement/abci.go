@@ -0,0 +1,50 @@
+package assetmanagement
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker closes the conversion windows that have reached their deadline, mints the coins of the running emission
+// schedules, returns the deposits left in claim campaigns that have reached their end time to the owners, drops
+// the council actions that expired without enough approvals and runs the timelocked actions that are due
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.CloseConversions(ctx)
+	k.ProcessEmissions(ctx)
+	k.ExpireClaimCampaigns(ctx)
+	k.ExpireActions(ctx)
+	runQueuedActions(ctx, k)
+}
+
+// runQueuedActions runs the timelocked actions whose ETA has been reached. Each runs against its own cache, so one
+// that fails, eg because its token changed in the meantime, is dropped without changing anything
+func runQueuedActions(ctx sdk.Context, k Keeper) {
+	for _, action := range k.GetDueQueuedActions(ctx) {
+		// an action that ran before may have removed the next ones, eg by retiring their token
+		if _, err := k.GetQueuedAction(ctx, action.ID); err != nil {
+			continue
+		}
+		k.DeleteQueuedAction(ctx, action)
+
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		res := handleMsg(cacheCtx, k, action.Action)
+		result := "failed"
+		if res.IsOK() {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			result = "ok"
+		} else {
+			ctx.Logger().Info(fmt.Sprintf("queued action %d of %s failed: %s", action.ID, action.Symbol, res.Log))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeRunAction,
+			sdk.NewAttribute(AttributeKeyActionID, fmt.Sprintf("%d", action.ID)),
+			sdk.NewAttribute(AttributeKeySymbol, action.Symbol),
+			sdk.NewAttribute(AttributeKeyAction, action.Action.Type()),
+			sdk.NewAttribute(AttributeKeyResult, result),
+		))
+	}
+}
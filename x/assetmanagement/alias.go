@@ -6,42 +6,410 @@ import (
 )
 
 const (
-	ModuleName = types.ModuleName
-	RouterKey  = types.RouterKey
-	StoreKey   = types.StoreKey
+	ModuleName      = types.ModuleName
+	RouterKey       = types.RouterKey
+	StoreKey        = types.StoreKey
+	FrozenPoolName  = types.FrozenPoolName
+	ClaimsPoolName  = types.ClaimsPoolName
+	VestingPoolName = types.VestingPoolName
+
+	EventTypeApprove         = types.EventTypeApprove
+	EventTypeTransferFrom    = types.EventTypeTransferFrom
+	EventTypeClawback        = types.EventTypeClawback
+	EventTypeTransferFee     = types.EventTypeTransferFee
+	EventTypeHoldingLimits   = types.EventTypeHoldingLimits
+	EventTypeRedenominate    = types.EventTypeRedenominate
+	EventTypeOpenConversion  = types.EventTypeOpenConversion
+	EventTypeConvert         = types.EventTypeConvert
+	EventTypeCloseConversion = types.EventTypeCloseConversion
+	EventTypeTokenStatus     = types.EventTypeTokenStatus
+	EventTypeSetCouncil      = types.EventTypeSetCouncil
+	EventTypeProposeAction   = types.EventTypeProposeAction
+	EventTypeApproveAction   = types.EventTypeApproveAction
+	EventTypeRevokeApproval  = types.EventTypeRevokeApproval
+	EventTypeExecuteAction   = types.EventTypeExecuteAction
+	EventTypeExpireAction    = types.EventTypeExpireAction
+	EventTypeSetTimelock     = types.EventTypeSetTimelock
+	EventTypeQueueAction     = types.EventTypeQueueAction
+	EventTypeCancelAction    = types.EventTypeCancelAction
+	EventTypeRunAction       = types.EventTypeRunAction
+	EventTypeGrant           = types.EventTypeGrant
+	EventTypeRevokeGrant     = types.EventTypeRevokeGrant
+	EventTypeExec            = types.EventTypeExec
+	EventTypeOwnership       = types.EventTypeOwnership
+	EventTypeTokenMetadata   = types.EventTypeTokenMetadata
+	EventTypeTokenPaused     = types.EventTypeTokenPaused
+	AttributeKeyOwner        = types.AttributeKeyOwner
+	AttributeKeySpender      = types.AttributeKeySpender
+	AttributeKeySender       = types.AttributeKeySender
+	AttributeKeyRecipient    = types.AttributeKeyRecipient
+	AttributeKeySymbol       = types.AttributeKeySymbol
+	AttributeKeyExpiry       = types.AttributeKeyExpiry
+	AttributeKeyRemaining    = types.AttributeKeyRemaining
+	AttributeKeyAuthority    = types.AttributeKeyAuthority
+	AttributeKeyReason       = types.AttributeKeyReason
+	AttributeKeyClawback     = types.AttributeKeyClawback
+	AttributeKeyMaxBalance   = types.AttributeKeyMaxBalance
+	AttributeKeyMaxHolders   = types.AttributeKeyMaxHolders
+	AttributeKeyRatio        = types.AttributeKeyRatio
+	AttributeKeyDust         = types.AttributeKeyDust
+	AttributeKeyTarget       = types.AttributeKeyTarget
+	AttributeKeyDeadline     = types.AttributeKeyDeadline
+	AttributeKeyReceived     = types.AttributeKeyReceived
+	AttributeKeyStatus       = types.AttributeKeyStatus
+	AttributeKeyThreshold    = types.AttributeKeyThreshold
+	AttributeKeyActionID     = types.AttributeKeyActionID
+	AttributeKeyAction       = types.AttributeKeyAction
+	AttributeKeyApprovals    = types.AttributeKeyApprovals
+	AttributeKeyDelay        = types.AttributeKeyDelay
+	AttributeKeyETA          = types.AttributeKeyETA
+	AttributeKeyResult       = types.AttributeKeyResult
+	AttributeKeyGranter      = types.AttributeKeyGranter
+	AttributeKeyGrantee      = types.AttributeKeyGrantee
+	AttributeKeyMsgType      = types.AttributeKeyMsgType
+	AttributeKeySpendLimit   = types.AttributeKeySpendLimit
+	AttributeKeyNewOwner     = types.AttributeKeyNewOwner
+	AttributeKeyName         = types.AttributeKeyName
+	AttributeKeyPaused       = types.AttributeKeyPaused
+	AttributeValueCategory   = types.AttributeValueCategory
+
+	DefaultCodespace             = types.DefaultCodespace
+	CodeTokenSymbolDoesNotExist  = types.CodeTokenSymbolDoesNotExist
+	CodeTokenSymbolAlreadyExists = types.CodeTokenSymbolAlreadyExists
+	CodeInvalidSymbol            = types.CodeInvalidSymbol
+	CodeInvalidTokenName         = types.CodeInvalidTokenName
+	CodeInvalidAmount            = types.CodeInvalidAmount
+	CodeInvalidOwner             = types.CodeInvalidOwner
+	CodeTokenNotMintable         = types.CodeTokenNotMintable
+	CodeInsufficientCoins        = types.CodeInsufficientCoins
+	CodeInsufficientFrozenCoins  = types.CodeInsufficientFrozenCoins
+	CodeUnknownAccount           = types.CodeUnknownAccount
+	CodeAccountCannotFreeze      = types.CodeAccountCannotFreeze
+	CodeMissingOwner             = types.CodeMissingOwner
+	CodeInvalidToken             = types.CodeInvalidToken
+	CodeInvalidRecipients        = types.CodeInvalidRecipients
+	CodeInvalidCampaign          = types.CodeInvalidCampaign
+	CodeCampaignDoesNotExist     = types.CodeCampaignDoesNotExist
+	CodeCampaignExpired          = types.CodeCampaignExpired
+	CodeInvalidClaimProof        = types.CodeInvalidClaimProof
+	CodeAlreadyClaimed           = types.CodeAlreadyClaimed
+	CodeInvalidVestingGrant      = types.CodeInvalidVestingGrant
+	CodeVestingGrantDoesNotExist = types.CodeVestingGrantDoesNotExist
+	CodeNotBeneficiary           = types.CodeNotBeneficiary
+	CodeNothingVested            = types.CodeNothingVested
+	CodeInvalidEmission          = types.CodeInvalidEmission
+	CodeEmissionDoesNotExist     = types.CodeEmissionDoesNotExist
+	CodeInvalidAllowance         = types.CodeInvalidAllowance
+	CodeAllowanceDoesNotExist    = types.CodeAllowanceDoesNotExist
+	CodeAllowanceExpired         = types.CodeAllowanceExpired
+	CodeInsufficientAllowance    = types.CodeInsufficientAllowance
+	CodeTokenNotClawbackable     = types.CodeTokenNotClawbackable
+	CodeInvalidClawback          = types.CodeInvalidClawback
+	CodeNotClawbackAuthority     = types.CodeNotClawbackAuthority
+	CodeInvalidTransferFee       = types.CodeInvalidTransferFee
+	CodeTransferFeeDoesNotExist  = types.CodeTransferFeeDoesNotExist
+	CodeTransferNotAllowed       = types.CodeTransferNotAllowed
+	CodeInvalidHoldingLimits     = types.CodeInvalidHoldingLimits
+	CodeMaxBalanceExceeded       = types.CodeMaxBalanceExceeded
+	CodeMaxHoldersReached        = types.CodeMaxHoldersReached
+	CodeInvalidRedenomination    = types.CodeInvalidRedenomination
+	CodeInvalidConversion        = types.CodeInvalidConversion
+	CodeConversionDoesNotExist   = types.CodeConversionDoesNotExist
+	CodeTokenDisabled            = types.CodeTokenDisabled
+	CodeInvalidTokenStatus       = types.CodeInvalidTokenStatus
+	CodeTokenDeprecated          = types.CodeTokenDeprecated
+	CodeInvalidCouncil           = types.CodeInvalidCouncil
+	CodeCouncilDoesNotExist      = types.CodeCouncilDoesNotExist
+	CodeNotCouncilMember         = types.CodeNotCouncilMember
+	CodeInvalidAction            = types.CodeInvalidAction
+	CodeActionDoesNotExist       = types.CodeActionDoesNotExist
+	CodeInvalidTimelock          = types.CodeInvalidTimelock
+	CodeQueuedActionDoesNotExist = types.CodeQueuedActionDoesNotExist
+	CodeInvalidGrant             = types.CodeInvalidGrant
+	CodeGrantDoesNotExist        = types.CodeGrantDoesNotExist
+	CodeGrantExpired             = types.CodeGrantExpired
+	CodeInsufficientGrant        = types.CodeInsufficientGrant
+	CodeTokenPaused              = types.CodeTokenPaused
+
+	MaxDistributeRecipients  = types.MaxDistributeRecipients
+	MaxClawbackReasonLength  = types.MaxClawbackReasonLength
+	MaxTransferFeeRate       = types.MaxTransferFeeRate
+	MaxTransferFeeExemptions = types.MaxTransferFeeExemptions
+	MaxRedenominationFactor  = types.MaxRedenominationFactor
+	MaxConversionFactor      = types.MaxConversionFactor
+	MaxCouncilMembers        = types.MaxCouncilMembers
+	MaxActionDuration        = types.MaxActionDuration
+	MaxTimelockDelay         = types.MaxTimelockDelay
+	MaxExecMsgs              = types.MaxExecMsgs
+
+	TransferModeFree            = types.TransferModeFree
+	TransferModeNonTransferable = types.TransferModeNonTransferable
+	TransferModeOwnerOnly       = types.TransferModeOwnerOnly
+
+	TokenStatusActive     = types.TokenStatusActive
+	TokenStatusDeprecated = types.TokenStatusDeprecated
+	TokenStatusRetired    = types.TokenStatusRetired
 )
 
 var (
-	NewKeeper  = keeper.NewKeeper
-	NewQuerier = keeper.NewQuerier
+	// keys
+	CampaignKeyPrefix        = types.CampaignKeyPrefix
+	ClaimedKeyPrefix         = types.ClaimedKeyPrefix
+	NextCampaignIDKey        = types.NextCampaignIDKey
+	CampaignQueueKeyPrefix   = types.CampaignQueueKeyPrefix
+	VestingGrantKeyPrefix    = types.VestingGrantKeyPrefix
+	TokenVestingKeyPrefix    = types.TokenVestingKeyPrefix
+	BeneficiaryKeyPrefix     = types.BeneficiaryKeyPrefix
+	NextVestingGrantIDKey    = types.NextVestingGrantIDKey
+	EmissionKeyPrefix        = types.EmissionKeyPrefix
+	AllowanceKeyPrefix       = types.AllowanceKeyPrefix
+	SpenderKeyPrefix         = types.SpenderKeyPrefix
+	ClawbackKeyPrefix        = types.ClawbackKeyPrefix
+	NextClawbackIDKey        = types.NextClawbackIDKey
+	TransferFeeKeyPrefix     = types.TransferFeeKeyPrefix
+	HolderKeyPrefix          = types.HolderKeyPrefix
+	HolderCountKeyPrefix     = types.HolderCountKeyPrefix
+	RedenominationKeyPrefix  = types.RedenominationKeyPrefix
+	ConversionKeyPrefix      = types.ConversionKeyPrefix
+	ConversionQueueKeyPrefix = types.ConversionQueueKeyPrefix
+	ArchivedTokenKeyPrefix   = types.ArchivedTokenKeyPrefix
+	CouncilKeyPrefix         = types.CouncilKeyPrefix
+	ActionKeyPrefix          = types.ActionKeyPrefix
+	NextActionIDKey          = types.NextActionIDKey
+	ActionQueueKeyPrefix     = types.ActionQueueKeyPrefix
+	QueuedActionKeyPrefix    = types.QueuedActionKeyPrefix
+	NextQueuedActionIDKey    = types.NextQueuedActionIDKey
+	TimelockQueueKeyPrefix   = types.TimelockQueueKeyPrefix
+	GrantKeyPrefix           = types.GrantKeyPrefix
+	GranteeKeyPrefix         = types.GranteeKeyPrefix
+
+	NewKeeper     = keeper.NewKeeper
+	NewBankKeeper = keeper.NewBankKeeper
+	NewQuerier    = keeper.NewQuerier
+
+	// invariants
+	RegisterInvariants      = keeper.RegisterInvariants
+	AllInvariants           = keeper.AllInvariants
+	TokenSupplyInvariant    = keeper.TokenSupplyInvariant
+	FrozenCoinsInvariant    = keeper.FrozenCoinsInvariant
+	TokenRecordsInvariant   = keeper.TokenRecordsInvariant
+	ClaimCampaignsInvariant = keeper.ClaimCampaignsInvariant
+	VestingGrantsInvariant  = keeper.VestingGrantsInvariant
+	TokenHoldersInvariant   = keeper.TokenHoldersInvariant
+
+	// errors
+	ErrTokenSymbolDoesNotExist  = types.ErrTokenSymbolDoesNotExist
+	ErrTokenSymbolAlreadyExists = types.ErrTokenSymbolAlreadyExists
+	ErrInvalidSymbol            = types.ErrInvalidSymbol
+	ErrInvalidTokenName         = types.ErrInvalidTokenName
+	ErrInvalidAmount            = types.ErrInvalidAmount
+	ErrInvalidOwner             = types.ErrInvalidOwner
+	ErrTokenNotMintable         = types.ErrTokenNotMintable
+	ErrInsufficientCoins        = types.ErrInsufficientCoins
+	ErrInsufficientFrozenCoins  = types.ErrInsufficientFrozenCoins
+	ErrUnknownAccount           = types.ErrUnknownAccount
+	ErrAccountCannotFreeze      = types.ErrAccountCannotFreeze
+	ErrMissingOwner             = types.ErrMissingOwner
+	ErrInvalidToken             = types.ErrInvalidToken
+	ErrInvalidRecipients        = types.ErrInvalidRecipients
+	ErrInvalidCampaign          = types.ErrInvalidCampaign
+	ErrCampaignDoesNotExist     = types.ErrCampaignDoesNotExist
+	ErrCampaignExpired          = types.ErrCampaignExpired
+	ErrInvalidClaimProof        = types.ErrInvalidClaimProof
+	ErrAlreadyClaimed           = types.ErrAlreadyClaimed
+	ErrInvalidVestingGrant      = types.ErrInvalidVestingGrant
+	ErrVestingGrantDoesNotExist = types.ErrVestingGrantDoesNotExist
+	ErrNotBeneficiary           = types.ErrNotBeneficiary
+	ErrNothingVested            = types.ErrNothingVested
+	ErrInvalidEmission          = types.ErrInvalidEmission
+	ErrEmissionDoesNotExist     = types.ErrEmissionDoesNotExist
+	ErrInvalidAllowance         = types.ErrInvalidAllowance
+	ErrAllowanceDoesNotExist    = types.ErrAllowanceDoesNotExist
+	ErrAllowanceExpired         = types.ErrAllowanceExpired
+	ErrInsufficientAllowance    = types.ErrInsufficientAllowance
+	ErrTokenNotClawbackable     = types.ErrTokenNotClawbackable
+	ErrInvalidClawback          = types.ErrInvalidClawback
+	ErrNotClawbackAuthority     = types.ErrNotClawbackAuthority
+	ErrInvalidTransferFee       = types.ErrInvalidTransferFee
+	ErrTransferFeeDoesNotExist  = types.ErrTransferFeeDoesNotExist
+	ErrTransferNotAllowed       = types.ErrTransferNotAllowed
+	ErrInvalidHoldingLimits     = types.ErrInvalidHoldingLimits
+	ErrMaxBalanceExceeded       = types.ErrMaxBalanceExceeded
+	ErrMaxHoldersReached        = types.ErrMaxHoldersReached
+	ErrInvalidRedenomination    = types.ErrInvalidRedenomination
+	ErrInvalidConversion        = types.ErrInvalidConversion
+	ErrConversionDoesNotExist   = types.ErrConversionDoesNotExist
+	ErrTokenDisabled            = types.ErrTokenDisabled
+	ErrInvalidTokenStatus       = types.ErrInvalidTokenStatus
+	ErrTokenDeprecated          = types.ErrTokenDeprecated
+	ErrInvalidCouncil           = types.ErrInvalidCouncil
+	ErrCouncilDoesNotExist      = types.ErrCouncilDoesNotExist
+	ErrNotCouncilMember         = types.ErrNotCouncilMember
+	ErrInvalidAction            = types.ErrInvalidAction
+	ErrActionDoesNotExist       = types.ErrActionDoesNotExist
+	ErrInvalidTimelock          = types.ErrInvalidTimelock
+	ErrQueuedActionDoesNotExist = types.ErrQueuedActionDoesNotExist
+	ErrInvalidGrant             = types.ErrInvalidGrant
+	ErrGrantDoesNotExist        = types.ErrGrantDoesNotExist
+	ErrGrantExpired             = types.ErrGrantExpired
+	ErrInsufficientGrant        = types.ErrInsufficientGrant
+	ErrTokenPaused              = types.ErrTokenPaused
 
 	// messages
-	NewMsgBurnCoins     = types.NewMsgBurnCoins
-	NewMsgFreezeCoins   = types.NewMsgFreezeCoins
-	NewMsgIssueToken    = types.NewMsgIssueToken
-	NewMsgMintCoins     = types.NewMsgMintCoins
-	NewMsgUnfreezeCoins = types.NewMsgUnfreezeCoins
+	NewMsgApprove                 = types.NewMsgApprove
+	NewMsgBurnCoins               = types.NewMsgBurnCoins
+	NewMsgClaim                   = types.NewMsgClaim
+	NewMsgClaimVested             = types.NewMsgClaimVested
+	NewMsgClawback                = types.NewMsgClawback
+	NewMsgCreateClaimCampaign     = types.NewMsgCreateClaimCampaign
+	NewMsgCreateVestingGrant      = types.NewMsgCreateVestingGrant
+	NewMsgDistribute              = types.NewMsgDistribute
+	NewMsgFreezeCoins             = types.NewMsgFreezeCoins
+	NewMsgIssueToken              = types.NewMsgIssueToken
+	NewMsgMintCoins               = types.NewMsgMintCoins
+	NewMsgRedenominate            = types.NewMsgRedenominate
+	NewMsgOpenConversion          = types.NewMsgOpenConversion
+	NewMsgConvert                 = types.NewMsgConvert
+	NewMsgSetTokenStatus          = types.NewMsgSetTokenStatus
+	NewMsgSetCouncil              = types.NewMsgSetCouncil
+	NewMsgProposeAction           = types.NewMsgProposeAction
+	NewMsgApproveAction           = types.NewMsgApproveAction
+	NewMsgRevokeApproval          = types.NewMsgRevokeApproval
+	NewMsgSetTimelock             = types.NewMsgSetTimelock
+	NewMsgCancelQueuedAction      = types.NewMsgCancelQueuedAction
+	NewMsgGrant                   = types.NewMsgGrant
+	NewMsgRevokeGrant             = types.NewMsgRevokeGrant
+	NewMsgExec                    = types.NewMsgExec
+	NewMsgTransferOwnership       = types.NewMsgTransferOwnership
+	NewMsgSetTokenMetadata        = types.NewMsgSetTokenMetadata
+	NewMsgSetTokenPaused          = types.NewMsgSetTokenPaused
+	NewMsgSetClawbackAdmin        = types.NewMsgSetClawbackAdmin
+	NewMsgSetEmission             = types.NewMsgSetEmission
+	NewMsgSetEmissionPaused       = types.NewMsgSetEmissionPaused
+	NewMsgSetTransferFee          = types.NewMsgSetTransferFee
+	NewMsgSetTransferFeeExemption = types.NewMsgSetTransferFeeExemption
+	NewMsgSetHoldingLimits        = types.NewMsgSetHoldingLimits
+	NewMsgTransferFrom            = types.NewMsgTransferFrom
+	NewMsgUnfreezeCoins           = types.NewMsgUnfreezeCoins
 
-	NewToken = types.NewToken
+	NewToken                    = types.NewToken
+	NewMultiAssetHooks          = types.NewMultiAssetHooks
+	NewRecipient                = types.NewRecipient
+	NewClaimCampaign            = types.NewClaimCampaign
+	ClaimLeaf                   = types.ClaimLeaf
+	BuildClaimTree              = types.BuildClaimTree
+	VerifyClaimProof            = types.VerifyClaimProof
+	ValidateMerkleRoot          = types.ValidateMerkleRoot
+	NewVestingGrant             = types.NewVestingGrant
+	ValidateVestingSchedule     = types.ValidateVestingSchedule
+	NewEmissionSchedule         = types.NewEmissionSchedule
+	ValidateEmission            = types.ValidateEmission
+	NewAllowance                = types.NewAllowance
+	ValidateClawbackReason      = types.ValidateClawbackReason
+	NewTransferFee              = types.NewTransferFee
+	ValidateTransferFee         = types.ValidateTransferFee
+	TransferModeFromString      = types.TransferModeFromString
+	ValidateHoldingLimits       = types.ValidateHoldingLimits
+	ValidateRedenominationRatio = types.ValidateRedenominationRatio
+	ScaleAmount                 = types.ScaleAmount
+	NewConversionWindow         = types.NewConversionWindow
+	ValidateConversionRatio     = types.ValidateConversionRatio
+	TokenStatusFromString       = types.TokenStatusFromString
+	CouncilAddress              = types.CouncilAddress
+	NewCouncil                  = types.NewCouncil
+	NewPendingAction            = types.NewPendingAction
+	ValidateAction              = types.ValidateAction
+	ValidateTimelockDelay       = types.ValidateTimelockDelay
+	TimelockedSymbol            = types.TimelockedSymbol
+	NewQueuedAction             = types.NewQueuedAction
+	GrantableMsgTypes           = types.GrantableMsgTypes
+	GrantedMsg                  = types.GrantedMsg
+	NewGrant                    = types.NewGrant
+	NormalizeSymbol             = types.NormalizeSymbol
+	ValidateSymbol              = types.ValidateSymbol
 
 	ModuleCdc     = types.ModuleCdc
 	RegisterCodec = types.RegisterCodec
 )
 
 type (
-	Keeper = keeper.Keeper
+	Keeper          = keeper.Keeper
+	BankKeeper      = keeper.BankKeeper
+	AssetHooks      = types.AssetHooks
+	MultiAssetHooks = types.MultiAssetHooks
 
 	// messages
-	MsgBurnCoins     = types.MsgBurnCoins
-	MsgFreezeCoins   = types.MsgFreezeCoins
-	MsgIssueToken    = types.MsgIssueToken
-	MsgMintCoins     = types.MsgMintCoins
-	MsgUnfreezeCoins = types.MsgUnfreezeCoins
+	MsgApprove                 = types.MsgApprove
+	MsgBurnCoins               = types.MsgBurnCoins
+	MsgClaim                   = types.MsgClaim
+	MsgClaimVested             = types.MsgClaimVested
+	MsgClawback                = types.MsgClawback
+	MsgConvert                 = types.MsgConvert
+	MsgCreateClaimCampaign     = types.MsgCreateClaimCampaign
+	MsgCreateVestingGrant      = types.MsgCreateVestingGrant
+	MsgDistribute              = types.MsgDistribute
+	MsgFreezeCoins             = types.MsgFreezeCoins
+	MsgIssueToken              = types.MsgIssueToken
+	MsgMintCoins               = types.MsgMintCoins
+	MsgOpenConversion          = types.MsgOpenConversion
+	MsgRedenominate            = types.MsgRedenominate
+	MsgSetClawbackAdmin        = types.MsgSetClawbackAdmin
+	MsgSetEmission             = types.MsgSetEmission
+	MsgSetEmissionPaused       = types.MsgSetEmissionPaused
+	MsgSetTransferFee          = types.MsgSetTransferFee
+	MsgSetTransferFeeExemption = types.MsgSetTransferFeeExemption
+	MsgSetHoldingLimits        = types.MsgSetHoldingLimits
+	MsgSetTokenStatus          = types.MsgSetTokenStatus
+	MsgSetCouncil              = types.MsgSetCouncil
+	MsgProposeAction           = types.MsgProposeAction
+	MsgApproveAction           = types.MsgApproveAction
+	MsgRevokeApproval          = types.MsgRevokeApproval
+	MsgSetTimelock             = types.MsgSetTimelock
+	MsgCancelQueuedAction      = types.MsgCancelQueuedAction
+	MsgGrant                   = types.MsgGrant
+	MsgRevokeGrant             = types.MsgRevokeGrant
+	MsgExec                    = types.MsgExec
+	MsgTransferOwnership       = types.MsgTransferOwnership
+	MsgSetTokenMetadata        = types.MsgSetTokenMetadata
+	MsgSetTokenPaused          = types.MsgSetTokenPaused
+	MsgTransferFrom            = types.MsgTransferFrom
+	MsgUnfreezeCoins           = types.MsgUnfreezeCoins
 
 	// queries
-	QueryResultSymbol = types.QueryResultSymbol
+	QueryResultSymbol          = types.QueryResultSymbol
+	QueryResultAccount         = types.QueryResultAccount
+	QueryResultCampaigns       = types.QueryResultCampaigns
+	QueryResultVestingGrants   = types.QueryResultVestingGrants
+	QueryResultEmissions       = types.QueryResultEmissions
+	QueryResultAllowances      = types.QueryResultAllowances
+	QueryResultClawbacks       = types.QueryResultClawbacks
+	QueryResultHolders         = types.QueryResultHolders
+	QueryResultHolding         = types.QueryResultHolding
+	QueryResultRedenominations = types.QueryResultRedenominations
+	QueryResultConversions     = types.QueryResultConversions
+	QueryResultTokenStatus     = types.QueryResultTokenStatus
+	QueryResultPendingActions  = types.QueryResultPendingActions
+	QueryResultQueuedActions   = types.QueryResultQueuedActions
+	QueryResultGrants          = types.QueryResultGrants
 
 	// state/stored types
-	CustomAccount = types.CustomAccount
-	Token         = types.Token
+	CustomAccount    = types.CustomAccount
+	Token            = types.Token
+	Recipient        = types.Recipient
+	ClaimCampaign    = types.ClaimCampaign
+	ClaimRecord      = types.ClaimRecord
+	VestingGrant     = types.VestingGrant
+	EmissionSchedule = types.EmissionSchedule
+	Allowance        = types.Allowance
+	ClawbackRecord   = types.ClawbackRecord
+	TransferFee      = types.TransferFee
+	TransferMode     = types.TransferMode
+	Redenomination   = types.Redenomination
+	ConversionWindow = types.ConversionWindow
+	TokenStatus      = types.TokenStatus
+	ArchivedToken    = types.ArchivedToken
+	Council          = types.Council
+	PendingAction    = types.PendingAction
+	QueuedAction     = types.QueuedAction
+	Grant            = types.Grant
 )
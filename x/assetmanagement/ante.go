@@ -0,0 +1,54 @@
+package assetmanagement
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// NewAnteHandler wraps the app's ante handler so that bank sends of the module's tokens are rejected before they
+// run when the token's transfer mode doesn't allow them. The module's own messages check the mode in the keeper
+func NewAnteHandler(k Keeper, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		newCtx, res, abort := next(ctx, tx, simulate)
+		if abort {
+			return newCtx, res, abort
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			if err := checkBankTransferMode(newCtx, k, msg); err != nil {
+				return newCtx, err.Result(), true
+			}
+		}
+		return newCtx, res, abort
+	}
+}
+
+func checkBankTransferMode(ctx sdk.Context, k Keeper, msg sdk.Msg) sdk.Error {
+	switch msg := msg.(type) {
+	case bank.MsgSend:
+		return k.CheckTransferMode(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+	case bank.MsgMultiSend:
+		// the coins of the inputs are pooled, so every input holding a token must be allowed to send it to every
+		// output receiving it
+		for _, input := range msg.Inputs {
+			for _, output := range msg.Outputs {
+				coins := sharedCoins(input.Coins, output.Coins)
+				if err := k.CheckTransferMode(ctx, input.Address, output.Address, coins); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// sharedCoins returns the coins of a whose denomination is also found in b
+func sharedCoins(a, b sdk.Coins) sdk.Coins {
+	var shared sdk.Coins
+	for _, coin := range a {
+		if b.AmountOf(coin.Denom).IsPositive() {
+			shared = append(shared, coin)
+		}
+	}
+	return shared
+}
@@ -0,0 +1,72 @@
+package assetmanagement
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/keeper"
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestAnteHandlerTransferMode(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+
+	issue := NewMsgIssueToken(owner, "Badge", "bdg123", "BDG", 1000, false)
+	issue.TransferMode = TransferModeNonTransferable
+	require.True(t, h(ctx, issue).IsOK())
+	token, err := k.GetToken(ctx, "bdg123")
+	require.Nil(t, err)
+	require.Equal(t, TransferModeNonTransferable, token.TransferMode)
+
+	passThrough := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		return ctx, sdk.Result{}, false
+	}
+	ante := NewAnteHandler(k, passThrough)
+	run := func(msgs ...sdk.Msg) (sdk.Result, bool) {
+		_, res, abort := ante(ctx, auth.StdTx{Msgs: msgs}, false)
+		return res, abort
+	}
+	send := func(from, to sdk.AccAddress) bank.MsgSend {
+		return bank.MsgSend{FromAddress: from, ToAddress: to, Amount: types.NewTestCoins("bdg123", 10)}
+	}
+
+	_, abort := run(send(owner, alice))
+	require.False(t, abort)
+	res, abort := run(send(owner, alice), send(alice, bob))
+	require.True(t, abort)
+	require.Equal(t, CodeTransferNotAllowed, res.Code)
+
+	res, abort = run(bank.MsgMultiSend{
+		Inputs: []bank.Input{
+			bank.NewInput(owner, types.NewTestCoins("bdg123", 10)),
+			bank.NewInput(alice, types.NewTestCoins("stake", 10)),
+		},
+		Outputs: []bank.Output{
+			bank.NewOutput(bob, types.NewTestCoins("bdg123", 10)),
+			bank.NewOutput(owner, types.NewTestCoins("stake", 10)),
+		},
+	})
+	require.False(t, abort, res.Log)
+	_, abort = run(bank.MsgMultiSend{
+		Inputs:  []bank.Input{bank.NewInput(alice, types.NewTestCoins("bdg123", 10))},
+		Outputs: []bank.Output{bank.NewOutput(owner, types.NewTestCoins("bdg123", 10))},
+	})
+	require.True(t, abort)
+
+	// the wrapped handler aborting takes precedence
+	failing := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		return ctx, sdk.ErrUnauthorized("no").Result(), true
+	}
+	_, res, abort = NewAnteHandler(k, failing)(ctx, auth.StdTx{Msgs: []sdk.Msg{send(owner, alice)}}, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+}
@@ -0,0 +1,79 @@
+package assetmanagement
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+var _ module.AppModule = BankModule{}
+
+// BankModule is the bank module with its handler wrapped by NewBankHandler, the app registers it in place of the
+// plain bank module so that sends of the module's tokens follow the token's transfer rules
+type BankModule struct {
+	bank.AppModule
+	keeper Keeper
+}
+
+// NewBankModule wraps the bank module
+func NewBankModule(bankModule bank.AppModule, k Keeper) BankModule {
+	return BankModule{
+		AppModule: bankModule,
+		keeper:    k,
+	}
+}
+
+func (am BankModule) NewHandler() sdk.Handler {
+	return NewBankHandler(am.keeper, am.AppModule.NewHandler())
+}
+
+// NewBankHandler wraps the handler of the bank module so that sends stay within the holding limits of the tokens
+// and the recipients pay the transfer fees due. The fees are taken in the same transaction as the send, if they
+// can't be paid the send fails as a whole. The sender of a multi send with several inputs can't be told apart, so
+// only the recipient's exemption counts there
+func NewBankHandler(k Keeper, bankHandler sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		if err := checkBankHoldingLimits(ctx, k, msg); err != nil {
+			return err.Result()
+		}
+
+		res := bankHandler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		switch msg := msg.(type) {
+		case bank.MsgSend:
+			if _, err := k.ChargeTransferFee(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+				return err.Result()
+			}
+		case bank.MsgMultiSend:
+			var sender sdk.AccAddress
+			if len(msg.Inputs) == 1 {
+				sender = msg.Inputs[0].Address
+			}
+			for _, output := range msg.Outputs {
+				if _, err := k.ChargeTransferFee(ctx, sender, output.Address, output.Coins); err != nil {
+					return err.Result()
+				}
+			}
+		default:
+			return res
+		}
+
+		res.Events = ctx.EventManager().Events()
+		return res
+	}
+}
+
+func checkBankHoldingLimits(ctx sdk.Context, k Keeper, msg sdk.Msg) sdk.Error {
+	switch msg := msg.(type) {
+	case bank.MsgSend:
+		return k.CheckHoldingLimits(ctx, []bank.Input{bank.NewInput(msg.FromAddress, msg.Amount)},
+			[]bank.Output{bank.NewOutput(msg.ToAddress, msg.Amount)})
+	case bank.MsgMultiSend:
+		return k.CheckHoldingLimits(ctx, msg.Inputs, msg.Outputs)
+	default:
+		return nil
+	}
+}
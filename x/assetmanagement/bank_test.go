@@ -0,0 +1,96 @@
+package assetmanagement
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/keeper"
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestBankHandlerTransferFee(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	bankHandler := NewBankHandler(k, bank.NewHandler(k.CoinKeeper))
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, collector := types.KeyTestPubAddr()
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	_, _, carol := types.KeyTestPubAddr()
+
+	deliver := func(handler sdk.Handler, msg sdk.Msg) sdk.Result {
+		return handler(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	}
+	send := func(from, to sdk.AccAddress, amount int64) bank.MsgSend {
+		return bank.MsgSend{FromAddress: from, ToAddress: to, Amount: types.NewTestCoins("zap123", amount)}
+	}
+
+	require.True(t, deliver(h, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 100000, false)).IsOK())
+	require.True(t, deliver(h, NewMsgSetTransferFee(owner, "zap123", 100, 0, 0, collector)).IsOK())
+	require.True(t, deliver(bankHandler, send(owner, alice, 10000)).IsOK())
+	require.Equal(t, sdk.NewInt(10000), k.CoinKeeper.GetCoins(ctx, alice).AmountOf("zap123"))
+
+	res := deliver(bankHandler, send(alice, bob, 1000))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeTransferFee))
+	require.Equal(t, sdk.NewInt(990), k.CoinKeeper.GetCoins(ctx, bob).AmountOf("zap123"))
+
+	res = deliver(bankHandler, bank.MsgMultiSend{
+		Inputs: []bank.Input{bank.NewInput(alice, types.NewTestCoins("zap123", 3000))},
+		Outputs: []bank.Output{
+			bank.NewOutput(bob, types.NewTestCoins("zap123", 1000)),
+			bank.NewOutput(carol, types.NewTestCoins("zap123", 2000)),
+		},
+	})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(990+990), k.CoinKeeper.GetCoins(ctx, bob).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(1980), k.CoinKeeper.GetCoins(ctx, carol).AmountOf("zap123"))
+
+	require.True(t, deliver(h, NewMsgDistribute(alice, "zap123", []Recipient{NewRecipient(carol, 100)})).IsOK())
+	require.Equal(t, sdk.NewInt(1980+99), k.CoinKeeper.GetCoins(ctx, carol).AmountOf("zap123"))
+
+	fee, err := k.GetTransferFee(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(10+30+1), fee.Collected)
+	require.Equal(t, fee.Collected, k.CoinKeeper.GetCoins(ctx, collector).AmountOf("zap123"))
+}
+
+func TestBankHandlerHoldingLimits(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	bankHandler := NewBankHandler(k, bank.NewHandler(k.CoinKeeper))
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+
+	deliver := func(handler sdk.Handler, msg sdk.Msg) sdk.Result {
+		return handler(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	}
+	send := func(from, to sdk.AccAddress, amount int64) bank.MsgSend {
+		return bank.MsgSend{FromAddress: from, ToAddress: to, Amount: types.NewTestCoins("zap123", amount)}
+	}
+
+	require.True(t, deliver(h, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 100000, false)).IsOK())
+	res := deliver(h, NewMsgSetHoldingLimits(owner, "zap123", 1000, 2))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeHoldingLimits))
+
+	require.Equal(t, CodeMaxBalanceExceeded, deliver(bankHandler, send(owner, alice, 1001)).Code)
+	require.True(t, deliver(bankHandler, send(owner, alice, 1000)).IsOK())
+	require.Equal(t, CodeMaxHoldersReached, deliver(bankHandler, send(alice, bob, 10)).Code)
+
+	res = deliver(bankHandler, bank.MsgMultiSend{
+		Inputs: []bank.Input{bank.NewInput(owner, types.NewTestCoins("zap123", 20))},
+		Outputs: []bank.Output{
+			bank.NewOutput(alice, types.NewTestCoins("zap123", 10)),
+			bank.NewOutput(owner, types.NewTestCoins("zap123", 10)),
+		},
+	})
+	require.Equal(t, CodeMaxBalanceExceeded, res.Code)
+	require.Equal(t, sdk.NewInt(1000), k.CoinKeeper.GetCoins(ctx, alice).AmountOf("zap123"))
+	require.Equal(t, uint64(2), k.GetHolderCount(ctx, "zap123"))
+}
@@ -0,0 +1,295 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// airdropState is the progress of an airdrop, saved before and after every batch so an interrupted airdrop can be
+// resumed
+type airdropState struct {
+	Symbol   string        `json:"symbol"`
+	Checksum string        `json:"checksum"`
+	Done     int           `json:"done"`
+	TxHashes []string      `json:"tx_hashes"`
+	Pending  *pendingBatch `json:"pending,omitempty"`
+}
+
+// pendingBatch is the signed tx of the batch being sent, recorded before it is broadcast so a run that stops before
+// learning its outcome doesn't pay the batch twice
+type pendingBatch struct {
+	Recipients int    `json:"recipients"`
+	TxHash     string `json:"tx_hash"`
+	Tx         []byte `json:"tx"`
+}
+
+// airdropClient is what an airdrop needs of the chain
+type airdropClient struct {
+	sign      func(msg sdk.Msg) ([]byte, error)            // nil bytes when the tx was simulated or declined
+	broadcast func(txBytes []byte) (sdk.TxResponse, error) // waits for the tx to be committed
+	lookup    func(txHash string) (*sdk.TxResponse, error) // nil when the tx wasn't committed
+}
+
+// GetCmdAirdrop is the CLI command for distributing a token to the recipients listed in a CSV file, split over
+// as many MsgDistribute transactions as needed
+func GetCmdAirdrop(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `airdrop --file [recipients.csv] --symbol [ABC-123] --from [account]`,
+		Short: "send a token to every address,amount row of a CSV file, resuming where a previous run stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			cliCtx.BroadcastMode = flags.BroadcastBlock // each batch must be committed before recording it
+			if cliCtx.GenerateOnly {
+				return fmt.Errorf("airdrop broadcasts several transactions and can't be used with --%s",
+					flags.FlagGenerateOnly)
+			}
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			client := airdropClient{
+				sign: func(msg sdk.Msg) ([]byte, error) {
+					return SignTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+				},
+				broadcast: func(txBytes []byte) (sdk.TxResponse, error) {
+					res, err := cliCtx.BroadcastTx(txBytes)
+					if err != nil {
+						return res, err
+					}
+					return res, cliCtx.PrintOutput(res)
+				},
+				lookup: func(txHash string) (*sdk.TxResponse, error) {
+					res, err := utils.QueryTx(cliCtx, txHash)
+					if err != nil {
+						if strings.Contains(err.Error(), "not found") {
+							return nil, nil
+						}
+						return nil, err
+					}
+					return &res, nil
+				},
+			}
+
+			address := getAccountAddress(cliCtx)
+			file := fetchStringFlag(cmd, "file")
+			symbol := types.NormalizeSymbol(fetchStringFlag(cmd, "symbol"))
+			batchSize := fetchInt64Flag(cmd, "batch-size")
+			if batchSize < 1 || batchSize > types.MaxDistributeRecipients {
+				return fmt.Errorf("batch size must be between 1 and %d", types.MaxDistributeRecipients)
+			}
+			stateFile := fetchStringFlag(cmd, "state-file")
+			if stateFile == "" {
+				stateFile = file + ".state"
+			}
+
+			recipients, checksum, err := readRecipients(file)
+			if err != nil {
+				return err
+			}
+			state, err := loadAirdropState(stateFile, symbol, checksum)
+			if err != nil {
+				return err
+			}
+			if state.Done > 0 || state.Pending != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "resuming airdrop after %d of %d recipients\n", state.Done, len(recipients))
+			}
+
+			return runAirdrop(client, stateFile, state, address, recipients, int(batchSize))
+		},
+	}
+
+	setupStringFlag(cmd, "file", "", "",
+		"CSV file with one address,amount row per recipient, a header row is allowed", true)
+	setupStringFlag(cmd, "symbol", "", "",
+		"what is the shorthand symbol, eg ABC-123, for the existing token", true)
+	setupInt64Flag(cmd, "batch-size", "", types.MaxDistributeRecipients,
+		"how many recipients to pay per transaction", false)
+	setupStringFlag(cmd, "state-file", "", "",
+		"where the progress is kept, defaults to the CSV file name with a .state suffix", false)
+
+	return cmd
+}
+
+// runAirdrop sends the batches of recipients left, saving the progress in the state file as it goes
+func runAirdrop(client airdropClient, stateFile string, state airdropState, sender sdk.AccAddress,
+	recipients []types.Recipient, batchSize int) error {
+	for state.Done < len(recipients) {
+		var response *sdk.TxResponse
+		if state.Pending != nil {
+			// a previous run stopped before learning the outcome of this batch, it is only sent again if it wasn't
+			// committed, and its sequence keeps the very same tx from being included twice
+			res, err := client.lookup(state.Pending.TxHash)
+			if err != nil {
+				return fmt.Errorf("unable to look up tx %s of the previous run: %v", state.Pending.TxHash, err)
+			}
+			response = res
+		} else {
+			batch := splitRecipients(recipients[state.Done:], batchSize)[0]
+			msg := types.NewMsgDistribute(sender, state.Symbol, batch)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			txBytes, err := client.sign(msg)
+			if err != nil {
+				return fmt.Errorf("airdrop stopped after %d of %d recipients: %v", state.Done, len(recipients), err)
+			}
+			if txBytes == nil {
+				// simulated or declined, nothing was sent
+				return nil
+			}
+			state.Pending = &pendingBatch{Recipients: len(batch), TxHash: fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+				Tx: txBytes}
+			if err := saveAirdropState(stateFile, state); err != nil {
+				return err
+			}
+		}
+
+		if response == nil {
+			res, err := client.broadcast(state.Pending.Tx)
+			if err != nil {
+				// the tx may still be committed, the next run looks it up
+				return fmt.Errorf("airdrop stopped after %d of %d recipients, tx %s may still be committed: %v",
+					state.Done, len(recipients), state.Pending.TxHash, err)
+			}
+			if res.Code != 0 {
+				// a resent tx is also rejected when it was committed in the meantime
+				committed, err := client.lookup(state.Pending.TxHash)
+				if err != nil {
+					return fmt.Errorf("unable to look up tx %s: %v", state.Pending.TxHash, err)
+				}
+				if committed != nil {
+					res = *committed
+				}
+			}
+			response = &res
+		}
+
+		pending := *state.Pending
+		state.Pending = nil
+		if response.Code != 0 {
+			if err := saveAirdropState(stateFile, state); err != nil {
+				return err
+			}
+			return fmt.Errorf("airdrop stopped after %d of %d recipients, tx %s failed: %s",
+				state.Done, len(recipients), pending.TxHash, response.RawLog)
+		}
+		state.Done += pending.Recipients
+		state.TxHashes = append(state.TxHashes, pending.TxHash)
+		if err := saveAirdropState(stateFile, state); err != nil {
+			return err
+		}
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "airdrop complete, %d recipients in %d transactions\n",
+		len(recipients), len(state.TxHashes))
+	return nil
+}
+
+// readRecipients parses the address,amount rows of a CSV file and returns them with the checksum of the file
+func readRecipients(path string) ([]types.Recipient, string, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	checksum := sha256.Sum256(bz)
+
+	reader := csv.NewReader(strings.NewReader(string(bz)))
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var recipients []types.Recipient
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if row == 1 && strings.EqualFold(record[0], "address") {
+			continue
+		}
+
+		address, err := sdk.AccAddressFromBech32(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, "", fmt.Errorf("%s row %d: %v", path, row, err)
+		}
+		amount, err := strconv.ParseInt(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil || amount < 1 {
+			return nil, "", fmt.Errorf("%s row %d: invalid amount '%s'", path, row, record[1])
+		}
+		recipients = append(recipients, types.NewRecipient(address, amount))
+	}
+	if len(recipients) == 0 {
+		return nil, "", fmt.Errorf("%s has no recipients", path)
+	}
+
+	return recipients, hex.EncodeToString(checksum[:]), nil
+}
+
+// splitRecipients splits the recipients into batches of at most size recipients
+func splitRecipients(recipients []types.Recipient, size int) [][]types.Recipient {
+	var batches [][]types.Recipient
+	for len(recipients) > size {
+		batches = append(batches, recipients[:size])
+		recipients = recipients[size:]
+	}
+	if len(recipients) > 0 {
+		batches = append(batches, recipients)
+	}
+	return batches
+}
+
+// loadAirdropState reads the progress of a previous run, or starts a new one when there is no state file.
+// A state file left by a run for another token or another version of the CSV file is refused
+func loadAirdropState(path string, symbol string, checksum string) (airdropState, error) {
+	state := airdropState{Symbol: symbol, Checksum: checksum}
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+
+	var saved airdropState
+	if err := json.Unmarshal(bz, &saved); err != nil {
+		return state, fmt.Errorf("unable to read airdrop progress from %s: %v", path, err)
+	}
+	if saved.Symbol != symbol || saved.Checksum != checksum {
+		return state, fmt.Errorf("%s belongs to an airdrop of another token or CSV file, "+
+			"remove it or pass a different --state-file to start over", path)
+	}
+	return saved, nil
+}
+
+// saveAirdropState writes the progress atomically, so a crash can't leave a truncated state file behind
+func saveAirdropState(path string, state airdropState) error {
+	bz, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
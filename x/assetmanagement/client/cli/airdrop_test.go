@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func writeTempFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.Nil(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestReadRecipients(t *testing.T) {
+	dir, err := ioutil.TempDir("", "airdrop")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	_, _, me := types.KeyTestPubAddr()
+	_, _, you := types.KeyTestPubAddr()
+	path := writeTempFile(t, dir, "recipients.csv",
+		"address,amount\n# comments are skipped\n"+me.String()+",10\n "+you.String()+", 20\n")
+
+	recipients, checksum, err := readRecipients(path)
+	require.Nil(t, err)
+	require.Equal(t, []types.Recipient{types.NewRecipient(me, 10), types.NewRecipient(you, 20)}, recipients)
+	require.Len(t, checksum, 64)
+
+	for _, content := range []string{
+		"",
+		"address,amount\n",
+		"nope,10\n",
+		me.String() + ",0\n",
+		me.String() + ",ten\n",
+		me.String() + ",10,extra\n",
+	} {
+		_, _, err := readRecipients(writeTempFile(t, dir, "invalid.csv", content))
+		require.NotNil(t, err, content)
+	}
+}
+
+func TestSplitRecipients(t *testing.T) {
+	recipients := make([]types.Recipient, 5)
+
+	require.Len(t, splitRecipients(recipients, 2), 3)
+	require.Len(t, splitRecipients(recipients, 2)[2], 1)
+	require.Len(t, splitRecipients(recipients, 5), 1)
+	require.Empty(t, splitRecipients(nil, 5))
+}
+
+func TestAirdropState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "airdrop")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "recipients.csv.state")
+
+	state, err := loadAirdropState(path, "zap123", "abcd")
+	require.Nil(t, err)
+	require.Equal(t, 0, state.Done)
+
+	state.Done = 100
+	state.TxHashes = []string{"F77A055D"}
+	require.Nil(t, saveAirdropState(path, state))
+
+	resumed, err := loadAirdropState(path, "zap123", "abcd")
+	require.Nil(t, err)
+	require.Equal(t, state, resumed)
+
+	// progress of another token or another version of the file must not be reused
+	_, err = loadAirdropState(path, "zap124", "abcd")
+	require.NotNil(t, err)
+	_, err = loadAirdropState(path, "zap123", "dcba")
+	require.NotNil(t, err)
+}
+
+// fakeChain commits the txs it is sent, optionally losing the response of one of them
+type fakeChain struct {
+	committed map[string]sdk.TxResponse
+	sent      [][]byte
+	dropAt    int // the response of the tx sent in that position is lost, from 1
+}
+
+func (c *fakeChain) client() airdropClient {
+	return airdropClient{
+		sign: func(msg sdk.Msg) ([]byte, error) {
+			return msg.GetSignBytes(), nil
+		},
+		broadcast: func(txBytes []byte) (sdk.TxResponse, error) {
+			c.sent = append(c.sent, txBytes)
+			hash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+			if _, ok := c.committed[hash]; ok {
+				return sdk.TxResponse{TxHash: hash, Code: 4, RawLog: "signature verification failed"}, nil
+			}
+			c.committed[hash] = sdk.TxResponse{TxHash: hash}
+			if len(c.sent) == c.dropAt {
+				return sdk.TxResponse{}, errors.New("timed out waiting for tx to be included in a block")
+			}
+			return c.committed[hash], nil
+		},
+		lookup: func(txHash string) (*sdk.TxResponse, error) {
+			if res, ok := c.committed[txHash]; ok {
+				return &res, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func TestRunAirdropResumes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "airdrop")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "recipients.csv.state")
+
+	_, _, sender := types.KeyTestPubAddr()
+	recipients := make([]types.Recipient, 5)
+	for i := range recipients {
+		_, _, address := types.KeyTestPubAddr()
+		recipients[i] = types.NewRecipient(address, 10)
+	}
+	chain := &fakeChain{committed: map[string]sdk.TxResponse{}, dropAt: 2}
+
+	// the second batch is committed but the run stops before hearing about it
+	state, err := loadAirdropState(path, "zap123", "abcd")
+	require.Nil(t, err)
+	require.NotNil(t, runAirdrop(chain.client(), path, state, sender, recipients, 2))
+	state, err = loadAirdropState(path, "zap123", "abcd")
+	require.Nil(t, err)
+	require.Equal(t, 2, state.Done)
+	require.NotNil(t, state.Pending)
+	require.Equal(t, chain.sent[1], state.Pending.Tx)
+
+	// resuming finds it committed instead of paying it again
+	require.Nil(t, runAirdrop(chain.client(), path, state, sender, recipients, 2))
+	require.Len(t, chain.sent, 3)
+	state, err = loadAirdropState(path, "zap123", "abcd")
+	require.Nil(t, err)
+	require.Equal(t, 5, state.Done)
+	require.Nil(t, state.Pending)
+	require.Len(t, state.TxHashes, 3)
+}
+
+func TestRunAirdropResendsUncommitted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "airdrop")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "recipients.csv.state")
+
+	_, _, sender := types.KeyTestPubAddr()
+	_, _, recipient := types.KeyTestPubAddr()
+	recipients := []types.Recipient{types.NewRecipient(recipient, 10)}
+	tx := types.NewMsgDistribute(sender, "zap123", recipients).GetSignBytes()
+
+	// a run that stopped before its tx reached the chain sends the very same tx again
+	state := airdropState{Symbol: "zap123", Checksum: "abcd",
+		Pending: &pendingBatch{Recipients: 1, TxHash: fmt.Sprintf("%X", tmhash.Sum(tx)), Tx: tx}}
+	chain := &fakeChain{committed: map[string]sdk.TxResponse{}}
+	require.Nil(t, runAirdrop(chain.client(), path, state, sender, recipients, 2))
+	require.Equal(t, [][]byte{tx}, chain.sent)
+	state, err = loadAirdropState(path, "zap123", "abcd")
+	require.Nil(t, err)
+	require.Equal(t, 1, state.Done)
+	require.Nil(t, state.Pending)
+}
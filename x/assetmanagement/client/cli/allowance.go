@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdApprove is the CLI command for sending an Approve transaction
+func GetCmdApprove(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: `approve [spender] --symbol [ABC-123] --amount [amount] --expiry [2021-01-01T00:00:00Z]
+			--from [account]`,
+		Short: "let the spender transfer coins of a token out of the sending account",
+		Long: `Let the spender transfer up to the amount of a token out of the sending account until the expiry.
+This replaces any earlier allowance of the spender over the token, an amount of 0 revokes it. Without an expiry the
+allowance never expires.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			address := getAccountAddress(cliCtx)
+			spender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			var expiry time.Time
+			if value := fetchStringFlag(cmd, "expiry"); value != "" {
+				expiry, err = time.Parse(time.RFC3339, value)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgApprove(address, spender, types.NormalizeSymbol(fetchStringFlag(cmd, "symbol")),
+				fetchInt64Flag(cmd, "amount"), expiry.UTC())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "symbol", "", "",
+		"what is the shorthand symbol, eg ABC-123, for the existing token", true)
+	setupInt64Flag(cmd, "amount", "", -1, "how many coins the spender may transfer, 0 to revoke", true)
+	setupStringFlag(cmd, "expiry", "", "", "when the allowance expires, in RFC3339 format, never if empty", false)
+
+	return cmd
+}
+
+// GetCmdTransferFrom is the CLI command for sending a TransferFrom transaction
+func GetCmdTransferFrom(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `transfer-from [from] [to] --symbol [ABC-123] --amount [amount] --from [account]`,
+		Short: "transfer coins of a token out of an account that approved the sending account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			from, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferFrom(getAccountAddress(cliCtx), from, to,
+				types.NormalizeSymbol(fetchStringFlag(cmd, "symbol")), fetchInt64Flag(cmd, "amount"))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "symbol", "", "",
+		"what is the shorthand symbol, eg ABC-123, for the existing token", true)
+	setupInt64Flag(cmd, "amount", "", -1, "how many coins to transfer", true)
+
+	return cmd
+}
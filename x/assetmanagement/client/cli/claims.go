@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// claimsFile is the output of build-claims: the root to register a campaign with and the proof of every recipient
+type claimsFile struct {
+	MerkleRoot string       `json:"merkle_root"`
+	Total      int64        `json:"total"`
+	Claims     []claimEntry `json:"claims"`
+}
+
+// claimEntry is what a single recipient needs to claim
+type claimEntry struct {
+	Address string   `json:"address"`
+	Amount  int64    `json:"amount"`
+	Proof   []string `json:"proof"`
+}
+
+// GetCmdBuildClaims is the offline CLI command building the claim tree and the per address proofs from a CSV file
+func GetCmdBuildClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `build-claims [recipients.csv]`,
+		Short: "build the merkle root and proofs of a claim campaign from address,amount rows of a CSV file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recipients, _, err := readRecipients(args[0])
+			if err != nil {
+				return err
+			}
+			claims, err := buildClaims(recipients)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(claims, "", "  ")
+			if err != nil {
+				return err
+			}
+			output := fetchStringFlag(cmd, "output")
+			if output == "" {
+				fmt.Println(string(bz))
+			} else if err := ioutil.WriteFile(output, bz, 0644); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(os.Stderr, "%d recipients, total %d, merkle root %s\n",
+				len(claims.Claims), claims.Total, claims.MerkleRoot)
+			return nil
+		},
+	}
+
+	setupStringFlag(cmd, "output", "o", "", "file to write the proofs to instead of stdout", false)
+
+	return cmd
+}
+
+// buildClaims builds the claim tree over the recipients, each address may only be listed once
+func buildClaims(recipients []types.Recipient) (claimsFile, error) {
+	seen := make(map[string]bool, len(recipients))
+	leaves := make([][]byte, len(recipients))
+	var total int64
+	for i, recipient := range recipients {
+		if seen[recipient.Address.String()] {
+			return claimsFile{}, fmt.Errorf("%s is listed more than once, it could only claim once", recipient.Address)
+		}
+		seen[recipient.Address.String()] = true
+		leaves[i] = types.ClaimLeaf(recipient.Address, recipient.Amount)
+		total += recipient.Amount
+		if total < 0 {
+			return claimsFile{}, fmt.Errorf("the total amount overflows")
+		}
+	}
+
+	root, proofs := types.BuildClaimTree(leaves)
+	claims := claimsFile{MerkleRoot: hex.EncodeToString(root), Total: total, Claims: make([]claimEntry, len(recipients))}
+	for i, recipient := range recipients {
+		proof := make([]string, len(proofs[i]))
+		for j, node := range proofs[i] {
+			proof[j] = hex.EncodeToString(node)
+		}
+		claims.Claims[i] = claimEntry{Address: recipient.Address.String(), Amount: recipient.Amount, Proof: proof}
+	}
+	return claims, nil
+}
+
+// findClaim looks up the amount and proof of an address in a file written by build-claims
+func findClaim(path string, address sdk.AccAddress) (int64, []string, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	var claims claimsFile
+	if err := json.Unmarshal(bz, &claims); err != nil {
+		return 0, nil, fmt.Errorf("unable to read claims from %s: %v", path, err)
+	}
+	for _, claim := range claims.Claims {
+		if claim.Address == address.String() {
+			return claim.Amount, claim.Proof, nil
+		}
+	}
+	return 0, nil, fmt.Errorf("%s has nothing to claim in %s", address, path)
+}
+
+// GetCmdCreateClaimCampaign is the CLI command for sending a CreateClaimCampaign transaction
+func GetCmdCreateClaimCampaign(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: `create-claim-campaign --symbol [ABC-123] --merkle-root [hex] --deposit [amount]
+			--end-time [2020-12-31T00:00:00Z] --from [account]`,
+		Short: "fund a claimable airdrop, the deposit left at the end time is returned to the token owner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			address := getAccountAddress(cliCtx)
+			symbol := types.NormalizeSymbol(fetchStringFlag(cmd, "symbol"))
+			endTime, err := time.Parse(time.RFC3339, fetchStringFlag(cmd, "end-time"))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClaimCampaign(address, symbol, strings.ToLower(fetchStringFlag(cmd, "merkle-root")),
+				fetchInt64Flag(cmd, "deposit"), endTime.UTC())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "symbol", "", "",
+		"what is the shorthand symbol, eg ABC-123, for the existing token", true)
+	setupStringFlag(cmd, "merkle-root", "", "", "the merkle root printed by build-claims", true)
+	setupInt64Flag(cmd, "deposit", "", -1,
+		"how many coins to deposit, at least the total printed by build-claims", true)
+	setupStringFlag(cmd, "end-time", "", "", "when unclaimed coins are returned, in RFC3339 format", true)
+
+	return cmd
+}
+
+// GetCmdClaim is the CLI command for sending a Claim transaction
+func GetCmdClaim(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `claim [campaign-id] --claims-file [claims.json] --from [account]`,
+		Short: "claim the coins a campaign assigns to the sending account",
+		Long: `Claim the coins a campaign assigns to the sending account. The amount and proof are looked up in the
+file written by build-claims, or can be given with --amount and --proof instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id '%s': %v", args[0], err)
+			}
+			address := getAccountAddress(cliCtx)
+
+			amount := fetchInt64Flag(cmd, "amount")
+			var proof []string
+			if proofFlag := fetchStringFlag(cmd, "proof"); proofFlag != "" {
+				proof = strings.Split(proofFlag, ",")
+			}
+			if file := fetchStringFlag(cmd, "claims-file"); file != "" {
+				amount, proof, err = findClaim(file, address)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClaim(address, id, amount, proof)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "claims-file", "", "", "the file written by build-claims", false)
+	setupInt64Flag(cmd, "amount", "", -1, "the amount to claim, when not using --claims-file", false)
+	setupStringFlag(cmd, "proof", "", "",
+		"comma separated hex hashes of the proof, when not using --claims-file", false)
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestBuildClaims(t *testing.T) {
+	dir, err := ioutil.TempDir("", "claims")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	var recipients []types.Recipient
+	for i := int64(1); i <= 5; i++ {
+		_, _, address := types.KeyTestPubAddr()
+		recipients = append(recipients, types.NewRecipient(address, i*10))
+	}
+
+	claims, err := buildClaims(recipients)
+	require.Nil(t, err)
+	require.Equal(t, int64(150), claims.Total)
+	root, err := hex.DecodeString(claims.MerkleRoot)
+	require.Nil(t, err)
+
+	bz, err := json.Marshal(claims)
+	require.Nil(t, err)
+	path := writeTempFile(t, dir, "claims.json", string(bz))
+
+	// every recipient finds a proof that verifies against the root
+	for _, recipient := range recipients {
+		amount, proof, err := findClaim(path, recipient.Address)
+		require.Nil(t, err)
+		require.Equal(t, recipient.Amount, amount)
+
+		msg := types.NewMsgClaim(recipient.Address, 1, amount, proof)
+		require.Nil(t, msg.ValidateBasic())
+		decoded, err := msg.DecodeProof()
+		require.Nil(t, err)
+		require.True(t, types.VerifyClaimProof(root, types.ClaimLeaf(recipient.Address, amount), decoded))
+	}
+
+	_, _, stranger := types.KeyTestPubAddr()
+	_, _, err = findClaim(path, stranger)
+	require.NotNil(t, err)
+
+	_, err = buildClaims(append(recipients, recipients[0]))
+	require.NotNil(t, err)
+}
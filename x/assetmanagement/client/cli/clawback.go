@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdClawback is the CLI command for sending a Clawback transaction
+func GetCmdClawback(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: `clawback [holder] --symbol [ABC-123] --amount [amount] --reason [text] --recipient [address]
+			--from [account]`,
+		Short: "take coins of a clawbackable token from a holder, as its owner or clawback admin",
+		Long: `Take coins of a clawbackable token from a holder, as its owner or clawback admin. The holder's free
+balance is used first, whatever is missing is unfrozen. The coins are moved to the recipient, or burned without one.
+The reason is recorded in the token's clawback history.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			holder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			var recipient sdk.AccAddress
+			if value := fetchStringFlag(cmd, "recipient"); value != "" {
+				recipient, err = sdk.AccAddressFromBech32(value)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(getAccountAddress(cliCtx), types.NormalizeSymbol(fetchStringFlag(cmd, "symbol")),
+				holder, recipient, fetchInt64Flag(cmd, "amount"), fetchStringFlag(cmd, "reason"))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "symbol", "", "",
+		"what is the shorthand symbol, eg ABC-123, for the existing token", true)
+	setupInt64Flag(cmd, "amount", "", -1, "how many coins to claw back", true)
+	setupStringFlag(cmd, "reason", "", "", "why the coins are clawed back, eg a court order reference", true)
+	setupStringFlag(cmd, "recipient", "", "", "where the coins go, they are burned if empty", false)
+
+	return cmd
+}
+
+// GetCmdSetClawbackAdmin is the CLI command for sending a SetClawbackAdmin transaction
+func GetCmdSetClawbackAdmin(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `set-clawback-admin [ABC-123] [admin] --from [account]`,
+		Short: "change who besides the owner may claw back coins of a token, leave out the admin to remove it",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var admin sdk.AccAddress
+			if len(args) == 2 {
+				var err error
+				admin, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetClawbackAdmin(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), admin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdOpenConversion is the CLI command for sending an OpenConversion transaction
+func GetCmdOpenConversion(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: `open-conversion [OLD-123] [NEW-456] [numerator] [denominator] --deadline [2020-12-31T00:00:00Z]
+			--from [account]`,
+		Short: "let the holders of a token convert it to another token of the same owner until the deadline",
+		Long: `Let the holders of the old token convert it to the new token until the deadline, receiving numerator /
+denominator new coins per old coin, rounded down. The converted old coins are burnt and the new coins minted, so the
+new token must be mintable. The old token is disabled once the deadline passes: its coins can no longer be
+transferred or minted.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			numerator, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("numerator %s is not a positive number: %s", args[2], err)
+			}
+			denominator, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("denominator %s is not a positive number: %s", args[3], err)
+			}
+			deadline, err := time.Parse(time.RFC3339, fetchStringFlag(cmd, "deadline"))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenConversion(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]),
+				types.NormalizeSymbol(args[1]), numerator, denominator, deadline.UTC())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "deadline", "", "", "when the window closes and the old token is disabled, in RFC3339 format",
+		true)
+
+	return cmd
+}
+
+// GetCmdConvert is the CLI command for sending a Convert transaction
+func GetCmdConvert(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `convert [OLD-123] [amount] --from [account]`,
+		Short: "convert coins of a token with an open conversion window to the token replacing it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("amount %s is not a number: %s", args[1], err)
+			}
+
+			msg := types.NewMsgConvert(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdSetCouncil is the CLI command for sending a SetCouncil transaction
+func GetCmdSetCouncil(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `set-council [ABC-123] [threshold] [member1,member2,...] --from [account]`,
+		Short: "hand a token over to a council whose members approve its privileged actions",
+		Long: `Hand a token over to a council: the token is then owned by the council address, which nobody holds the
+key of. Its privileged messages are proposed to the council with propose-action and run once threshold members
+approved them. A council changes its members or threshold through an approved set-council action of its own.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			threshold, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("threshold %s is not a positive number: %s", args[1], err)
+			}
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCouncil(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), members, threshold)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdProposeAction is the CLI command for sending a ProposeAction transaction
+func GetCmdProposeAction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `propose-action [ABC-123] [action.json] --expires-in [72h] --from [account]`,
+		Short: "propose a privileged message of a council owned token to its council",
+		Long: `Propose a privileged message of a council owned token, it counts as approved by the proposer and runs
+as soon as enough members approved it. The file holds either the message itself or a transaction generated with
+--generate-only --from [council address] holding that single message.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			action, err := readAction(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			expiresIn, err := time.ParseDuration(fetchStringFlag(cmd, "expires-in"))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeAction(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), action,
+				time.Now().Add(expiresIn).UTC())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "expires-in", "", "72h", "how long the action may wait for approvals", false)
+
+	return cmd
+}
+
+// GetCmdApproveAction is the CLI command for sending an ApproveAction transaction
+func GetCmdApproveAction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `approve-action [id] --from [account]`,
+		Short: "approve a pending council action, it runs once enough members approved it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("action id %s is not a number: %s", args[0], err)
+			}
+
+			msg := types.NewMsgApproveAction(getAccountAddress(cliCtx), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevokeApproval is the CLI command for sending a RevokeApproval transaction
+func GetCmdRevokeApproval(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `revoke-approval [id] --from [account]`,
+		Short: "withdraw an approval of a pending council action, the action is dropped once no approval is left",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("action id %s is not a number: %s", args[0], err)
+			}
+
+			msg := types.NewMsgRevokeApproval(getAccountAddress(cliCtx), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func parseMembers(list string) ([]sdk.AccAddress, error) {
+	var members []sdk.AccAddress
+	for _, bech := range strings.Split(list, ",") {
+		member, err := sdk.AccAddressFromBech32(strings.TrimSpace(bech))
+		if err != nil {
+			return nil, fmt.Errorf("invalid member '%s': %s", bech, err)
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// readAction reads the message to propose from a file holding either the message or a generated transaction with
+// that single message
+func readAction(cdc *codec.Codec, path string) (sdk.Msg, error) {
+	msgs, err := readMsgs(cdc, path)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("the transaction in %s holds %d messages, only one can be proposed", path, len(msgs))
+	}
+	return msgs[0], nil
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestReadAction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "council")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	msg := types.NewMsgSetTokenStatus(types.CouncilAddress("abc123"), "abc123", types.TokenStatusDeprecated)
+	other := types.NewMsgSetTokenStatus(types.CouncilAddress("abc123"), "abc123", types.TokenStatusRetired)
+
+	// the message alone
+	path := writeTempFile(t, dir, "msg.json", string(cdc.MustMarshalJSON(sdk.Msg(msg))))
+	action, err := readAction(cdc, path)
+	require.Nil(t, err)
+	require.Equal(t, msg, action)
+
+	// a generated transaction with that message
+	tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+	path = writeTempFile(t, dir, "tx.json", string(cdc.MustMarshalJSON(tx)))
+	action, err = readAction(cdc, path)
+	require.Nil(t, err)
+	require.Equal(t, msg, action)
+
+	tx = auth.NewStdTx([]sdk.Msg{msg, other}, auth.StdFee{}, nil, "")
+	path = writeTempFile(t, dir, "txs.json", string(cdc.MustMarshalJSON(tx)))
+	_, err = readAction(cdc, path)
+	require.NotNil(t, err)
+
+	path = writeTempFile(t, dir, "bad.json", `{"type":"unknown","value":{}}`)
+	_, err = readAction(cdc, path)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdSetEmission is the CLI command for sending a SetEmission transaction
+func GetCmdSetEmission(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: `set-emission [ABC-123] --recipient [address] --rate [amount] --start-height [height]
+			--end-height [height] --decay-interval [blocks] --decay-rate [0.1] --max-supply [amount] --from [account]`,
+		Short: "mint a token to a recipient every block, replacing the token's current emission schedule",
+		Long: `Mint a token to a recipient every block from the start height up to and including the end height.
+Every decay interval the rate shrinks by the decay rate, and minting stops once the total supply reaches the max supply.
+Leave out the end height to mint until the max supply is reached or the rate has decayed to nothing.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			address := getAccountAddress(cliCtx)
+			symbol := types.NormalizeSymbol(args[0])
+			recipient, err := sdk.AccAddressFromBech32(fetchStringFlag(cmd, "recipient"))
+			if err != nil {
+				return err
+			}
+			decayRate, err := sdk.NewDecFromStr(fetchStringFlag(cmd, "decay-rate"))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetEmission(address, symbol, recipient, fetchInt64Flag(cmd, "rate"),
+				fetchInt64Flag(cmd, "start-height"), fetchInt64Flag(cmd, "end-height"),
+				fetchInt64Flag(cmd, "decay-interval"), decayRate, fetchInt64Flag(cmd, "max-supply"))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "recipient", "", "", "the address the minted coins are sent to", true)
+	setupInt64Flag(cmd, "rate", "", -1, "how many coins to mint per block", true)
+	setupInt64Flag(cmd, "start-height", "", -1, "the first height to mint at", true)
+	setupInt64Flag(cmd, "end-height", "", 0, "the last height to mint at, 0 for no end height", false)
+	setupInt64Flag(cmd, "decay-interval", "", 0, "how many blocks between decays of the rate", false)
+	setupStringFlag(cmd, "decay-rate", "", "0", "the fraction the rate shrinks by at every decay", false)
+	setupInt64Flag(cmd, "max-supply", "", 0, "the total supply at which minting stops, 0 for no cap", false)
+
+	return cmd
+}
+
+// GetCmdPauseEmission is the CLI command for pausing an emission schedule
+func GetCmdPauseEmission(cdc *codec.Codec) *cobra.Command {
+	return getCmdSetEmissionPaused(cdc, true)
+}
+
+// GetCmdResumeEmission is the CLI command for resuming a paused emission schedule
+func GetCmdResumeEmission(cdc *codec.Codec) *cobra.Command {
+	return getCmdSetEmissionPaused(cdc, false)
+}
+
+func getCmdSetEmissionPaused(cdc *codec.Codec, paused bool) *cobra.Command {
+	use, short := "resume-emission", "resume minting a token according to its emission schedule"
+	if paused {
+		use, short = "pause-emission", "stop minting a token according to its emission schedule until resumed"
+	}
+
+	return &cobra.Command{
+		Use:   use + ` [ABC-123] --from [account]`,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetEmissionPaused(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), paused)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
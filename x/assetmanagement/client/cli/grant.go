@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdGrant is the CLI command for sending a Grant transaction
+func GetCmdGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: `grant [grantee] [msg-type] --symbol [ABC-123] --spend-limit [amount] --expiry [2021-01-01T00:00:00Z]
+			--from [account]`,
+		Short: "let the grantee sign messages of one type over a token for the sending account",
+		Long: fmt.Sprintf(`Let the grantee sign messages of one type over a token for the sending account, which it runs
+with exec. The messages that move coins take them off the spend limit, the grant is used up once nothing is left.
+This replaces any earlier grant of the grantee for the type and token. Without a spend limit or expiry the grant is
+unlimited or never expires. Message types: %s.`, strings.Join(types.GrantableMsgTypes(), ", ")),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			var expiry time.Time
+			if value := fetchStringFlag(cmd, "expiry"); value != "" {
+				expiry, err = time.Parse(time.RFC3339, value)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgGrant(getAccountAddress(cliCtx), grantee,
+				types.NormalizeSymbol(fetchStringFlag(cmd, "symbol")), args[1], fetchInt64Flag(cmd, "spend-limit"),
+				expiry.UTC())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "symbol", "", "",
+		"what is the shorthand symbol, eg ABC-123, for the existing token", true)
+	setupInt64Flag(cmd, "spend-limit", "", 0, "how many coins the grantee may move, unlimited if 0", false)
+	setupStringFlag(cmd, "expiry", "", "", "when the grant expires, in RFC3339 format, never if empty", false)
+
+	return cmd
+}
+
+// GetCmdRevokeGrant is the CLI command for sending a RevokeGrant transaction
+func GetCmdRevokeGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `revoke-grant [grantee] [msg-type] --symbol [ABC-123] --from [account]`,
+		Short: "take back a grant the sending account issued",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeGrant(getAccountAddress(cliCtx), grantee,
+				types.NormalizeSymbol(fetchStringFlag(cmd, "symbol")), args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "symbol", "", "",
+		"what is the shorthand symbol, eg ABC-123, for the existing token", true)
+
+	return cmd
+}
+
+// GetCmdExec is the CLI command for sending an Exec transaction
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `exec [msgs.json] --from [account]`,
+		Short: "run messages of other accounts under the grants they issued to the sending account",
+		Long: `Run messages of other accounts under the grants they issued to the sending account, either all of
+them run or none does. The file holds either a single message or a transaction generated with --generate-only
+--from [granter] holding up to 10 messages.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msgs, err := readMsgs(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(getAccountAddress(cliCtx), msgs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// readMsgs reads the messages of a generated transaction, or a single message, from a JSON file
+func readMsgs(cdc *codec.Codec, path string) ([]sdk.Msg, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx auth.StdTx
+	if err := cdc.UnmarshalJSON(bz, &tx); err == nil && len(tx.Msgs) > 0 {
+		return tx.Msgs, nil
+	}
+
+	var msg sdk.Msg
+	if err := cdc.UnmarshalJSON(bz, &msg); err != nil {
+		return nil, fmt.Errorf("%s holds neither a message nor a transaction: %s", path, err)
+	}
+	return []sdk.Msg{msg}, nil
+}
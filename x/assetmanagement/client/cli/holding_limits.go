@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdSetHoldingLimits is the CLI command for sending a SetHoldingLimits transaction
+func GetCmdSetHoldingLimits(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `set-holding-limits [ABC-123] --max-balance [amount] --max-holders [count] --from [account]`,
+		Short: "limit how much any account may hold of a token and how many accounts may hold it",
+		Long: `Limit how much any account but the owner may hold of a token, free and frozen coins together, and how
+many accounts may hold it, the owner included. A limit of 0 removes it. Accounts already over a new limit keep their
+coins but can't receive more.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			maxHolders := fetchInt64Flag(cmd, "max-holders")
+			if maxHolders < 0 {
+				return fmt.Errorf("max holders %d cannot be negative", maxHolders)
+			}
+
+			msg := types.NewMsgSetHoldingLimits(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]),
+				fetchInt64Flag(cmd, "max-balance"), uint64(maxHolders))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupInt64Flag(cmd, "max-balance", "", 0, "the most any account but the owner may hold, 0 for no limit", false)
+	setupInt64Flag(cmd, "max-holders", "", 0, "the most accounts that may hold the token, 0 for no limit", false)
+
+	return cmd
+}
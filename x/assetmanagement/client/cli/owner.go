@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdTransferOwnership is the CLI command for sending a TransferOwnership transaction
+func GetCmdTransferOwnership(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `transfer-ownership [ABC-123] [new owner] --from [account]`,
+		Short: "hand a token over to a new owner",
+		Long: `Hand a token over to a new owner, who then signs all of its privileged messages. A council owned token
+leaves its council, which is dissolved. Use set-council to hand a token to a council instead.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferOwnership(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), newOwner)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetTokenMetadata is the CLI command for sending a SetTokenMetadata transaction
+func GetCmdSetTokenMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `set-metadata [ABC-123] [name] [original symbol] --from [account]`,
+		Short: "change the name and original symbol wallets display a token with",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetTokenMetadata(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), args[1],
+				args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdPauseToken is the CLI command for pausing a token
+func GetCmdPauseToken(cdc *codec.Codec) *cobra.Command {
+	return getCmdSetTokenPaused(cdc, true)
+}
+
+// GetCmdResumeToken is the CLI command for resuming a paused token
+func GetCmdResumeToken(cdc *codec.Codec) *cobra.Command {
+	return getCmdSetTokenPaused(cdc, false)
+}
+
+func getCmdSetTokenPaused(cdc *codec.Codec, paused bool) *cobra.Command {
+	use, short := "resume-token", "let the coins of a paused token move again"
+	if paused {
+		use, short = "pause-token", "stop every movement of a token's coins until resumed, eg to redenominate it"
+	}
+
+	return &cobra.Command{
+		Use:   use + ` [ABC-123] --from [account]`,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetTokenPaused(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), paused)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
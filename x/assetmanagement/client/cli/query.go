@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/client/common"
 	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/keeper"
 	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
 	"github.com/spf13/cobra"
@@ -21,7 +22,36 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	}
 	queryCmd.AddCommand(client.GetCommands(
 		GetCmdFindToken(storeKey, cdc),
+		GetCmdSupply(storeKey, cdc),
 		GetCmdSymbols(storeKey, cdc),
+		GetCmdAccount(storeKey, cdc),
+		GetCmdCampaign(storeKey, cdc),
+		GetCmdCampaigns(storeKey, cdc),
+		GetCmdVestingGrant(storeKey, cdc),
+		GetCmdTokenVestingGrants(storeKey, cdc),
+		GetCmdBeneficiaryVestingGrants(storeKey, cdc),
+		GetCmdEmission(storeKey, cdc),
+		GetCmdEmissions(storeKey, cdc),
+		GetCmdAllowance(storeKey, cdc),
+		GetCmdOwnerAllowances(storeKey, cdc),
+		GetCmdSpenderAllowances(storeKey, cdc),
+		GetCmdClawbacks(storeKey, cdc),
+		GetCmdTransferFee(storeKey, cdc),
+		GetCmdHolders(storeKey, cdc),
+		GetCmdHolding(storeKey, cdc),
+		GetCmdRedenominations(storeKey, cdc),
+		GetCmdConversion(storeKey, cdc),
+		GetCmdConversions(storeKey, cdc),
+		GetCmdTokenStatus(storeKey, cdc),
+		GetCmdRetiredToken(storeKey, cdc),
+		GetCmdCouncil(storeKey, cdc),
+		GetCmdPendingAction(storeKey, cdc),
+		GetCmdPendingActions(storeKey, cdc),
+		GetCmdQueuedAction(storeKey, cdc),
+		GetCmdQueuedActions(storeKey, cdc),
+		GetCmdFindGrant(storeKey, cdc),
+		GetCmdGranterGrants(storeKey, cdc),
+		GetCmdGranteeGrants(storeKey, cdc),
 	)...)
 	return queryCmd
 }
@@ -31,34 +61,65 @@ func GetCmdFindToken(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "find [symbol]",
 		Short: "find symbol",
-		Args:  cobra.ExactArgs(1),
+		Long: `Find a token by its symbol. Use --height to look at a past block and --trust-node=false
+to read the token straight from the store with its Merkle proof verified against the block header.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			symbol := args[0]
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryToken, symbol), nil)
+			out, _, err := common.QueryToken(cliCtx, queryRoute, symbol)
 			if err != nil {
 				fmt.Printf("could not find symbol - '%s'. reason: '%s'\n", symbol, err)
 				return nil
 			}
 
-			var out types.Token
-			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
 	}
 }
 
-// GetCmdSymbols queries a list of all symbols
-func GetCmdSymbols(queryRoute string, cdc *codec.Codec) *cobra.Command {
+// GetCmdSupply queries the total supply of a token through its unique symbol
+func GetCmdSupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "symbols",
+		Use:   "supply [symbol]",
+		Short: "show the total supply of a token",
+		Long: `Show the total supply of a token. Use --height to look at a past block and --trust-node=false
+to have the result verified against the block header.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			token, _, err := common.QueryToken(cliCtx, queryRoute, symbol)
+			if err != nil {
+				fmt.Printf("could not find symbol - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			return cliCtx.PrintOutput(token.TotalSupply)
+		},
+	}
+}
+
+// GetCmdSymbols queries a list of all symbols, optionally only those of tokens with a given status
+func GetCmdSymbols(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbols --status [active|deprecated|retired]",
 		Short: "symbols",
 		// Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QuerySymbols), nil)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QuerySymbols)
+			if status := fetchStringFlag(cmd, "status"); status != "" {
+				if _, err := types.TokenStatusFromString(status); err != nil {
+					return err
+				}
+				route = fmt.Sprintf("%s/%s", route, status)
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
 			if err != nil {
 				fmt.Printf("could not get query symbols. reason: '%s'\n", err)
 				return nil
@@ -69,4 +130,661 @@ func GetCmdSymbols(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	setupStringFlag(cmd, "status", "", "", "only list the tokens with this status, retired ones are listed from their "+
+		"archived records", false)
+
+	return cmd
+}
+
+// GetCmdAccount queries the free and frozen token balances held by an address
+func GetCmdAccount(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "account [address]",
+		Short: "show free and frozen token balances of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryAccount, address), nil)
+			if err != nil {
+				fmt.Printf("could not query account - '%s'. reason: '%s'\n", address, err)
+				return nil
+			}
+
+			var out types.QueryResultAccount
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdCampaign queries a claim campaign by its ID
+func GetCmdCampaign(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "campaign [id]",
+		Short: "show a claim campaign and the deposit left in it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			id := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryCampaign, id), nil)
+			if err != nil {
+				fmt.Printf("could not find campaign - '%s'. reason: '%s'\n", id, err)
+				return nil
+			}
+
+			var out types.ClaimCampaign
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdCampaigns queries all claim campaigns that haven't ended
+func GetCmdCampaigns(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "campaigns",
+		Short: "list the claim campaigns that haven't ended",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryCampaigns), nil)
+			if err != nil {
+				fmt.Printf("could not query campaigns. reason: '%s'\n", err)
+				return nil
+			}
+
+			var out types.QueryResultCampaigns
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdVestingGrant queries a vesting grant by its ID
+func GetCmdVestingGrant(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting-grant [id]",
+		Short: "show a vesting grant and how much of it was released",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			id := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryVestingGrant, id), nil)
+			if err != nil {
+				fmt.Printf("could not find vesting grant - '%s'. reason: '%s'\n", id, err)
+				return nil
+			}
+
+			var out types.VestingGrant
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdTokenVestingGrants queries the vesting grants of a token
+func GetCmdTokenVestingGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-vesting-grants [symbol]",
+		Short: "list the vesting grants of a token that aren't fully released",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryTokenVestingGrants, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not query vesting grants of - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.QueryResultVestingGrants
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdBeneficiaryVestingGrants queries the vesting grants of a beneficiary
+func GetCmdBeneficiaryVestingGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "beneficiary-vesting-grants [address]",
+		Short: "list the vesting grants an address is the beneficiary of that aren't fully released",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryBeneficiaryVestingGrants, address), nil)
+			if err != nil {
+				fmt.Printf("could not query vesting grants of - '%s'. reason: '%s'\n", address, err)
+				return nil
+			}
+
+			var out types.QueryResultVestingGrants
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdEmission queries the emission schedule of a token
+func GetCmdEmission(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "emission [symbol]",
+		Short: "show the emission schedule of a token and how much it emitted so far",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryEmission, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not find emission of - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.EmissionSchedule
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdEmissions queries all emission schedules
+func GetCmdEmissions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "emissions",
+		Short: "list the emission schedules that haven't finished",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryEmissions), nil)
+			if err != nil {
+				fmt.Printf("could not query emissions. reason: '%s'\n", err)
+				return nil
+			}
+
+			var out types.QueryResultEmissions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdAllowance queries the allowance of a spender over a token of an owner
+func GetCmdAllowance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [owner] [spender] [symbol]",
+		Short: "show how much of a token of the owner the spender may still transfer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s", queryRoute, keeper.QueryAllowance,
+				args[0], args[1], args[2]), nil)
+			if err != nil {
+				fmt.Printf("could not find allowance of - '%s' over '%s' of '%s'. reason: '%s'\n", args[1], args[2],
+					args[0], err)
+				return nil
+			}
+
+			var out types.Allowance
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdOwnerAllowances queries the allowances an owner granted
+func GetCmdOwnerAllowances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "owner-allowances [address]",
+		Short: "list the allowances an account granted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryOwnerAllowances,
+				args[0]), nil)
+			if err != nil {
+				fmt.Printf("could not query allowances of - '%s'. reason: '%s'\n", args[0], err)
+				return nil
+			}
+
+			var out types.QueryResultAllowances
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdSpenderAllowances queries the allowances granted to a spender
+func GetCmdSpenderAllowances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "spender-allowances [address]",
+		Short: "list the allowances granted to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute,
+				keeper.QuerySpenderAllowances, args[0]), nil)
+			if err != nil {
+				fmt.Printf("could not query allowances granted to - '%s'. reason: '%s'\n", args[0], err)
+				return nil
+			}
+
+			var out types.QueryResultAllowances
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdClawbacks queries the clawback history of a token
+func GetCmdClawbacks(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clawbacks [symbol]",
+		Short: "list the coins clawed back from holders of a token, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryClawbacks, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not query clawbacks of - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.QueryResultClawbacks
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdTransferFee queries the transfer fee of a token
+func GetCmdTransferFee(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-fee [symbol]",
+		Short: "show the fee taken out of the transfers of a token, its exemptions and how much it collected so far",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryTransferFee, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not find transfer fee of - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.TransferFee
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdHolders queries how many accounts hold a token against its holding limits
+func GetCmdHolders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "holders [symbol]",
+		Short: "show how many accounts hold a token and its holding limits",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryHolders, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not find holders of - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.QueryResultHolders
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdHolding queries how much of a token an account holds against the token's balance limit
+func GetCmdHolding(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "holding [symbol] [address]",
+		Short: "show how much of a token an account holds and how much more it may receive",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol, address := args[0], args[1]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, keeper.QueryHolding, symbol,
+				address), nil)
+			if err != nil {
+				fmt.Printf("could not find holding of - '%s' by '%s'. reason: '%s'\n", symbol, address, err)
+				return nil
+			}
+
+			var out types.QueryResultHolding
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdRedenominations queries the redenomination history of a token
+func GetCmdRedenominations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redenominations [symbol]",
+		Short: "list the redenominations of a token, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryRedenominations, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not query redenominations of - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.QueryResultRedenominations
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdConversion queries the conversion window of a token
+func GetCmdConversion(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "conversion [symbol]",
+		Short: "show the conversion window of a token and how much was converted through it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryConversion, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not find conversion window of - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.ConversionWindow
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdConversions queries all conversion windows
+func GetCmdConversions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "conversions",
+		Short: "list the conversion windows, open and closed, with their stats",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryConversions), nil)
+			if err != nil {
+				fmt.Printf("could not query conversions. reason: '%s'\n", err)
+				return nil
+			}
+
+			var out types.QueryResultConversions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdTokenStatus queries the lifecycle status of a token
+func GetCmdTokenStatus(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status [symbol]",
+		Short: "show whether a token is active, deprecated or retired",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryTokenStatus, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not find token - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.QueryResultTokenStatus
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdRetiredToken queries the archived record of a retired token
+func GetCmdRetiredToken(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retired-token [symbol]",
+		Short: "show the archived record of a retired token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryRetiredToken, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not find retired token - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.ArchivedToken
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdCouncil queries the council owning a token
+func GetCmdCouncil(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "council [symbol]",
+		Short: "show the council owning a token, its members and threshold",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryCouncil, symbol), nil)
+			if err != nil {
+				fmt.Printf("could not find council of token - '%s'. reason: '%s'\n", symbol, err)
+				return nil
+			}
+
+			var out types.Council
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdPendingAction queries a pending council action through its id
+func GetCmdPendingAction(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "action [id]",
+		Short: "show a pending council action and its approvals",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			id := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryPendingAction, id), nil)
+			if err != nil {
+				fmt.Printf("could not find action - '%s'. reason: '%s'\n", id, err)
+				return nil
+			}
+
+			var out types.PendingAction
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdPendingActions queries the pending council actions, of every council or of the council of one token
+func GetCmdPendingActions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "actions [symbol]",
+		Short: "list the pending council actions, of one token when a symbol is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			path := fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryPendingActions)
+			if len(args) > 0 {
+				path = fmt.Sprintf("%s/%s", path, args[0])
+			}
+			res, _, err := cliCtx.QueryWithData(path, nil)
+			if err != nil {
+				fmt.Printf("could not query actions. reason: '%s'\n", err)
+				return nil
+			}
+
+			var out types.QueryResultPendingActions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueuedAction queries a timelocked action through its id
+func GetCmdQueuedAction(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "queued-action [id]",
+		Short: "show a timelocked action and when it runs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			id := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryQueuedAction, id), nil)
+			if err != nil {
+				fmt.Printf("could not find queued action - '%s'. reason: '%s'\n", id, err)
+				return nil
+			}
+
+			var out types.QueuedAction
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueuedActions queries the timelocked actions, of every token or of one token
+func GetCmdQueuedActions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "queued-actions [symbol]",
+		Short: "list the timelocked actions waiting to run, of one token when a symbol is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			path := fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryQueuedActions)
+			if len(args) > 0 {
+				path = fmt.Sprintf("%s/%s", path, args[0])
+			}
+			res, _, err := cliCtx.QueryWithData(path, nil)
+			if err != nil {
+				fmt.Printf("could not query queued actions. reason: '%s'\n", err)
+				return nil
+			}
+
+			var out types.QueryResultQueuedActions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdFindGrant queries the grant of a grantee to sign messages of a type over a token for a granter
+func GetCmdFindGrant(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant [granter] [grantee] [symbol] [msg-type]",
+		Short: "show the grant of the grantee to sign messages of a type over a token for the granter",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s/%s", queryRoute, keeper.QueryGrant,
+				args[0], args[1], args[2], args[3]), nil)
+			if err != nil {
+				fmt.Printf("could not find grant of - '%s' to sign '%s' of '%s' for '%s'. reason: '%s'\n", args[1],
+					args[3], args[2], args[0], err)
+				return nil
+			}
+
+			var out types.Grant
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGranterGrants queries the grants a granter issued
+func GetCmdGranterGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "granter-grants [address]",
+		Short: "list the grants an account issued",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryGranterGrants,
+				args[0]), nil)
+			if err != nil {
+				fmt.Printf("could not query grants of - '%s'. reason: '%s'\n", args[0], err)
+				return nil
+			}
+
+			var out types.QueryResultGrants
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGranteeGrants queries the grants issued to a grantee
+func GetCmdGranteeGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grantee-grants [address]",
+		Short: "list the grants issued to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryGranteeGrants,
+				args[0]), nil)
+			if err != nil {
+				fmt.Printf("could not query grants issued to - '%s'. reason: '%s'\n", args[0], err)
+				return nil
+			}
+
+			var out types.QueryResultGrants
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
 }
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdRedenominate is the CLI command for sending a Redenominate transaction
+func GetCmdRedenominate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `redenominate [ABC-123] [numerator] [denominator] --from [account]`,
+		Short: "multiply every balance and the supply of a token by numerator / denominator",
+		Long: `Multiply every balance and the supply of a token by numerator / denominator, eg 2 1 for a 2 for 1 split
+or 1 10 to merge 10 coins into 1. Frozen coins, vesting grants, allowances, the emission schedule, the transfer fee and
+the balance limit are rescaled as well. Amounts are rounded down and the dust is paid to the owner. Tokens with claim
+campaigns can't be redenominated.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			numerator, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("numerator %s is not a positive number: %s", args[1], err)
+			}
+			denominator, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("denominator %s is not a positive number: %s", args[2], err)
+			}
+
+			msg := types.NewMsgRedenominate(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), numerator,
+				denominator)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
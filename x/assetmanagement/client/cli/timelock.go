@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdSetTimelock is the CLI command for sending a SetTimelock transaction
+func GetCmdSetTimelock(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `set-timelock [ABC-123] [delay e.g. 48h] --from [account]`,
+		Short: "delay the rule changes of a token, a delay of 0s turns the timelock off",
+		Long: `Delay the rule changes of a token: messages such as set-transfer-fee or set-council are queued and only
+run once the delay has passed, giving the holders time to react. The owner can cancel a queued action with
+cancel-queued-action until then. Shortening or turning off the timelock is itself delayed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			delay, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("delay %s is not a duration: %s", args[1], err)
+			}
+
+			msg := types.NewMsgSetTimelock(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), delay)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCancelQueuedAction is the CLI command for sending a CancelQueuedAction transaction
+func GetCmdCancelQueuedAction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `cancel-queued-action [id] --from [account]`,
+		Short: "cancel a timelocked action before it runs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("action id %s is not a number: %s", args[0], err)
+			}
+
+			msg := types.NewMsgCancelQueuedAction(getAccountAddress(cliCtx), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
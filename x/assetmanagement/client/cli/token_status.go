@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdSetTokenStatus is the CLI command for sending a SetTokenStatus transaction
+func GetCmdSetTokenStatus(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `set-status [ABC-123] [deprecated|retired] --from [account]`,
+		Short: "deprecate a token or retire it once its whole supply is burnt",
+		Long: `Deprecate a token: it can no longer be minted nor reach new holders, its current holders can still move
+it between themselves and back to the owner. Retire a token once its whole supply is burnt: it is removed and only an
+archived record is kept, its symbol can't be issued again. A token never goes back to an earlier status.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			status, err := types.TokenStatusFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTokenStatus(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), status)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
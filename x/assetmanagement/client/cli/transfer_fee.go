@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdSetTransferFee is the CLI command for sending a SetTransferFee transaction
+func GetCmdSetTransferFee(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `set-transfer-fee [ABC-123] [recipient] --rate [basis points] --min-fee [amount] --max-fee [amount] --from [account]`,
+		Short: "take a fee out of every transfer of a token and pay it to the recipient",
+		Long: `Take a fee out of every transfer of a token and pay it to the recipient. The fee is the rate, in basis
+points, of the transferred amount, raised to the min fee and capped at the max fee, a max fee of 0 leaves it uncapped.
+The recipient of a transfer receives the amount less the fee. A rate and min fee of 0 stop the fee.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			rate := fetchInt64Flag(cmd, "rate")
+			if rate < 0 {
+				return fmt.Errorf("rate %d cannot be negative", rate)
+			}
+
+			msg := types.NewMsgSetTransferFee(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]), uint64(rate),
+				fetchInt64Flag(cmd, "min-fee"), fetchInt64Flag(cmd, "max-fee"), recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupInt64Flag(cmd, "rate", "", 0, "the fee in basis points of the transferred amount, eg 25 for 0.25%", true)
+	setupInt64Flag(cmd, "min-fee", "", 0, "the lowest fee taken out of a transfer", false)
+	setupInt64Flag(cmd, "max-fee", "", 0, "the highest fee taken out of a transfer, 0 for no cap", false)
+
+	return cmd
+}
+
+// GetCmdSetTransferFeeExemption is the CLI command for sending a SetTransferFeeExemption transaction
+func GetCmdSetTransferFeeExemption(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   `exempt-from-fee [ABC-123] [address] --remove --from [account]`,
+		Short: "let transfers of a token from or to an address, eg an exchange, pay no fee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTransferFeeExemption(getAccountAddress(cliCtx), types.NormalizeSymbol(args[0]),
+				address, !fetchBoolFlag(cmd, "remove"))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupBoolFlag(cmd, "remove", "", false, "end the exemption instead", false)
+
+	return cmd
+}
@@ -39,7 +39,40 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdBurnCoins(cdc),
 		GetCmdFreezeCoins(cdc),
 		GetCmdUnfreezeCoins(cdc),
+		GetCmdAirdrop(cdc),
+		GetCmdCreateClaimCampaign(cdc),
+		GetCmdClaim(cdc),
+		GetCmdCreateVestingGrant(cdc),
+		GetCmdClaimVested(cdc),
+		GetCmdSetEmission(cdc),
+		GetCmdPauseEmission(cdc),
+		GetCmdResumeEmission(cdc),
+		GetCmdApprove(cdc),
+		GetCmdTransferFrom(cdc),
+		GetCmdClawback(cdc),
+		GetCmdSetClawbackAdmin(cdc),
+		GetCmdSetTransferFee(cdc),
+		GetCmdSetTransferFeeExemption(cdc),
+		GetCmdSetHoldingLimits(cdc),
+		GetCmdRedenominate(cdc),
+		GetCmdOpenConversion(cdc),
+		GetCmdConvert(cdc),
+		GetCmdSetTokenStatus(cdc),
+		GetCmdTransferOwnership(cdc),
+		GetCmdSetTokenMetadata(cdc),
+		GetCmdPauseToken(cdc),
+		GetCmdResumeToken(cdc),
+		GetCmdSetCouncil(cdc),
+		GetCmdProposeAction(cdc),
+		GetCmdApproveAction(cdc),
+		GetCmdRevokeApproval(cdc),
+		GetCmdSetTimelock(cdc),
+		GetCmdCancelQueuedAction(cdc),
+		GetCmdGrant(cdc),
+		GetCmdRevokeGrant(cdc),
+		GetCmdExec(cdc),
 	)...)
+	txRootCmd.AddCommand(GetCmdBuildClaims())
 
 	return txRootCmd
 }
@@ -115,7 +148,9 @@ func getAccountAddress(cliCtx client.CLIContext) sdk.AccAddress {
 func GetCmdIssueToken(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use: `issue --token-name [name] --total-supply [amount]
-			--symbol [ABC] --mintable --from [account]`,
+			--symbol [ABC] --mintable --clawbackable --clawback-admin [address]
+			--transfer-mode [free|non_transferable|owner_only] --max-balance [amount] --max-holders [count]
+			--from [account]`,
 		Short: "create a new asset",
 		// Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -134,6 +169,21 @@ func GetCmdIssueToken(cdc *codec.Codec) *cobra.Command {
 			log.Debugf("token is mintable? %t", mintable)
 
 			msg := types.NewMsgIssueToken(address, name, symbol, originalSymbol, totalSupply, mintable)
+			msg.Clawbackable = fetchBoolFlag(cmd, "clawbackable")
+			if admin := fetchStringFlag(cmd, "clawback-admin"); admin != "" {
+				clawbackAdmin, err := sdk.AccAddressFromBech32(admin)
+				if err != nil {
+					return err
+				}
+				msg.ClawbackAdmin = clawbackAdmin
+			}
+			msg.TransferMode = types.TransferMode(fetchStringFlag(cmd, "transfer-mode"))
+			msg.MaxBalancePerAccount = fetchInt64Flag(cmd, "max-balance")
+			maxHolders := fetchInt64Flag(cmd, "max-holders")
+			if maxHolders < 0 {
+				return fmt.Errorf("max holders %d cannot be negative", maxHolders)
+			}
+			msg.MaxHolders = uint64(maxHolders)
 			err := msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -148,6 +198,15 @@ func GetCmdIssueToken(cdc *codec.Codec) *cobra.Command {
 	}
 
 	setupBoolFlag(cmd, "mintable", "", false, "is the new token mintable", false)
+	setupBoolFlag(cmd, "clawbackable", "", false,
+		"can the owner claw back coins from holders, this can't be changed after issuance", false)
+	setupStringFlag(cmd, "clawback-admin", "", "",
+		"who besides the owner may claw back coins, requires --clawbackable", false)
+	setupStringFlag(cmd, "transfer-mode", "", string(types.TransferModeFree),
+		"who the coins can move between: free, non_transferable (only the owner hands them out) or owner_only "+
+			"(holders can only send them back to the owner), this can't be changed after issuance", false)
+	setupInt64Flag(cmd, "max-balance", "", 0, "the most any account but the owner may hold, 0 for no limit", false)
+	setupInt64Flag(cmd, "max-holders", "", 0, "the most accounts that may hold the token, 0 for no limit", false)
 	setupStringFlag(cmd, "token-name", "", "", "the name of the new token", true)
 	setupInt64Flag(cmd, "total-supply", "", -1,
 		"what is the total supply for the new token", true)
@@ -343,6 +402,25 @@ func GenerateOrBroadcastMsgs(cliCtx context.CLIContext, txBldr authtypes.TxBuild
 // supplied messages. Finally, it broadcasts the signed transaction to a node
 // and returns the response and/or error
 func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	txBytes, err := SignTxCLI(txBldr, cliCtx, msgs)
+	if err != nil || txBytes == nil {
+		return nil, err
+	}
+
+	// broadcast to a Tendermint node
+	res, err := cliCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, cliCtx.PrintOutput(res)
+}
+
+// SignTxCLI builds and signs a transaction with the supplied messages the same
+// way CompleteAndBroadcastTxCLI does, and returns its bytes without broadcasting
+// them. It returns no bytes when the transaction was only simulated or the
+// user declined to sign it
+func SignTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) ([]byte, error) {
 	txBldr, err := utils.PrepareTxBuilder(txBldr, cliCtx)
 	if err != nil {
 		return nil, err
@@ -396,16 +474,5 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 	}
 
 	// build and sign the transaction
-	txBytes, err := txBldr.BuildAndSign(fromName, passphrase, msgs)
-	if err != nil {
-		return nil, err
-	}
-
-	// broadcast to a Tendermint node
-	res, err := cliCtx.BroadcastTx(txBytes)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res, cliCtx.PrintOutput(res)
+	return txBldr.BuildAndSign(fromName, passphrase, msgs)
 }
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCmdCreateVestingGrant is the CLI command for sending a CreateVestingGrant transaction
+func GetCmdCreateVestingGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: `create-vesting-grant [beneficiary] --symbol [ABC-123] --total [amount]
+			--start-time [2020-01-01T00:00:00Z] --cliff-time [2020-07-01T00:00:00Z] --end-time [2021-01-01T00:00:00Z]
+			--from [account]`,
+		Short: "lock coins of a token that vest to the beneficiary between the start and end time",
+		Long: `Lock coins of a token that vest to the beneficiary between the start and end time. Nothing can be
+claimed before the cliff time, which defaults to the start time.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			address := getAccountAddress(cliCtx)
+			beneficiary, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			symbol := types.NormalizeSymbol(fetchStringFlag(cmd, "symbol"))
+			startTime, err := time.Parse(time.RFC3339, fetchStringFlag(cmd, "start-time"))
+			if err != nil {
+				return err
+			}
+			cliffTime := startTime
+			if cliff := fetchStringFlag(cmd, "cliff-time"); cliff != "" {
+				cliffTime, err = time.Parse(time.RFC3339, cliff)
+				if err != nil {
+					return err
+				}
+			}
+			endTime, err := time.Parse(time.RFC3339, fetchStringFlag(cmd, "end-time"))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingGrant(address, beneficiary, symbol, fetchInt64Flag(cmd, "total"),
+				startTime.UTC(), cliffTime.UTC(), endTime.UTC())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	setupStringFlag(cmd, "symbol", "", "",
+		"what is the shorthand symbol, eg ABC-123, for the existing token", true)
+	setupInt64Flag(cmd, "total", "", -1, "how many coins to lock in the grant", true)
+	setupStringFlag(cmd, "start-time", "", "", "when the coins start vesting, in RFC3339 format", true)
+	setupStringFlag(cmd, "cliff-time", "", "",
+		"before when nothing can be claimed, in RFC3339 format, defaults to the start time", false)
+	setupStringFlag(cmd, "end-time", "", "", "when all coins have vested, in RFC3339 format", true)
+
+	return cmd
+}
+
+// GetCmdClaimVested is the CLI command for sending a ClaimVested transaction
+func GetCmdClaimVested(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   `claim-vested [grant-id] --from [account]`,
+		Short: "release the coins of a vesting grant that have vested to the sending beneficiary",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid vesting grant id '%s': %v", args[0], err)
+			}
+
+			msg := types.NewMsgClaimVested(getAccountAddress(cliCtx), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
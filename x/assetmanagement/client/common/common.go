@@ -0,0 +1,35 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/keeper"
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// QueryToken looks up a token at the height set on the context. When the node is not trusted the token
+// is read straight from the store so that its Merkle proof is verified against the block header.
+func QueryToken(cliCtx context.CLIContext, queryRoute string, symbol string) (types.Token, int64, error) {
+	var token types.Token
+
+	if !cliCtx.TrustNode {
+		res, height, err := cliCtx.QueryStore(types.TokenKey(types.NormalizeSymbol(symbol)), queryRoute)
+		if err != nil {
+			return token, height, err
+		}
+		if len(res) == 0 {
+			return token, height, fmt.Errorf("could not find Token for symbol '%s'", symbol)
+		}
+		err = cliCtx.Codec.UnmarshalBinaryBare(res, &token)
+		return token, height, err
+	}
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryToken, symbol), nil)
+	if err != nil {
+		return token, height, err
+	}
+	err = cliCtx.Codec.UnmarshalJSON(res, &token)
+	return token, height, err
+}
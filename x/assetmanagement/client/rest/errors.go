@@ -0,0 +1,130 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// httpStatuses maps the module error codes to the HTTP status returned for them
+var httpStatuses = map[sdk.CodeType]int{
+	types.CodeTokenSymbolDoesNotExist:  http.StatusNotFound,
+	types.CodeTokenSymbolAlreadyExists: http.StatusConflict,
+	types.CodeInvalidSymbol:            http.StatusBadRequest,
+	types.CodeInvalidTokenName:         http.StatusBadRequest,
+	types.CodeInvalidAmount:            http.StatusBadRequest,
+	types.CodeInvalidOwner:             http.StatusForbidden,
+	types.CodeTokenNotMintable:         http.StatusUnprocessableEntity,
+	types.CodeInsufficientCoins:        http.StatusUnprocessableEntity,
+	types.CodeInsufficientFrozenCoins:  http.StatusUnprocessableEntity,
+	types.CodeUnknownAccount:           http.StatusNotFound,
+	types.CodeAccountCannotFreeze:      http.StatusUnprocessableEntity,
+	types.CodeMissingOwner:             http.StatusInternalServerError,
+	types.CodeInvalidToken:             http.StatusBadRequest,
+	types.CodeInvalidRecipients:        http.StatusBadRequest,
+	types.CodeInvalidCampaign:          http.StatusBadRequest,
+	types.CodeCampaignDoesNotExist:     http.StatusNotFound,
+	types.CodeCampaignExpired:          http.StatusGone,
+	types.CodeInvalidClaimProof:        http.StatusForbidden,
+	types.CodeAlreadyClaimed:           http.StatusConflict,
+	types.CodeInvalidVestingGrant:      http.StatusBadRequest,
+	types.CodeVestingGrantDoesNotExist: http.StatusNotFound,
+	types.CodeNotBeneficiary:           http.StatusForbidden,
+	types.CodeNothingVested:            http.StatusUnprocessableEntity,
+	types.CodeInvalidEmission:          http.StatusBadRequest,
+	types.CodeEmissionDoesNotExist:     http.StatusNotFound,
+	types.CodeInvalidAllowance:         http.StatusBadRequest,
+	types.CodeAllowanceDoesNotExist:    http.StatusNotFound,
+	types.CodeAllowanceExpired:         http.StatusGone,
+	types.CodeInsufficientAllowance:    http.StatusUnprocessableEntity,
+	types.CodeTokenNotClawbackable:     http.StatusUnprocessableEntity,
+	types.CodeInvalidClawback:          http.StatusBadRequest,
+	types.CodeNotClawbackAuthority:     http.StatusForbidden,
+	types.CodeInvalidTransferFee:       http.StatusBadRequest,
+	types.CodeTransferFeeDoesNotExist:  http.StatusNotFound,
+	types.CodeTransferNotAllowed:       http.StatusUnprocessableEntity,
+	types.CodeInvalidHoldingLimits:     http.StatusBadRequest,
+	types.CodeMaxBalanceExceeded:       http.StatusUnprocessableEntity,
+	types.CodeMaxHoldersReached:        http.StatusUnprocessableEntity,
+	types.CodeInvalidRedenomination:    http.StatusBadRequest,
+	types.CodeInvalidConversion:        http.StatusBadRequest,
+	types.CodeConversionDoesNotExist:   http.StatusNotFound,
+	types.CodeTokenDisabled:            http.StatusUnprocessableEntity,
+	types.CodeInvalidTokenStatus:       http.StatusBadRequest,
+	types.CodeTokenDeprecated:          http.StatusUnprocessableEntity,
+	types.CodeInvalidCouncil:           http.StatusBadRequest,
+	types.CodeCouncilDoesNotExist:      http.StatusNotFound,
+	types.CodeNotCouncilMember:         http.StatusForbidden,
+	types.CodeInvalidAction:            http.StatusBadRequest,
+	types.CodeActionDoesNotExist:       http.StatusNotFound,
+	types.CodeInvalidTimelock:          http.StatusBadRequest,
+	types.CodeQueuedActionDoesNotExist: http.StatusNotFound,
+	types.CodeInvalidGrant:             http.StatusBadRequest,
+	types.CodeGrantDoesNotExist:        http.StatusNotFound,
+	types.CodeGrantExpired:             http.StatusGone,
+	types.CodeInsufficientGrant:        http.StatusUnprocessableEntity,
+	types.CodeTokenPaused:              http.StatusUnprocessableEntity,
+}
+
+// sdkHTTPStatuses maps the codes of the SDK's root codespace, which the ante handler and bank return, to the HTTP
+// status returned for them
+var sdkHTTPStatuses = map[sdk.CodeType]int{
+	sdk.CodeTxDecode:          http.StatusBadRequest,
+	sdk.CodeInvalidSequence:   http.StatusUnauthorized,
+	sdk.CodeUnauthorized:      http.StatusUnauthorized,
+	sdk.CodeInsufficientFunds: http.StatusUnprocessableEntity,
+	sdk.CodeUnknownRequest:    http.StatusBadRequest,
+	sdk.CodeInvalidAddress:    http.StatusBadRequest,
+	sdk.CodeInvalidPubKey:     http.StatusUnauthorized,
+	sdk.CodeUnknownAddress:    http.StatusNotFound,
+	sdk.CodeInsufficientCoins: http.StatusUnprocessableEntity,
+	sdk.CodeInvalidCoins:      http.StatusBadRequest,
+	sdk.CodeOutOfGas:          http.StatusUnprocessableEntity,
+	sdk.CodeMemoTooLarge:      http.StatusBadRequest,
+	sdk.CodeInsufficientFee:   http.StatusPaymentRequired,
+	sdk.CodeNoSignatures:      http.StatusUnauthorized,
+}
+
+// abciError is the JSON log of a failed query or transaction
+type abciError struct {
+	Codespace sdk.CodespaceType `json:"codespace"`
+	Code      sdk.CodeType      `json:"code"`
+	Message   string            `json:"message"`
+}
+
+// httpStatus returns the HTTP status for an error, falling back to the given status when it isn't a module or SDK
+// error
+func httpStatus(err error, fallback int) int {
+	var codespace sdk.CodespaceType
+	var code sdk.CodeType
+	if sdkErr, ok := err.(sdk.Error); ok {
+		codespace, code = sdkErr.Codespace(), sdkErr.Code()
+	} else {
+		var logged abciError
+		if json.Unmarshal([]byte(err.Error()), &logged) != nil {
+			return fallback
+		}
+		codespace, code = logged.Codespace, logged.Code
+	}
+
+	var statuses map[sdk.CodeType]int
+	switch codespace {
+	case types.DefaultCodespace:
+		statuses = httpStatuses
+	case sdk.CodespaceRoot:
+		statuses = sdkHTTPStatuses
+	}
+	if status, ok := statuses[code]; ok {
+		return status
+	}
+	return fallback
+}
+
+// writeErrorResponse writes the error with the HTTP status matching its module error code
+func writeErrorResponse(w http.ResponseWriter, fallback int, err error) {
+	rest.WriteErrorResponse(w, httpStatus(err, fallback), err.Error())
+}
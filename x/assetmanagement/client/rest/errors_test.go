@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestWriteErrorResponse(t *testing.T) {
+	_, _, addr := types.KeyTestPubAddr()
+
+	cases := []struct {
+		err    error
+		status int
+	}{
+		// returned by the keeper or ValidateBasic
+		{types.ErrTokenSymbolDoesNotExist(types.DefaultCodespace, "abc123"), http.StatusNotFound},
+		{types.ErrInvalidOwner(types.DefaultCodespace, addr, "abc123"), http.StatusForbidden},
+		{sdk.ErrUnauthorized("signature verification failed"), http.StatusUnauthorized},
+		// the log of a failed gas simulation
+		{errors.New(types.ErrGrantDoesNotExist(types.DefaultCodespace, addr, addr, "abc123", "mint_coins").ABCILog()),
+			http.StatusNotFound},
+		{errors.New(sdk.ErrUnknownAddress("account does not exist").ABCILog()), http.StatusNotFound},
+		{errors.New(sdk.ErrUnauthorized("wrong sequence").ABCILog()), http.StatusUnauthorized},
+		// anything else keeps the fallback
+		{errors.New("decoding bech32 failed"), http.StatusBadRequest},
+		{sdk.NewError("other", types.CodeTokenSymbolDoesNotExist, "not ours"), http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		writeErrorResponse(w, http.StatusBadRequest, tc.err)
+		require.Equal(t, tc.status, w.Code, tc.err.Error())
+		require.Contains(t, w.Body.String(), "error")
+	}
+}
@@ -9,7 +9,15 @@ import (
 )
 
 const (
-	restName = "token"
+	restName     = "token"
+	restAddress  = "address"
+	restCampaign = "campaign"
+	restGrant    = "grant"
+	restSpender  = "spender"
+	restAction   = "action"
+	restQueued   = "queued"
+	restGrantee  = "grantee"
+	restMsgType  = "msgtype"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -17,6 +25,37 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	// Queries
 	r.HandleFunc(fmt.Sprintf("/%s/tokens", storeName), symbolsHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}", storeName, restName), findTokenHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/supply", storeName, restName), supplyHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/accounts/{%s}", storeName, restAddress), accountHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/campaigns", storeName), campaignsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/campaigns/{%s}", storeName, restCampaign), campaignHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/vesting-grants/{%s}", storeName, restGrant), vestingGrantHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/vesting-grants", storeName, restName), tokenVestingGrantsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/accounts/{%s}/vesting-grants", storeName, restAddress), beneficiaryVestingGrantsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/emission", storeName, restName), emissionHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/emissions", storeName), emissionsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/allowances/{%s}/{%s}/{%s}", storeName, restAddress, restSpender, restName), allowanceHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/accounts/{%s}/allowances", storeName, restAddress), ownerAllowancesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/accounts/{%s}/spender-allowances", storeName, restAddress), spenderAllowancesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/clawbacks", storeName, restName), clawbacksHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/transfer-fee", storeName, restName), transferFeeHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/holders", storeName, restName), holdersHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/holders/{%s}", storeName, restName, restAddress), holdingHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/redenominations", storeName, restName), redenominationsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/conversion", storeName, restName), conversionHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/conversions", storeName), conversionsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/status", storeName, restName), tokenStatusHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/retired-tokens/{%s}", storeName, restName), retiredTokenHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/council", storeName, restName), councilHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/actions", storeName, restName), tokenPendingActionsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/actions", storeName), pendingActionsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/actions/{%s}", storeName, restAction), pendingActionHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/queued-actions", storeName, restName), tokenQueuedActionsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/queued-actions", storeName), queuedActionsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/queued-actions/{%s}", storeName, restQueued), queuedActionHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/grants/{%s}/{%s}/{%s}/{%s}", storeName, restAddress, restGrantee, restName, restMsgType), grantHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/accounts/{%s}/grants", storeName, restAddress), granterGrantsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/accounts/{%s}/grantee-grants", storeName, restAddress), granteeGrantsHandler(cliCtx, storeName)).Methods("GET")
 
 	// Transactions
 	r.HandleFunc(fmt.Sprintf("/%s/tokens", storeName), issueTokenHandler(cliCtx)).Methods("POST")
@@ -24,5 +63,35 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(fmt.Sprintf("/%s/tokens/burn", storeName), burnHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/tokens/freeze", storeName), freezeHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/tokens/unfreeze", storeName), unfreezeHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/distribute", storeName), distributeHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/campaigns", storeName), createClaimCampaignHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/campaigns/{%s}/claim", storeName, restCampaign), claimHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/vesting-grants", storeName), createVestingGrantHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/vesting-grants/{%s}/claim", storeName, restGrant), claimVestedHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/emission", storeName, restName), setEmissionHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/emission/paused", storeName, restName), setEmissionPausedHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/allowances", storeName), approveHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/allowances/transfer", storeName), transferFromHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/clawbacks", storeName, restName), clawbackHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/clawback-admin", storeName, restName), setClawbackAdminHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/transfer-fee", storeName, restName), setTransferFeeHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/transfer-fee/exemptions", storeName, restName), setTransferFeeExemptionHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/holding-limits", storeName, restName), setHoldingLimitsHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/redenominations", storeName, restName), redenominateHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/conversion", storeName, restName), openConversionHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/conversion/convert", storeName, restName), convertHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/status", storeName, restName), setTokenStatusHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/owner", storeName, restName), transferOwnershipHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/metadata", storeName, restName), setTokenMetadataHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/paused", storeName, restName), setTokenPausedHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/council", storeName, restName), setCouncilHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/actions", storeName, restName), proposeActionHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/actions/{%s}/approve", storeName, restAction), approveActionHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/actions/{%s}/revoke", storeName, restAction), revokeApprovalHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/tokens/{%s}/timelock", storeName, restName), setTimelockHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/queued-actions/{%s}/cancel", storeName, restQueued), cancelQueuedActionHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/grants", storeName), grantTxHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/grants/revoke", storeName), revokeGrantHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/grants/exec", storeName), execHandler(cliCtx)).Methods("POST")
 
 }
@@ -2,14 +2,19 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/dev10/fantom-asset-management/x/assetmanagement/rand"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gorilla/mux"
 
 	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
 )
@@ -21,6 +26,11 @@ type issueTokenReq struct {
 	Symbol        string       `json:"symbol"`
 	TotalSupply   int64        `json:"total_supply"`
 	Mintable      bool         `json:"mintable"`
+	Clawbackable  bool         `json:"clawbackable"`
+	ClawbackAdmin string       `json:"clawback_admin"`
+	TransferMode  string       `json:"transfer_mode"`
+	MaxBalance    int64        `json:"max_balance_per_account"`
+	MaxHolders    uint64       `json:"max_holders"`
 }
 
 func issueTokenHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -39,7 +49,7 @@ func issueTokenHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		addr, err := sdk.AccAddressFromBech32(req.SourceAddress)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
@@ -47,13 +57,24 @@ func issueTokenHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		// create the message
 		msg := types.NewMsgIssueToken(addr, req.Name, symbol, req.Symbol, req.TotalSupply, req.Mintable)
+		msg.Clawbackable = req.Clawbackable
+		msg.TransferMode = types.TransferMode(req.TransferMode)
+		msg.MaxBalancePerAccount = req.MaxBalance
+		msg.MaxHolders = req.MaxHolders
+		if req.ClawbackAdmin != "" {
+			msg.ClawbackAdmin, err = sdk.AccAddressFromBech32(req.ClawbackAdmin)
+			if err != nil {
+				writeErrorResponse(w, http.StatusBadRequest, err)
+				return
+			}
+		}
 		err = msg.ValidateBasic()
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
 
@@ -79,7 +100,7 @@ func mintHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		addr, err := sdk.AccAddressFromBech32(req.Owner)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
@@ -87,11 +108,11 @@ func mintHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := types.NewMsgMintCoins(req.Amount, req.Symbol, addr)
 		err = msg.ValidateBasic()
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
 
@@ -117,7 +138,7 @@ func burnHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		addr, err := sdk.AccAddressFromBech32(req.Owner)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
@@ -125,11 +146,11 @@ func burnHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := types.NewMsgBurnCoins(req.Amount, req.Symbol, addr)
 		err = msg.ValidateBasic()
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
 
@@ -155,7 +176,7 @@ func freezeHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		addr, err := sdk.AccAddressFromBech32(req.Owner)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
@@ -163,11 +184,11 @@ func freezeHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := types.NewMsgFreezeCoins(req.Amount, req.Symbol, addr)
 		err = msg.ValidateBasic()
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
 
@@ -193,7 +214,7 @@ func unfreezeHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		addr, err := sdk.AccAddressFromBech32(req.Owner)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
@@ -201,10 +222,1355 @@ func unfreezeHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := types.NewMsgUnfreezeCoins(req.Amount, req.Symbol, addr)
 		err = msg.ValidateBasic()
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type recipientReq struct {
+	Address string `json:"address"`
+	Amount  int64  `json:"amount"`
+}
+
+type distributeReq struct {
+	BaseReq    rest.BaseReq   `json:"base_req"`
+	Sender     string         `json:"sender"`
+	Symbol     string         `json:"symbol"`
+	Recipients []recipientReq `json:"recipients"`
+}
+
+func distributeHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req distributeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Sender)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		recipients := make([]types.Recipient, 0, len(req.Recipients))
+		for _, recipient := range req.Recipients {
+			recipientAddr, err := sdk.AccAddressFromBech32(recipient.Address)
+			if err != nil {
+				writeErrorResponse(w, http.StatusBadRequest, err)
+				return
+			}
+			recipients = append(recipients, types.NewRecipient(recipientAddr, recipient.Amount))
+		}
+
+		// create the message
+		msg := types.NewMsgDistribute(addr, req.Symbol, recipients)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type createClaimCampaignReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Owner      string       `json:"owner"`
+	Symbol     string       `json:"symbol"`
+	MerkleRoot string       `json:"merkle_root"`
+	Deposit    int64        `json:"deposit"`
+	EndTime    time.Time    `json:"end_time"`
+}
+
+func createClaimCampaignHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createClaimCampaignReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCreateClaimCampaign(addr, req.Symbol, req.MerkleRoot, req.Deposit, req.EndTime)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type claimReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Claimant string       `json:"claimant"`
+	Amount   int64        `json:"amount"`
+	Proof    []string     `json:"proof"`
+}
+
+func claimHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[restCampaign], 10, 64)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		var req claimReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Claimant)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgClaim(addr, id, req.Amount, req.Proof)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type createVestingGrantReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Owner       string       `json:"owner"`
+	Beneficiary string       `json:"beneficiary"`
+	Symbol      string       `json:"symbol"`
+	Total       int64        `json:"total"`
+	StartTime   time.Time    `json:"start_time"`
+	CliffTime   time.Time    `json:"cliff_time"`
+	EndTime     time.Time    `json:"end_time"`
+}
+
+func createVestingGrantHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createVestingGrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		beneficiary, err := sdk.AccAddressFromBech32(req.Beneficiary)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCreateVestingGrant(addr, beneficiary, req.Symbol, req.Total, req.StartTime, req.CliffTime,
+			req.EndTime)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type claimVestedReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Beneficiary string       `json:"beneficiary"`
+}
+
+func claimVestedHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[restGrant], 10, 64)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		var req claimVestedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Beneficiary)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgClaimVested(addr, id)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setEmissionReq struct {
+	BaseReq       rest.BaseReq `json:"base_req"`
+	Owner         string       `json:"owner"`
+	Recipient     string       `json:"recipient"`
+	Rate          int64        `json:"rate"`
+	StartHeight   int64        `json:"start_height"`
+	EndHeight     int64        `json:"end_height"`
+	DecayInterval int64        `json:"decay_interval"`
+	DecayRate     sdk.Dec      `json:"decay_rate"`
+	MaxSupply     int64        `json:"max_supply"`
+}
+
+func setEmissionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setEmissionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		decayRate := req.DecayRate
+		if decayRate.Int == nil {
+			decayRate = sdk.ZeroDec()
+		}
+
+		// create the message
+		msg := types.NewMsgSetEmission(addr, symbol, recipient, req.Rate, req.StartHeight, req.EndHeight,
+			req.DecayInterval, decayRate, req.MaxSupply)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setEmissionPausedReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+	Paused  bool         `json:"paused"`
+}
+
+func setEmissionPausedHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setEmissionPausedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetEmissionPaused(addr, symbol, req.Paused)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type approveReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+	Spender string       `json:"spender"`
+	Symbol  string       `json:"symbol"`
+	Amount  int64        `json:"amount"`
+	Expiry  time.Time    `json:"expiry"`
+}
+
+func approveHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req approveReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		spender, err := sdk.AccAddressFromBech32(req.Spender)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgApprove(addr, spender, types.NormalizeSymbol(req.Symbol), req.Amount, req.Expiry)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
 			return
 		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
+
+type transferFromReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Spender string       `json:"spender"`
+	From    string       `json:"from"`
+	To      string       `json:"to"`
+	Symbol  string       `json:"symbol"`
+	Amount  int64        `json:"amount"`
+}
+
+func transferFromHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req transferFromReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Spender)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		from, err := sdk.AccAddressFromBech32(req.From)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		to, err := sdk.AccAddressFromBech32(req.To)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgTransferFrom(addr, from, to, types.NormalizeSymbol(req.Symbol), req.Amount)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type clawbackReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Authority string       `json:"authority"`
+	From      string       `json:"from"`
+	Recipient string       `json:"recipient"`
+	Amount    int64        `json:"amount"`
+	Reason    string       `json:"reason"`
+}
+
+func clawbackHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req clawbackReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Authority)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		from, err := sdk.AccAddressFromBech32(req.From)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		var recipient sdk.AccAddress
+		if req.Recipient != "" {
+			recipient, err = sdk.AccAddressFromBech32(req.Recipient)
+			if err != nil {
+				writeErrorResponse(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		// create the message
+		msg := types.NewMsgClawback(addr, symbol, from, recipient, req.Amount, req.Reason)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setClawbackAdminReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+	Admin   string       `json:"admin"`
+}
+
+func setClawbackAdminHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setClawbackAdminReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		var admin sdk.AccAddress
+		if req.Admin != "" {
+			admin, err = sdk.AccAddressFromBech32(req.Admin)
+			if err != nil {
+				writeErrorResponse(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		// create the message
+		msg := types.NewMsgSetClawbackAdmin(addr, symbol, admin)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTransferFeeReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Owner     string       `json:"owner"`
+	Rate      uint64       `json:"rate"`
+	MinFee    int64        `json:"min_fee"`
+	MaxFee    int64        `json:"max_fee"`
+	Recipient string       `json:"recipient"`
+}
+
+func setTransferFeeHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setTransferFeeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetTransferFee(addr, symbol, req.Rate, req.MinFee, req.MaxFee, recipient)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTransferFeeExemptionReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+	Address string       `json:"address"`
+	Exempt  bool         `json:"exempt"`
+}
+
+func setTransferFeeExemptionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setTransferFeeExemptionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		address, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetTransferFeeExemption(addr, symbol, address, req.Exempt)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setHoldingLimitsReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Owner      string       `json:"owner"`
+	MaxBalance int64        `json:"max_balance_per_account"`
+	MaxHolders uint64       `json:"max_holders"`
+}
+
+func setHoldingLimitsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setHoldingLimitsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetHoldingLimits(addr, symbol, req.MaxBalance, req.MaxHolders)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type redenominateReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Owner       string       `json:"owner"`
+	Numerator   uint64       `json:"numerator"`
+	Denominator uint64       `json:"denominator"`
+}
+
+func redenominateHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req redenominateReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRedenominate(addr, symbol, req.Numerator, req.Denominator)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type openConversionReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Owner       string       `json:"owner"`
+	Target      string       `json:"target"`
+	Numerator   uint64       `json:"numerator"`
+	Denominator uint64       `json:"denominator"`
+	Deadline    time.Time    `json:"deadline"`
+}
+
+func openConversionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		source := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req openConversionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgOpenConversion(addr, source, types.NormalizeSymbol(req.Target), req.Numerator,
+			req.Denominator, req.Deadline.UTC())
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type convertReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Holder  string       `json:"holder"`
+	Amount  int64        `json:"amount"`
+}
+
+func convertHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		source := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req convertReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Holder)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgConvert(addr, source, req.Amount)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTokenStatusReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+	Status  string       `json:"status"`
+}
+
+func setTokenStatusHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setTokenStatusReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		status, err := types.TokenStatusFromString(req.Status)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetTokenStatus(addr, symbol, status)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type transferOwnershipReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Owner    string       `json:"owner"`
+	NewOwner string       `json:"new_owner"`
+}
+
+func transferOwnershipHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req transferOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgTransferOwnership(addr, symbol, newOwner)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTokenMetadataReq struct {
+	BaseReq        rest.BaseReq `json:"base_req"`
+	Owner          string       `json:"owner"`
+	Name           string       `json:"name"`
+	OriginalSymbol string       `json:"original_symbol"`
+}
+
+func setTokenMetadataHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setTokenMetadataReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetTokenMetadata(addr, symbol, req.Name, req.OriginalSymbol)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTokenPausedReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+	Paused  bool         `json:"paused"`
+}
+
+func setTokenPausedHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setTokenPausedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetTokenPaused(addr, symbol, req.Paused)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setCouncilReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Owner     string       `json:"owner"`
+	Members   []string     `json:"members"`
+	Threshold uint64       `json:"threshold"`
+}
+
+func setCouncilHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setCouncilReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		members := make([]sdk.AccAddress, len(req.Members))
+		for i, bech := range req.Members {
+			members[i], err = sdk.AccAddressFromBech32(bech)
+			if err != nil {
+				writeErrorResponse(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		// create the message
+		msg := types.NewMsgSetCouncil(addr, symbol, members, req.Threshold)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type proposeActionReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Proposer string       `json:"proposer"`
+	Action   sdk.Msg      `json:"action"`
+	Expiry   time.Time    `json:"expiry"`
+}
+
+func proposeActionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req proposeActionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Proposer)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgProposeAction(addr, symbol, req.Action, req.Expiry.UTC())
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type councilVoteReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Member  string       `json:"member"`
+}
+
+func approveActionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return councilVoteHandler(cliCtx, func(member sdk.AccAddress, id uint64) sdk.Msg {
+		return types.NewMsgApproveAction(member, id)
+	})
+}
+
+func revokeApprovalHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return councilVoteHandler(cliCtx, func(member sdk.AccAddress, id uint64) sdk.Msg {
+		return types.NewMsgRevokeApproval(member, id)
+	})
+}
+
+func councilVoteHandler(cliCtx context.CLIContext, newMsg func(member sdk.AccAddress, id uint64) sdk.Msg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[restAction], 10, 64)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		var req councilVoteReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Member)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := newMsg(addr, id)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTimelockReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+	Delay   string       `json:"delay"` // eg 48h, 0s turns the timelock off
+}
+
+func setTimelockHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := types.NormalizeSymbol(mux.Vars(r)[restName])
+
+		var req setTimelockReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		delay, err := time.ParseDuration(req.Delay)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetTimelock(addr, symbol, delay)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type cancelQueuedActionReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+}
+
+func cancelQueuedActionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[restQueued], 10, 64)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		var req cancelQueuedActionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCancelQueuedAction(addr, id)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type grantReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Granter    string       `json:"granter"`
+	Grantee    string       `json:"grantee"`
+	Symbol     string       `json:"symbol"`
+	MsgType    string       `json:"msg_type"`
+	SpendLimit int64        `json:"spend_limit"`
+	Expiry     time.Time    `json:"expiry"`
+}
+
+func grantTxHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req grantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Granter)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgGrant(addr, grantee, types.NormalizeSymbol(req.Symbol), req.MsgType, req.SpendLimit,
+			req.Expiry.UTC())
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokeGrantReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Granter string       `json:"granter"`
+	Grantee string       `json:"grantee"`
+	Symbol  string       `json:"symbol"`
+	MsgType string       `json:"msg_type"`
+}
+
+func revokeGrantHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokeGrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Granter)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevokeGrant(addr, grantee, types.NormalizeSymbol(req.Symbol), req.MsgType)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type execReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Grantee string       `json:"grantee"`
+	Msgs    []sdk.Msg    `json:"msgs"`
+}
+
+func execHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req execReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Grantee)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgExec(addr, req.Msgs)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// writeGenerateStdTxResponse writes the unsigned tx of the messages like utils.WriteGenerateStdTxResponse, except
+// that a failed gas simulation is answered with the HTTP status matching its error code
+func writeGenerateStdTxResponse(w http.ResponseWriter, cliCtx context.CLIContext, br rest.BaseReq, msgs []sdk.Msg) {
+	gasAdj, ok := rest.ParseFloat64OrReturnBadRequest(w, br.GasAdjustment, flags.DefaultGasAdjustment)
+	if !ok {
+		return
+	}
+
+	simAndExec, gas, err := flags.ParseGas(br.Gas)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	txBldr := authtypes.NewTxBuilder(
+		utils.GetTxEncoder(cliCtx.Codec), br.AccountNumber, br.Sequence, gas, gasAdj,
+		br.Simulate, br.ChainID, br.Memo, br.Fees, br.GasPrices,
+	)
+
+	if br.Simulate || simAndExec {
+		if gasAdj < 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid gas adjustment")
+			return
+		}
+
+		txBldr, err = utils.EnrichWithGas(txBldr, cliCtx, msgs)
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		if br.Simulate {
+			rest.WriteSimulationResponse(w, cliCtx.Codec, txBldr.Gas())
+			return
+		}
+	}
+
+	stdMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rest.PostProcessResponseBare(w, cliCtx, authtypes.NewStdTx(stdMsg.Msgs, stdMsg.Fee, nil, stdMsg.Memo))
+}
@@ -4,18 +4,73 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// FrozenBalance is the total of coins frozen on a single account
+type FrozenBalance struct {
+	Address sdk.AccAddress `json:"address"`
+	Coins   sdk.Coins      `json:"coins"`
+}
+
 type GenesisState struct {
-	TokenRecords []Token `json:"token_records"`
+	TokenRecords       []Token            `json:"token_records"`
+	FrozenBalances     []FrozenBalance    `json:"frozen_balances"`
+	ClaimCampaigns     []ClaimCampaign    `json:"claim_campaigns"`
+	ClaimRecords       []ClaimRecord      `json:"claim_records"`
+	NextCampaignID     uint64             `json:"next_campaign_id"`
+	VestingGrants      []VestingGrant     `json:"vesting_grants"`
+	NextVestingGrantID uint64             `json:"next_vesting_grant_id"`
+	Emissions          []EmissionSchedule `json:"emissions"`
+	Allowances         []Allowance        `json:"allowances"`
+	Clawbacks          []ClawbackRecord   `json:"clawbacks"`
+	NextClawbackID     uint64             `json:"next_clawback_id"`
+	TransferFees       []TransferFee      `json:"transfer_fees"`
+	Redenominations    []Redenomination   `json:"redenominations"`
+	Conversions        []ConversionWindow `json:"conversions"`
+	RetiredTokens      []ArchivedToken    `json:"retired_tokens"`
+	Councils           []Council          `json:"councils"`
+	PendingActions     []PendingAction    `json:"pending_actions"`
+	NextActionID       uint64             `json:"next_action_id"`
+	QueuedActions      []QueuedAction     `json:"queued_actions"`
+	NextQueuedActionID uint64             `json:"next_queued_action_id"`
+	Grants             []Grant            `json:"grants"`
 }
 
-func NewGenesisState(tokenRecords []Token) GenesisState {
-	return GenesisState{TokenRecords: nil}
+func NewGenesisState(tokenRecords []Token, frozenBalances []FrozenBalance) GenesisState {
+	return GenesisState{
+		TokenRecords:       tokenRecords,
+		FrozenBalances:     frozenBalances,
+		ClaimCampaigns:     []ClaimCampaign{},
+		ClaimRecords:       []ClaimRecord{},
+		NextCampaignID:     1,
+		VestingGrants:      []VestingGrant{},
+		NextVestingGrantID: 1,
+		Emissions:          []EmissionSchedule{},
+		Allowances:         []Allowance{},
+		Clawbacks:          []ClawbackRecord{},
+		NextClawbackID:     1,
+		TransferFees:       []TransferFee{},
+		Redenominations:    []Redenomination{},
+		Conversions:        []ConversionWindow{},
+		RetiredTokens:      []ArchivedToken{},
+		Councils:           []Council{},
+		PendingActions:     []PendingAction{},
+		NextActionID:       1,
+		QueuedActions:      []QueuedAction{},
+		NextQueuedActionID: 1,
+		Grants:             []Grant{},
+	}
 }
 
 func ValidateGenesis(data GenesisState) error {
+	symbols := make(map[string]bool, len(data.TokenRecords))
+	mintable := make(map[string]bool, len(data.TokenRecords))
+	clawbackable := make(map[string]bool, len(data.TokenRecords))
+	disabled := make(map[string]bool, len(data.TokenRecords))
+	owners := make(map[string]sdk.AccAddress, len(data.TokenRecords))
 	for _, record := range data.TokenRecords {
 		if record.Owner == nil {
 			return fmt.Errorf("invalid TokenRecord: Value: %s. Error: Missing Owner", record.Symbol)
@@ -23,33 +78,572 @@ func ValidateGenesis(data GenesisState) error {
 		if record.Symbol == "" {
 			return fmt.Errorf("invalid TokenRecord: Owner: %s. Error: Missing Symbol", record.Owner)
 		}
+		if symbols[record.Symbol] {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Duplicate Symbol", record.Symbol)
+		}
+		symbols[record.Symbol] = true
+		mintable[record.Symbol] = record.Mintable
 		if record.TotalSupply == nil || record.TotalSupply.Len() == 0 {
 			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Missing TotalSupply", record.Symbol)
 		}
+		if !record.TotalSupply.IsValid() {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Invalid TotalSupply %s", record.Symbol,
+				record.TotalSupply)
+		}
+		if record.TotalSupply.Len() != 1 || record.TotalSupply[0].Denom != record.Symbol {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: TotalSupply %s is not denominated in the symbol",
+				record.Symbol, record.TotalSupply)
+		}
 		if record.Name == "" {
 			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Missing Name", record.Symbol)
 		}
 		if record.OriginalSymbol == "" {
 			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Missing OriginalSymbol", record.Symbol)
 		}
+		if !record.ClawbackAdmin.Empty() && !record.Clawbackable {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: ClawbackAdmin requires Clawbackable",
+				record.Symbol)
+		}
+		if !record.TransferMode.IsValid() {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Invalid TransferMode", record.Symbol)
+		}
+		if !record.Status.IsValid() || record.Status == TokenStatusRetired {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Invalid Status %s", record.Symbol, record.Status)
+		}
+		if err := ValidateHoldingLimits(record.MaxBalancePerAccount, record.MaxHolders); err != nil {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Invalid holding limits", record.Symbol)
+		}
+		if ValidateTimelockDelay(record.Timelock) != nil {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: Invalid Timelock %s", record.Symbol,
+				record.Timelock)
+		}
+		clawbackable[record.Symbol] = record.Clawbackable
+		disabled[record.Symbol] = record.Disabled
+		owners[record.Symbol] = record.Owner
+	}
+
+	// the history records of retired tokens are kept, so their symbols remain known to them
+	known := make(map[string]bool, len(data.TokenRecords)+len(data.RetiredTokens))
+	for symbol := range symbols {
+		known[symbol] = true
+	}
+	retired := make(map[string]bool, len(data.RetiredTokens))
+	for _, archived := range data.RetiredTokens {
+		symbol := archived.Token.Symbol
+		if symbol == "" {
+			return fmt.Errorf("invalid RetiredToken: Owner: %s. Error: Missing Symbol", archived.Token.Owner)
+		}
+		if known[symbol] {
+			return fmt.Errorf("invalid RetiredToken: Symbol: %s. Error: Duplicate Symbol", symbol)
+		}
+		known[symbol] = true
+		retired[symbol] = true
+		if archived.Token.Status != TokenStatusRetired {
+			return fmt.Errorf("invalid RetiredToken: Symbol: %s. Error: Status must be %s", symbol, TokenStatusRetired)
+		}
+		if !archived.Token.TotalSupply.AmountOf(symbol).IsZero() {
+			return fmt.Errorf("invalid RetiredToken: Symbol: %s. Error: TotalSupply %s must be zero", symbol,
+				archived.Token.TotalSupply)
+		}
+		mintable[symbol] = archived.Token.Mintable
+		clawbackable[symbol] = archived.Token.Clawbackable
+		disabled[symbol] = archived.Token.Disabled
+	}
+
+	addresses := make(map[string]bool, len(data.FrozenBalances))
+	for _, balance := range data.FrozenBalances {
+		if balance.Address.Empty() {
+			return fmt.Errorf("invalid FrozenBalance: Coins: %s. Error: Missing Address", balance.Coins)
+		}
+		if addresses[balance.Address.String()] {
+			return fmt.Errorf("invalid FrozenBalance: Address: %s. Error: Duplicate Address", balance.Address)
+		}
+		addresses[balance.Address.String()] = true
+		if !balance.Coins.IsValid() {
+			return fmt.Errorf("invalid FrozenBalance: Address: %s. Error: Invalid Coins %s", balance.Address,
+				balance.Coins)
+		}
+	}
+
+	campaigns := make(map[uint64]bool, len(data.ClaimCampaigns))
+	for _, campaign := range data.ClaimCampaigns {
+		if campaigns[campaign.ID] {
+			return fmt.Errorf("invalid ClaimCampaign: ID: %d. Error: Duplicate ID", campaign.ID)
+		}
+		campaigns[campaign.ID] = true
+		if campaign.ID == 0 || campaign.ID >= data.NextCampaignID {
+			return fmt.Errorf("invalid ClaimCampaign: ID: %d. Error: ID must be between 1 and NextCampaignID %d",
+				campaign.ID, data.NextCampaignID)
+		}
+		if campaign.Owner.Empty() {
+			return fmt.Errorf("invalid ClaimCampaign: ID: %d. Error: Missing Owner", campaign.ID)
+		}
+		if !symbols[campaign.Symbol] {
+			return fmt.Errorf("invalid ClaimCampaign: ID: %d. Error: Unknown Symbol %s", campaign.ID, campaign.Symbol)
+		}
+		if ValidateMerkleRoot(campaign.MerkleRoot) != nil {
+			return fmt.Errorf("invalid ClaimCampaign: ID: %d. Error: Invalid MerkleRoot %s", campaign.ID,
+				campaign.MerkleRoot)
+		}
+		if !campaign.Deposit.IsValid() || !campaign.Deposit.IsZero() &&
+			(campaign.Deposit.Len() != 1 || campaign.Deposit[0].Denom != campaign.Symbol) {
+			return fmt.Errorf("invalid ClaimCampaign: ID: %d. Error: Invalid Deposit %s", campaign.ID, campaign.Deposit)
+		}
+		if campaign.EndTime.IsZero() {
+			return fmt.Errorf("invalid ClaimCampaign: ID: %d. Error: Missing EndTime", campaign.ID)
+		}
+	}
+
+	claimed := make(map[string]bool, len(data.ClaimRecords))
+	for _, record := range data.ClaimRecords {
+		if !campaigns[record.CampaignID] {
+			return fmt.Errorf("invalid ClaimRecord: Address: %s. Error: Unknown CampaignID %d", record.Address,
+				record.CampaignID)
+		}
+		if record.Address.Empty() {
+			return fmt.Errorf("invalid ClaimRecord: CampaignID: %d. Error: Missing Address", record.CampaignID)
+		}
+		key := fmt.Sprintf("%d/%s", record.CampaignID, record.Address)
+		if claimed[key] {
+			return fmt.Errorf("invalid ClaimRecord: CampaignID: %d, Address: %s. Error: Duplicate Claim",
+				record.CampaignID, record.Address)
+		}
+		claimed[key] = true
+	}
+
+	grants := make(map[uint64]bool, len(data.VestingGrants))
+	for _, grant := range data.VestingGrants {
+		if grants[grant.ID] {
+			return fmt.Errorf("invalid VestingGrant: ID: %d. Error: Duplicate ID", grant.ID)
+		}
+		grants[grant.ID] = true
+		if grant.ID == 0 || grant.ID >= data.NextVestingGrantID {
+			return fmt.Errorf("invalid VestingGrant: ID: %d. Error: ID must be between 1 and NextVestingGrantID %d",
+				grant.ID, data.NextVestingGrantID)
+		}
+		if grant.Owner.Empty() {
+			return fmt.Errorf("invalid VestingGrant: ID: %d. Error: Missing Owner", grant.ID)
+		}
+		if grant.Beneficiary.Empty() {
+			return fmt.Errorf("invalid VestingGrant: ID: %d. Error: Missing Beneficiary", grant.ID)
+		}
+		if !symbols[grant.Symbol] {
+			return fmt.Errorf("invalid VestingGrant: ID: %d. Error: Unknown Symbol %s", grant.ID, grant.Symbol)
+		}
+		if !grant.Total.IsValid() || grant.Total.Len() != 1 || grant.Total[0].Denom != grant.Symbol {
+			return fmt.Errorf("invalid VestingGrant: ID: %d. Error: Invalid Total %s", grant.ID, grant.Total)
+		}
+		if !grant.Released.IsValid() || !grant.Total.IsAllGTE(grant.Released) || grant.Locked().IsZero() {
+			return fmt.Errorf("invalid VestingGrant: ID: %d. Error: Invalid Released %s of %s", grant.ID,
+				grant.Released, grant.Total)
+		}
+		if ValidateVestingSchedule(grant.StartTime, grant.CliffTime, grant.EndTime) != nil {
+			return fmt.Errorf("invalid VestingGrant: ID: %d. Error: Invalid Schedule %s - %s - %s", grant.ID,
+				grant.StartTime, grant.CliffTime, grant.EndTime)
+		}
+	}
+
+	emissions := make(map[string]bool, len(data.Emissions))
+	for _, emission := range data.Emissions {
+		if emissions[emission.Symbol] {
+			return fmt.Errorf("invalid Emission: Symbol: %s. Error: Duplicate Symbol", emission.Symbol)
+		}
+		emissions[emission.Symbol] = true
+		if !symbols[emission.Symbol] {
+			return fmt.Errorf("invalid Emission: Symbol: %s. Error: Unknown Symbol", emission.Symbol)
+		}
+		if !mintable[emission.Symbol] {
+			return fmt.Errorf("invalid Emission: Symbol: %s. Error: Token is not mintable", emission.Symbol)
+		}
+		if emission.Validate() != nil {
+			return fmt.Errorf("invalid Emission: Symbol: %s. Error: Invalid Schedule", emission.Symbol)
+		}
+	}
+
+	allowances := make(map[string]bool, len(data.Allowances))
+	for _, allowance := range data.Allowances {
+		key := fmt.Sprintf("%s/%s/%s", allowance.Owner, allowance.Spender, allowance.Symbol)
+		if allowances[key] {
+			return fmt.Errorf("invalid Allowance: Owner: %s, Spender: %s, Symbol: %s. Error: Duplicate Allowance",
+				allowance.Owner, allowance.Spender, allowance.Symbol)
+		}
+		allowances[key] = true
+		if !symbols[allowance.Symbol] {
+			return fmt.Errorf("invalid Allowance: Owner: %s, Spender: %s, Symbol: %s. Error: Unknown Symbol",
+				allowance.Owner, allowance.Spender, allowance.Symbol)
+		}
+		if allowance.Owner.Empty() || allowance.Spender.Empty() || allowance.Owner.Equals(allowance.Spender) {
+			return fmt.Errorf("invalid Allowance: Owner: %s, Spender: %s, Symbol: %s. Error: Invalid Owner or Spender",
+				allowance.Owner, allowance.Spender, allowance.Symbol)
+		}
+		if allowance.Validate() != nil {
+			return fmt.Errorf("invalid Allowance: Owner: %s, Spender: %s, Symbol: %s. Error: Invalid Amount %s",
+				allowance.Owner, allowance.Spender, allowance.Symbol, allowance.Amount)
+		}
+	}
+
+	clawbacks := make(map[uint64]bool, len(data.Clawbacks))
+	for _, record := range data.Clawbacks {
+		if clawbacks[record.ID] {
+			return fmt.Errorf("invalid Clawback: ID: %d. Error: Duplicate ID", record.ID)
+		}
+		clawbacks[record.ID] = true
+		if record.ID == 0 || record.ID >= data.NextClawbackID {
+			return fmt.Errorf("invalid Clawback: ID: %d. Error: ID must be between 1 and NextClawbackID %d",
+				record.ID, data.NextClawbackID)
+		}
+		if !clawbackable[record.Symbol] {
+			return fmt.Errorf("invalid Clawback: ID: %d. Error: Symbol %s is unknown or not clawbackable", record.ID,
+				record.Symbol)
+		}
+		if record.Authority.Empty() || record.From.Empty() {
+			return fmt.Errorf("invalid Clawback: ID: %d. Error: Missing Authority or From", record.ID)
+		}
+		if !record.Amount.IsValid() || record.Amount.Empty() {
+			return fmt.Errorf("invalid Clawback: ID: %d. Error: Invalid Amount %s", record.ID, record.Amount)
+		}
+		if ValidateClawbackReason(record.Reason) != nil {
+			return fmt.Errorf("invalid Clawback: ID: %d. Error: Invalid Reason", record.ID)
+		}
+	}
+
+	fees := make(map[string]bool, len(data.TransferFees))
+	for _, fee := range data.TransferFees {
+		if fees[fee.Symbol] {
+			return fmt.Errorf("invalid TransferFee: Symbol: %s. Error: Duplicate Symbol", fee.Symbol)
+		}
+		fees[fee.Symbol] = true
+		if !symbols[fee.Symbol] {
+			return fmt.Errorf("invalid TransferFee: Symbol: %s. Error: Unknown Symbol", fee.Symbol)
+		}
+		if fee.Validate() != nil {
+			return fmt.Errorf("invalid TransferFee: Symbol: %s. Error: Invalid Fee", fee.Symbol)
+		}
+	}
+
+	for _, record := range data.Redenominations {
+		if !known[record.Symbol] {
+			return fmt.Errorf("invalid Redenomination: Symbol: %s. Error: Unknown Symbol", record.Symbol)
+		}
+		if record.Owner.Empty() {
+			return fmt.Errorf("invalid Redenomination: Symbol: %s. Error: Missing Owner", record.Symbol)
+		}
+		if ValidateRedenominationRatio(record.Numerator, record.Denominator) != nil {
+			return fmt.Errorf("invalid Redenomination: Symbol: %s. Error: Invalid Ratio %d/%d", record.Symbol,
+				record.Numerator, record.Denominator)
+		}
+	}
+
+	sources := make(map[string]bool, len(data.Conversions))
+	for _, window := range data.Conversions {
+		if sources[window.Source] {
+			return fmt.Errorf("invalid Conversion: Source: %s. Error: Duplicate Source", window.Source)
+		}
+		sources[window.Source] = true
+		if !known[window.Source] || !known[window.Target] {
+			return fmt.Errorf("invalid Conversion: Source: %s. Error: Unknown Source or Target %s", window.Source,
+				window.Target)
+		}
+		if !window.Closed && (retired[window.Source] || retired[window.Target]) {
+			return fmt.Errorf("invalid Conversion: Source: %s. Error: Open window of a retired token", window.Source)
+		}
+		if !mintable[window.Target] {
+			return fmt.Errorf("invalid Conversion: Source: %s. Error: Target %s is not mintable", window.Source,
+				window.Target)
+		}
+		if window.Validate() != nil {
+			return fmt.Errorf("invalid Conversion: Source: %s. Error: Invalid Window", window.Source)
+		}
+		if window.Closed != disabled[window.Source] {
+			return fmt.Errorf("invalid Conversion: Source: %s. Error: Source must be disabled once the window is closed",
+				window.Source)
+		}
+	}
+
+	councils := make(map[string]Council, len(data.Councils))
+	for _, council := range data.Councils {
+		if _, ok := councils[council.Symbol]; ok {
+			return fmt.Errorf("invalid Council: Symbol: %s. Error: Duplicate Symbol", council.Symbol)
+		}
+		councils[council.Symbol] = council
+		if !symbols[council.Symbol] {
+			return fmt.Errorf("invalid Council: Symbol: %s. Error: Unknown Symbol", council.Symbol)
+		}
+		if !council.Address().Equals(owners[council.Symbol]) {
+			return fmt.Errorf("invalid Council: Symbol: %s. Error: Token must be owned by the council address %s",
+				council.Symbol, council.Address())
+		}
+		if council.Validate() != nil {
+			return fmt.Errorf("invalid Council: Symbol: %s. Error: Invalid Members or Threshold", council.Symbol)
+		}
+	}
+
+	actions := make(map[uint64]bool, len(data.PendingActions))
+	for _, action := range data.PendingActions {
+		if actions[action.ID] {
+			return fmt.Errorf("invalid PendingAction: ID: %d. Error: Duplicate ID", action.ID)
+		}
+		actions[action.ID] = true
+		if action.ID == 0 || action.ID >= data.NextActionID {
+			return fmt.Errorf("invalid PendingAction: ID: %d. Error: ID must be between 1 and NextActionID %d",
+				action.ID, data.NextActionID)
+		}
+		council, ok := councils[action.Symbol]
+		if !ok {
+			return fmt.Errorf("invalid PendingAction: ID: %d. Error: Symbol %s has no council", action.ID,
+				action.Symbol)
+		}
+		if ValidateAction(action.Symbol, action.Action) != nil {
+			return fmt.Errorf("invalid PendingAction: ID: %d. Error: Invalid Action", action.ID)
+		}
+		if action.Expiry.IsZero() {
+			return fmt.Errorf("invalid PendingAction: ID: %d. Error: Missing Expiry", action.ID)
+		}
+		// an action with enough approvals has already run
+		if len(action.Approvals) == 0 || uint64(len(action.Approvals)) >= council.Threshold {
+			return fmt.Errorf("invalid PendingAction: ID: %d. Error: %d approvals, must be between 1 and the "+
+				"threshold %d", action.ID, len(action.Approvals), council.Threshold)
+		}
+		for i, member := range action.Approvals {
+			if !council.IsMember(member) || containsApproval(action.Approvals[:i], member) {
+				return fmt.Errorf("invalid PendingAction: ID: %d. Error: Invalid approval of %s", action.ID, member)
+			}
+		}
+	}
+
+	queued := make(map[uint64]bool, len(data.QueuedActions))
+	for _, action := range data.QueuedActions {
+		if queued[action.ID] {
+			return fmt.Errorf("invalid QueuedAction: ID: %d. Error: Duplicate ID", action.ID)
+		}
+		queued[action.ID] = true
+		if action.ID == 0 || action.ID >= data.NextQueuedActionID {
+			return fmt.Errorf("invalid QueuedAction: ID: %d. Error: ID must be between 1 and NextQueuedActionID %d",
+				action.ID, data.NextQueuedActionID)
+		}
+		if !symbols[action.Symbol] {
+			return fmt.Errorf("invalid QueuedAction: ID: %d. Error: Unknown Symbol %s", action.ID, action.Symbol)
+		}
+		if action.Action == nil || action.Action.ValidateBasic() != nil {
+			return fmt.Errorf("invalid QueuedAction: ID: %d. Error: Invalid Action", action.ID)
+		}
+		symbol, owner, ok := TimelockedSymbol(action.Action)
+		if !ok || symbol != action.Symbol || !owner.Equals(action.Owner) || !owner.Equals(owners[symbol]) {
+			return fmt.Errorf("invalid QueuedAction: ID: %d. Error: Action must be a rule change of %s by its owner",
+				action.ID, action.Symbol)
+		}
+		if action.ETA.IsZero() {
+			return fmt.Errorf("invalid QueuedAction: ID: %d. Error: Missing ETA", action.ID)
+		}
+	}
+
+	granted := make(map[string]bool, len(data.Grants))
+	for _, grant := range data.Grants {
+		key := fmt.Sprintf("%s/%s/%s/%s", grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType)
+		if granted[key] {
+			return fmt.Errorf("invalid Grant: Granter: %s, Grantee: %s, Symbol: %s, MsgType: %s. Error: Duplicate Grant",
+				grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType)
+		}
+		granted[key] = true
+		if !symbols[grant.Symbol] {
+			return fmt.Errorf("invalid Grant: Granter: %s, Grantee: %s, Symbol: %s, MsgType: %s. Error: Unknown Symbol",
+				grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType)
+		}
+		if grant.Validate() != nil {
+			return fmt.Errorf("invalid Grant: Granter: %s, Grantee: %s, Symbol: %s, MsgType: %s. Error: Invalid Grant",
+				grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType)
+		}
+	}
+	return nil
+}
+
+func containsApproval(approvals []sdk.AccAddress, member sdk.AccAddress) bool {
+	for _, approval := range approvals {
+		if approval.Equals(member) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateGenesisAccounts cross-checks the module genesis against the accounts section. Every token's total supply
+// must equal what the accounts hold plus what is frozen, the frozen pool must hold exactly the frozen coins and the
+// claims pool exactly the deposits left in claim campaigns and the vesting pool exactly the coins locked in grants
+func ValidateGenesisAccounts(data GenesisState, accounts genaccounts.GenesisState) error {
+	poolAddress := supply.NewModuleAddress(FrozenPoolName)
+	claimsPoolAddress := supply.NewModuleAddress(ClaimsPoolName)
+	held := sdk.NewCoins()
+	pool := sdk.NewCoins()
+	claimsPool := sdk.NewCoins()
+	vestingPoolAddress := supply.NewModuleAddress(VestingPoolName)
+	vestingPool := sdk.NewCoins()
+	known := make(map[string]bool, len(accounts))
+	for _, account := range accounts {
+		known[account.Address.String()] = true
+		if account.Address.Equals(poolAddress) {
+			pool = account.Coins
+			continue
+		}
+		if account.Address.Equals(claimsPoolAddress) {
+			claimsPool = account.Coins
+		}
+		if account.Address.Equals(vestingPoolAddress) {
+			vestingPool = account.Coins
+		}
+		held = held.Add(account.Coins)
+	}
+
+	deposits := sdk.NewCoins()
+	for _, campaign := range data.ClaimCampaigns {
+		deposits = deposits.Add(campaign.Deposit)
+	}
+	if !claimsPool.IsEqual(deposits) {
+		return fmt.Errorf("invalid ClaimCampaigns: claims pool holds %s but campaigns have %s left", claimsPool, deposits)
+	}
+
+	locked := sdk.NewCoins()
+	for _, grant := range data.VestingGrants {
+		locked = locked.Add(grant.Locked())
+	}
+	if !vestingPool.IsEqual(locked) {
+		return fmt.Errorf("invalid VestingGrants: vesting pool holds %s but grants have %s locked", vestingPool, locked)
+	}
+
+	frozen := sdk.NewCoins()
+	for _, balance := range data.FrozenBalances {
+		if !known[balance.Address.String()] {
+			return fmt.Errorf("invalid FrozenBalance: Address: %s. Error: Account missing from genesis accounts",
+				balance.Address)
+		}
+		frozen = frozen.Add(balance.Coins)
+	}
+
+	for _, record := range data.TokenRecords {
+		total := held.AmountOf(record.Symbol).Add(frozen.AmountOf(record.Symbol))
+		if !total.Equal(record.TotalSupply.AmountOf(record.Symbol)) {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: TotalSupply %s doesn't match the %s%s held by accounts",
+				record.Symbol, record.TotalSupply, total, record.Symbol)
+		}
+		if !pool.AmountOf(record.Symbol).Equal(frozen.AmountOf(record.Symbol)) {
+			return fmt.Errorf("invalid TokenRecord: Symbol: %s. Error: frozen pool holds %s%s but %s%s is frozen",
+				record.Symbol, pool.AmountOf(record.Symbol), record.Symbol, frozen.AmountOf(record.Symbol), record.Symbol)
+		}
 	}
 	return nil
 }
 
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		TokenRecords: []Token{},
+		TokenRecords:       []Token{},
+		FrozenBalances:     []FrozenBalance{},
+		ClaimCampaigns:     []ClaimCampaign{},
+		ClaimRecords:       []ClaimRecord{},
+		NextCampaignID:     1,
+		VestingGrants:      []VestingGrant{},
+		NextVestingGrantID: 1,
+		Emissions:          []EmissionSchedule{},
+		Allowances:         []Allowance{},
+		Clawbacks:          []ClawbackRecord{},
+		NextClawbackID:     1,
+		TransferFees:       []TransferFee{},
+		Redenominations:    []Redenomination{},
+		Conversions:        []ConversionWindow{},
+		RetiredTokens:      []ArchivedToken{},
+		Councils:           []Council{},
+		PendingActions:     []PendingAction{},
+		NextActionID:       1,
+		QueuedActions:      []QueuedAction{},
+		NextQueuedActionID: 1,
+		Grants:             []Grant{},
 	}
 }
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
 	for _, record := range data.TokenRecords {
 		record := record
+		if keeper.IsSymbolPresent(ctx, record.Symbol) {
+			panic(fmt.Sprintf("failed to set token for symbol: %s. Error: duplicate symbol", record.Symbol))
+		}
 		err := keeper.SetToken(ctx, record.Symbol, &record)
 		if err != nil {
 			panic(fmt.Sprintf("failed to set token for symbol: %s. Error: %s", record.Symbol, err))
 		}
 	}
+	for _, balance := range data.FrozenBalances {
+		err := keeper.SetFrozenCoins(ctx, balance.Address, balance.Coins)
+		if err != nil {
+			panic(fmt.Sprintf("failed to set frozen coins for address: %s. Error: %s", balance.Address, err))
+		}
+	}
+	// the holders aren't exported, they follow from the balances
+	keeper.RebuildHolders(ctx)
+	for _, campaign := range data.ClaimCampaigns {
+		keeper.SetClaimCampaign(ctx, campaign)
+	}
+	for _, record := range data.ClaimRecords {
+		keeper.SetClaimed(ctx, record.CampaignID, record.Address)
+	}
+	// genesis files from before claim campaigns have no next ID
+	if data.NextCampaignID == 0 {
+		data.NextCampaignID = 1
+	}
+	keeper.SetNextCampaignID(ctx, data.NextCampaignID)
+	for _, grant := range data.VestingGrants {
+		keeper.SetVestingGrant(ctx, grant)
+	}
+	// genesis files from before vesting grants have no next ID
+	if data.NextVestingGrantID == 0 {
+		data.NextVestingGrantID = 1
+	}
+	keeper.SetNextVestingGrantID(ctx, data.NextVestingGrantID)
+	for _, emission := range data.Emissions {
+		keeper.SetEmission(ctx, emission)
+	}
+	for _, allowance := range data.Allowances {
+		keeper.SetAllowance(ctx, allowance)
+	}
+	for _, record := range data.Clawbacks {
+		keeper.SetClawbackRecord(ctx, record)
+	}
+	// genesis files from before clawbacks have no next ID
+	if data.NextClawbackID == 0 {
+		data.NextClawbackID = 1
+	}
+	keeper.SetNextClawbackID(ctx, data.NextClawbackID)
+	for _, fee := range data.TransferFees {
+		keeper.SetTransferFee(ctx, fee)
+	}
+	for _, record := range data.Redenominations {
+		keeper.AppendRedenomination(ctx, record)
+	}
+	for _, window := range data.Conversions {
+		keeper.SetConversionWindow(ctx, window)
+	}
+	for _, archived := range data.RetiredTokens {
+		keeper.SetArchivedToken(ctx, archived)
+	}
+	for _, council := range data.Councils {
+		keeper.SetCouncil(ctx, council)
+	}
+	for _, action := range data.PendingActions {
+		keeper.SetPendingAction(ctx, action)
+	}
+	// genesis files from before councils have no next ID
+	if data.NextActionID == 0 {
+		data.NextActionID = 1
+	}
+	keeper.SetNextActionID(ctx, data.NextActionID)
+	for _, action := range data.QueuedActions {
+		keeper.SetQueuedAction(ctx, action)
+	}
+	// genesis files from before timelocks have no next ID
+	if data.NextQueuedActionID == 0 {
+		data.NextQueuedActionID = 1
+	}
+	keeper.SetNextQueuedActionID(ctx, data.NextQueuedActionID)
+	for _, grant := range data.Grants {
+		keeper.SetGrant(ctx, grant)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -66,5 +660,118 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		records = append(records, *token)
 
 	}
-	return GenesisState{TokenRecords: records}
+
+	// every account able to hold frozen coins is exported, even with nothing frozen, so it is restored as the same type
+	var balances []FrozenBalance
+	k.IterateFrozenCoins(ctx, func(address sdk.AccAddress, frozen sdk.Coins) bool {
+		balances = append(balances, FrozenBalance{Address: address, Coins: frozen})
+		return false
+	})
+
+	campaigns := []ClaimCampaign{}
+	k.IterateClaimCampaigns(ctx, func(campaign ClaimCampaign) bool {
+		campaigns = append(campaigns, campaign)
+		return false
+	})
+	claims := []ClaimRecord{}
+	k.IterateClaimRecords(ctx, func(record ClaimRecord) bool {
+		claims = append(claims, record)
+		return false
+	})
+
+	grants := []VestingGrant{}
+	k.IterateVestingGrants(ctx, func(grant VestingGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	emissions := []EmissionSchedule{}
+	k.IterateEmissions(ctx, func(emission EmissionSchedule) bool {
+		emissions = append(emissions, emission)
+		return false
+	})
+
+	allowances := []Allowance{}
+	k.IterateAllowances(ctx, func(allowance Allowance) bool {
+		allowances = append(allowances, allowance)
+		return false
+	})
+
+	clawbacks := []ClawbackRecord{}
+	k.IterateClawbackRecords(ctx, func(record ClawbackRecord) bool {
+		clawbacks = append(clawbacks, record)
+		return false
+	})
+
+	fees := []TransferFee{}
+	k.IterateTransferFees(ctx, func(fee TransferFee) bool {
+		fees = append(fees, fee)
+		return false
+	})
+
+	redenominations := []Redenomination{}
+	k.IterateRedenominations(ctx, func(record Redenomination) bool {
+		redenominations = append(redenominations, record)
+		return false
+	})
+
+	conversions := []ConversionWindow{}
+	k.IterateConversionWindows(ctx, func(window ConversionWindow) bool {
+		conversions = append(conversions, window)
+		return false
+	})
+
+	retired := []ArchivedToken{}
+	k.IterateArchivedTokens(ctx, func(archived ArchivedToken) bool {
+		retired = append(retired, archived)
+		return false
+	})
+
+	councils := []Council{}
+	k.IterateCouncils(ctx, func(council Council) bool {
+		councils = append(councils, council)
+		return false
+	})
+
+	actions := []PendingAction{}
+	k.IteratePendingActions(ctx, func(action PendingAction) bool {
+		actions = append(actions, action)
+		return false
+	})
+
+	queued := []QueuedAction{}
+	k.IterateQueuedActions(ctx, func(action QueuedAction) bool {
+		queued = append(queued, action)
+		return false
+	})
+
+	granted := []Grant{}
+	k.IterateGrants(ctx, func(grant Grant) bool {
+		granted = append(granted, grant)
+		return false
+	})
+
+	return GenesisState{
+		TokenRecords:       records,
+		FrozenBalances:     balances,
+		ClaimCampaigns:     campaigns,
+		ClaimRecords:       claims,
+		NextCampaignID:     k.GetNextCampaignID(ctx),
+		VestingGrants:      grants,
+		NextVestingGrantID: k.GetNextVestingGrantID(ctx),
+		Emissions:          emissions,
+		Allowances:         allowances,
+		Clawbacks:          clawbacks,
+		NextClawbackID:     k.GetNextClawbackID(ctx),
+		TransferFees:       fees,
+		Redenominations:    redenominations,
+		Conversions:        conversions,
+		RetiredTokens:      retired,
+		Councils:           councils,
+		PendingActions:     actions,
+		NextActionID:       k.GetNextActionID(ctx),
+		QueuedActions:      queued,
+		NextQueuedActionID: k.GetNextQueuedActionID(ctx),
+		Grants:             granted,
+	}
 }
@@ -0,0 +1,449 @@
+package assetmanagement
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.NoError(t, ValidateGenesis(NewGenesisState([]Token{token}, nil)))
+
+	duplicate := NewGenesisState([]Token{token, token}, nil)
+	require.Error(t, ValidateGenesis(duplicate))
+
+	badDenom := token
+	badDenom.Symbol = "TST-123"
+	badDenom.TotalSupply = sdk.Coins{sdk.Coin{Denom: "TST-123", Amount: sdk.NewInt(1000)}}
+	require.Error(t, ValidateGenesis(NewGenesisState([]Token{badDenom}, nil)))
+
+	wrongDenom := token
+	wrongDenom.TotalSupply = sdk.NewCoins(sdk.NewInt64Coin("abc123", 1000))
+	require.Error(t, ValidateGenesis(NewGenesisState([]Token{wrongDenom}, nil)))
+
+	legacyMode := token
+	legacyMode.TransferMode = ""
+	require.NoError(t, ValidateGenesis(NewGenesisState([]Token{legacyMode}, nil)))
+	badMode := token
+	badMode.TransferMode = "soulbound"
+	require.Error(t, ValidateGenesis(NewGenesisState([]Token{badMode}, nil)))
+	limited := token
+	limited.MaxBalancePerAccount = 100
+	limited.MaxHolders = 10
+	require.NoError(t, ValidateGenesis(NewGenesisState([]Token{limited}, nil)))
+	badLimits := token
+	badLimits.MaxBalancePerAccount = -1
+	require.Error(t, ValidateGenesis(NewGenesisState([]Token{badLimits}, nil)))
+
+	frozen := []FrozenBalance{{Address: owner, Coins: sdk.NewCoins(sdk.NewInt64Coin("tst123", 10))}}
+	require.NoError(t, ValidateGenesis(NewGenesisState([]Token{token}, frozen)))
+	require.Error(t, ValidateGenesis(NewGenesisState([]Token{token}, append(frozen, frozen...))))
+}
+
+func TestValidateGenesisAccounts(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	frozen := []FrozenBalance{{Address: owner, Coins: sdk.NewCoins(sdk.NewInt64Coin("tst123", 400))}}
+	pool := genaccounts.NewGenesisAccountRaw(supply.NewModuleAddress(FrozenPoolName),
+		sdk.NewCoins(sdk.NewInt64Coin("tst123", 400)), sdk.NewCoins(), 0, 0, FrozenPoolName)
+
+	accounts := genaccounts.GenesisState{
+		genaccounts.NewGenesisAccountRaw(owner, sdk.NewCoins(sdk.NewInt64Coin("tst123", 600)), sdk.NewCoins(), 0, 0, ""),
+		pool,
+	}
+	require.NoError(t, ValidateGenesisAccounts(NewGenesisState([]Token{token}, frozen), accounts))
+
+	// supply doesn't add up once the frozen balance is dropped
+	require.Error(t, ValidateGenesisAccounts(NewGenesisState([]Token{token}, nil), accounts))
+
+	// frozen coins without the matching pool balance
+	require.Error(t, ValidateGenesisAccounts(NewGenesisState([]Token{token}, frozen), accounts[:1]))
+
+	// frozen balance for an unknown account
+	stranger := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	unknown := []FrozenBalance{{Address: stranger, Coins: sdk.NewCoins(sdk.NewInt64Coin("tst123", 400))}}
+	require.Error(t, ValidateGenesisAccounts(NewGenesisState([]Token{token}, unknown), accounts))
+}
+
+func TestValidateGenesisClaimCampaigns(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	campaign := NewClaimCampaign(1, owner, "tst123", strings.Repeat("ab", 32), 300,
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	data := NewGenesisState([]Token{token}, nil)
+	data.ClaimCampaigns = []ClaimCampaign{campaign}
+	data.ClaimRecords = []ClaimRecord{{CampaignID: 1, Address: owner}}
+	data.NextCampaignID = 2
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.ClaimCampaigns = []ClaimCampaign{campaign}
+		broken.ClaimRecords = []ClaimRecord{{CampaignID: 1, Address: owner}}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.NextCampaignID = 1 })
+	invalid(func(data *GenesisState) { data.ClaimCampaigns = append(data.ClaimCampaigns, campaign) })
+	invalid(func(data *GenesisState) { data.ClaimCampaigns[0].Symbol = "abc123" })
+	invalid(func(data *GenesisState) { data.ClaimCampaigns[0].MerkleRoot = "abcd" })
+	invalid(func(data *GenesisState) { data.ClaimCampaigns[0].Deposit = sdk.NewCoins(sdk.NewInt64Coin("abc123", 1)) })
+	invalid(func(data *GenesisState) { data.ClaimRecords[0].CampaignID = 2 })
+	invalid(func(data *GenesisState) { data.ClaimRecords = append(data.ClaimRecords, data.ClaimRecords[0]) })
+
+	// the claims pool must hold what is left of the deposits
+	claimsPool := genaccounts.NewGenesisAccountRaw(supply.NewModuleAddress(ClaimsPoolName),
+		sdk.NewCoins(sdk.NewInt64Coin("tst123", 300)), sdk.NewCoins(), 0, 0, ClaimsPoolName)
+	accounts := genaccounts.GenesisState{
+		genaccounts.NewGenesisAccountRaw(owner, sdk.NewCoins(sdk.NewInt64Coin("tst123", 700)), sdk.NewCoins(), 0, 0, ""),
+		claimsPool,
+	}
+	require.NoError(t, ValidateGenesisAccounts(data, accounts))
+	require.Error(t, ValidateGenesisAccounts(data, accounts[:1]))
+}
+
+func TestValidateGenesisVestingGrants(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	beneficiary := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	grant := NewVestingGrant(1, owner, beneficiary, "tst123", 300, start, start, start.Add(time.Hour))
+	grant.Released = sdk.NewCoins(sdk.NewInt64Coin("tst123", 100))
+
+	data := NewGenesisState([]Token{token}, nil)
+	data.VestingGrants = []VestingGrant{grant}
+	data.NextVestingGrantID = 2
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.VestingGrants = []VestingGrant{grant}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.NextVestingGrantID = 1 })
+	invalid(func(data *GenesisState) { data.VestingGrants = append(data.VestingGrants, grant) })
+	invalid(func(data *GenesisState) { data.VestingGrants[0].Symbol = "abc123" })
+	invalid(func(data *GenesisState) { data.VestingGrants[0].Beneficiary = nil })
+	invalid(func(data *GenesisState) { data.VestingGrants[0].Released = data.VestingGrants[0].Total })
+	invalid(func(data *GenesisState) { data.VestingGrants[0].EndTime = start })
+
+	// the vesting pool must hold what is still locked
+	vestingPool := genaccounts.NewGenesisAccountRaw(supply.NewModuleAddress(VestingPoolName),
+		sdk.NewCoins(sdk.NewInt64Coin("tst123", 200)), sdk.NewCoins(), 0, 0, VestingPoolName)
+	accounts := genaccounts.GenesisState{
+		genaccounts.NewGenesisAccountRaw(owner, sdk.NewCoins(sdk.NewInt64Coin("tst123", 700)), sdk.NewCoins(), 0, 0, ""),
+		genaccounts.NewGenesisAccountRaw(beneficiary, sdk.NewCoins(sdk.NewInt64Coin("tst123", 100)), sdk.NewCoins(), 0, 0,
+			""),
+		vestingPool,
+	}
+	require.NoError(t, ValidateGenesisAccounts(data, accounts))
+	require.Error(t, ValidateGenesisAccounts(data, accounts[:2]))
+}
+
+func TestValidateGenesisEmissions(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	mintable := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	fixed := *NewToken("Fixed Token", "fix123", "FIX", 1000, owner, false)
+	emission := NewEmissionSchedule("tst123", owner, 10, 5, 50, 0, sdk.ZeroDec(), 0)
+
+	data := NewGenesisState([]Token{mintable, fixed}, nil)
+	data.Emissions = []EmissionSchedule{emission}
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.Emissions = []EmissionSchedule{emission}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.Emissions = append(data.Emissions, emission) })
+	invalid(func(data *GenesisState) { data.Emissions[0].Symbol = "abc123" })
+	invalid(func(data *GenesisState) { data.Emissions[0].Symbol = "fix123" })
+	invalid(func(data *GenesisState) { data.Emissions[0].Recipient = nil })
+	invalid(func(data *GenesisState) { data.Emissions[0].EndHeight = 4 })
+	invalid(func(data *GenesisState) { data.Emissions[0].Emitted = sdk.Int{} })
+}
+
+func TestValidateGenesisAllowances(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	spender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	allowance := NewAllowance(owner, spender, "tst123", 100, time.Time{})
+
+	data := NewGenesisState([]Token{token}, nil)
+	data.Allowances = []Allowance{allowance}
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.Allowances = []Allowance{allowance}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.Allowances = append(data.Allowances, allowance) })
+	invalid(func(data *GenesisState) { data.Allowances[0].Symbol = "abc123" })
+	invalid(func(data *GenesisState) { data.Allowances[0].Spender = nil })
+	invalid(func(data *GenesisState) { data.Allowances[0].Spender = owner })
+	invalid(func(data *GenesisState) { data.Allowances[0].Amount = sdk.ZeroInt() })
+}
+
+func TestValidateGenesisClawbacks(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	token.Clawbackable = true
+	fixed := *NewToken("Fixed Token", "fix123", "FIX", 1000, owner, false)
+	record := ClawbackRecord{
+		ID:        1,
+		Symbol:    "tst123",
+		Authority: owner,
+		From:      holder,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("tst123", 10)),
+		Reason:    "court order",
+	}
+
+	data := NewGenesisState([]Token{token, fixed}, nil)
+	data.Clawbacks = []ClawbackRecord{record}
+	data.NextClawbackID = 2
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.TokenRecords = []Token{token, fixed}
+		broken.Clawbacks = []ClawbackRecord{record}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.NextClawbackID = 1 })
+	invalid(func(data *GenesisState) { data.Clawbacks = append(data.Clawbacks, record) })
+	invalid(func(data *GenesisState) { data.Clawbacks[0].Symbol = "fix123" })
+	invalid(func(data *GenesisState) { data.Clawbacks[0].From = nil })
+	invalid(func(data *GenesisState) { data.Clawbacks[0].Amount = sdk.Coins{} })
+	invalid(func(data *GenesisState) { data.Clawbacks[0].Reason = "" })
+	invalid(func(data *GenesisState) { data.TokenRecords[1].ClawbackAdmin = holder })
+}
+
+func TestValidateGenesisTransferFees(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	fee := NewTransferFee("tst123", 25, 1, 100, owner)
+
+	data := NewGenesisState([]Token{token}, nil)
+	data.TransferFees = []TransferFee{fee}
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.TransferFees = []TransferFee{fee}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.TransferFees = append(data.TransferFees, fee) })
+	invalid(func(data *GenesisState) { data.TransferFees[0].Symbol = "abc123" })
+	invalid(func(data *GenesisState) { data.TransferFees[0].Recipient = nil })
+	invalid(func(data *GenesisState) { data.TransferFees[0].Rate = MaxTransferFeeRate + 1 })
+	invalid(func(data *GenesisState) { data.TransferFees[0].Collected = sdk.Int{} })
+}
+
+func TestValidateGenesisRedenominations(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	record := Redenomination{
+		Symbol:       "tst123",
+		Owner:        owner,
+		Numerator:    10,
+		Denominator:  1,
+		SupplyBefore: sdk.NewInt(100),
+		SupplyAfter:  sdk.NewInt(1000),
+		Dust:         sdk.ZeroInt(),
+	}
+
+	data := NewGenesisState([]Token{token}, nil)
+	data.Redenominations = []Redenomination{record}
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.Redenominations = []Redenomination{record}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.Redenominations[0].Symbol = "abc123" })
+	invalid(func(data *GenesisState) { data.Redenominations[0].Owner = nil })
+	invalid(func(data *GenesisState) { data.Redenominations[0].Denominator = 0 })
+}
+
+func TestValidateGenesisConversions(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	oldToken := *NewToken("Old Token", "old123", "OLD", 1000, owner, false)
+	newToken := *NewToken("New Token", "new123", "NEW", 1000, owner, true)
+	window := NewConversionWindow("old123", "new123", owner, 1, 1, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	data := NewGenesisState([]Token{oldToken, newToken}, nil)
+	data.Conversions = []ConversionWindow{window}
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.TokenRecords = []Token{oldToken, newToken}
+		broken.Conversions = []ConversionWindow{window}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.Conversions = append(data.Conversions, window) })
+	invalid(func(data *GenesisState) { data.Conversions[0].Target = "abc123" })
+	invalid(func(data *GenesisState) { data.Conversions[0].Source, data.Conversions[0].Target = "new123", "old123" })
+	invalid(func(data *GenesisState) { data.Conversions[0].Numerator = 0 })
+	invalid(func(data *GenesisState) { data.Conversions[0].Issued = sdk.NewInt(-1) })
+	invalid(func(data *GenesisState) { data.Conversions[0].Closed = true })
+	invalid(func(data *GenesisState) { data.TokenRecords[0].Disabled = true })
+}
+
+func TestValidateGenesisRetiredTokens(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	liveToken := *NewToken("New Token", "new123", "NEW", 1000, owner, true)
+	retiredToken := *NewToken("Old Token", "old123", "OLD", 0, owner, false)
+	retiredToken.TotalSupply = sdk.NewCoins()
+	retiredToken.Status = TokenStatusRetired
+	retiredToken.Disabled = true
+	archived := ArchivedToken{Token: retiredToken, Height: 10, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	window := NewConversionWindow("old123", "new123", owner, 1, 1, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	window.Closed = true
+
+	// the history of a retired token is kept
+	data := NewGenesisState([]Token{liveToken}, nil)
+	data.RetiredTokens = []ArchivedToken{archived}
+	data.Conversions = []ConversionWindow{window}
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.TokenRecords = []Token{liveToken}
+		broken.RetiredTokens = []ArchivedToken{archived}
+		broken.Conversions = []ConversionWindow{window}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.RetiredTokens = append(data.RetiredTokens, archived) })
+	invalid(func(data *GenesisState) { data.TokenRecords = append(data.TokenRecords, retiredToken) })
+	invalid(func(data *GenesisState) { data.RetiredTokens[0].Token.Status = TokenStatusDeprecated })
+	invalid(func(data *GenesisState) {
+		data.RetiredTokens[0].Token.TotalSupply = sdk.NewCoins(sdk.NewInt64Coin("old123", 1))
+	})
+	invalid(func(data *GenesisState) { data.Conversions[0].Closed = false })
+	invalid(func(data *GenesisState) { data.TokenRecords[0].Status = TokenStatusRetired })
+	invalid(func(data *GenesisState) { data.TokenRecords[0].Status = "frozen" })
+}
+
+func TestValidateGenesisCouncils(t *testing.T) {
+	members := []sdk.AccAddress{
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+	token := *NewToken("Test Token", "tst123", "TST", 1000, CouncilAddress("tst123"), true)
+	council := NewCouncil("tst123", members, 2)
+	action := NewPendingAction(1, "tst123", members[0],
+		NewMsgSetTokenStatus(CouncilAddress("tst123"), "tst123", TokenStatusDeprecated),
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	data := NewGenesisState([]Token{token}, nil)
+	data.Councils = []Council{council}
+	data.PendingActions = []PendingAction{action}
+	data.NextActionID = 2
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.TokenRecords = []Token{token}
+		broken.Councils = []Council{council}
+		pending := action
+		pending.Approvals = append([]sdk.AccAddress{}, action.Approvals...)
+		broken.PendingActions = []PendingAction{pending}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.Councils = append(data.Councils, council) })
+	invalid(func(data *GenesisState) { data.TokenRecords[0].Owner = members[0] })
+	invalid(func(data *GenesisState) { data.Councils[0].Threshold = 4 })
+	invalid(func(data *GenesisState) { data.Councils = nil })
+	invalid(func(data *GenesisState) { data.NextActionID = 1 })
+	invalid(func(data *GenesisState) { data.PendingActions = append(data.PendingActions, action) })
+	invalid(func(data *GenesisState) { data.PendingActions[0].Approvals = nil })
+	invalid(func(data *GenesisState) {
+		data.PendingActions[0].Approvals = append(data.PendingActions[0].Approvals, members[1])
+	})
+	invalid(func(data *GenesisState) {
+		data.PendingActions[0].Approvals[0] = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	})
+	invalid(func(data *GenesisState) {
+		data.PendingActions[0].Action = NewMsgSetTokenStatus(members[0], "tst123", TokenStatusDeprecated)
+	})
+}
+
+func TestValidateGenesisQueuedActions(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	token.Timelock = time.Hour
+	queuedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	action := NewQueuedAction(1, "tst123", owner, NewMsgSetHoldingLimits(owner, "tst123", 10, 0), queuedAt,
+		queuedAt.Add(time.Hour))
+
+	data := NewGenesisState([]Token{token}, nil)
+	data.QueuedActions = []QueuedAction{action}
+	data.NextQueuedActionID = 2
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.TokenRecords = []Token{token}
+		broken.QueuedActions = []QueuedAction{action}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.TokenRecords[0].Timelock = MaxTimelockDelay + time.Second })
+	invalid(func(data *GenesisState) { data.NextQueuedActionID = 1 })
+	invalid(func(data *GenesisState) { data.QueuedActions = append(data.QueuedActions, action) })
+	invalid(func(data *GenesisState) { data.QueuedActions[0].Symbol = "xyz123" })
+	invalid(func(data *GenesisState) { data.QueuedActions[0].Action = nil })
+	invalid(func(data *GenesisState) { data.QueuedActions[0].Action = NewMsgMintCoins(10, "tst123", owner) })
+	invalid(func(data *GenesisState) { data.TokenRecords[0].Owner = CouncilAddress("tst123") })
+	invalid(func(data *GenesisState) { data.QueuedActions[0].ETA = time.Time{} })
+}
+
+func TestValidateGenesisGrants(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	token := *NewToken("Test Token", "tst123", "TST", 1000, owner, true)
+	grant := NewGrant(owner, grantee, "tst123", MsgMintCoins{}.Type(), 100,
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	data := NewGenesisState([]Token{token}, nil)
+	data.Grants = []Grant{grant}
+	require.NoError(t, ValidateGenesis(data))
+
+	invalid := func(change func(data *GenesisState)) {
+		broken := data
+		broken.Grants = []Grant{grant}
+		change(&broken)
+		require.Error(t, ValidateGenesis(broken))
+	}
+	invalid(func(data *GenesisState) { data.Grants = append(data.Grants, grant) })
+	invalid(func(data *GenesisState) { data.Grants[0].Symbol = "xyz123" })
+	invalid(func(data *GenesisState) { data.Grants[0].Grantee = owner })
+	invalid(func(data *GenesisState) { data.Grants[0].MsgType = MsgSetTimelock{}.Type() })
+	invalid(func(data *GenesisState) { data.Grants[0].SpendLimit = sdk.NewInt(-1) })
+}
@@ -10,48 +10,126 @@ import (
 // NewHandler returns a handler for "assetmanagement" type messages.
 func NewHandler(keeper Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
-		switch msg := msg.(type) {
-		case MsgIssueToken:
-			return handleMsgIssueToken(ctx, keeper, msg)
-		case MsgMintCoins:
-			return handleMsgMintCoins(ctx, keeper, msg)
-		case MsgBurnCoins:
-			return handleMsgBurnCoins(ctx, keeper, msg)
-		case MsgFreezeCoins:
-			return handleMsgFreezeCoins(ctx, keeper, msg)
-		case MsgUnfreezeCoins:
-			return handleMsgUnfreezeCoins(ctx, keeper, msg)
-		default:
-			errMsg := fmt.Sprintf("Unrecognized assetmanagement Msg type: %v", msg.Type())
-			return sdk.ErrUnknownRequest(errMsg).Result()
+		// the rule changes of a timelocked token are queued and run by the end blocker once the delay has passed
+		if keeper.IsTimelocked(ctx, msg) {
+			return handleTimelockedMsg(ctx, keeper, msg)
 		}
+		return handleMsg(ctx, keeper, msg)
+	}
+}
+
+// handleMsg routes a message to its handler, past the timelock
+func handleMsg(ctx sdk.Context, keeper Keeper, msg sdk.Msg) sdk.Result {
+	switch msg := msg.(type) {
+	case MsgIssueToken:
+		return handleMsgIssueToken(ctx, keeper, msg)
+	case MsgMintCoins:
+		return handleMsgMintCoins(ctx, keeper, msg)
+	case MsgBurnCoins:
+		return handleMsgBurnCoins(ctx, keeper, msg)
+	case MsgFreezeCoins:
+		return handleMsgFreezeCoins(ctx, keeper, msg)
+	case MsgUnfreezeCoins:
+		return handleMsgUnfreezeCoins(ctx, keeper, msg)
+	case MsgDistribute:
+		return handleMsgDistribute(ctx, keeper, msg)
+	case MsgCreateClaimCampaign:
+		return handleMsgCreateClaimCampaign(ctx, keeper, msg)
+	case MsgClaim:
+		return handleMsgClaim(ctx, keeper, msg)
+	case MsgCreateVestingGrant:
+		return handleMsgCreateVestingGrant(ctx, keeper, msg)
+	case MsgClaimVested:
+		return handleMsgClaimVested(ctx, keeper, msg)
+	case MsgSetEmission:
+		return handleMsgSetEmission(ctx, keeper, msg)
+	case MsgSetEmissionPaused:
+		return handleMsgSetEmissionPaused(ctx, keeper, msg)
+	case MsgApprove:
+		return handleMsgApprove(ctx, keeper, msg)
+	case MsgTransferFrom:
+		return handleMsgTransferFrom(ctx, keeper, msg)
+	case MsgClawback:
+		return handleMsgClawback(ctx, keeper, msg)
+	case MsgSetClawbackAdmin:
+		return handleMsgSetClawbackAdmin(ctx, keeper, msg)
+	case MsgSetTransferFee:
+		return handleMsgSetTransferFee(ctx, keeper, msg)
+	case MsgSetTransferFeeExemption:
+		return handleMsgSetTransferFeeExemption(ctx, keeper, msg)
+	case MsgSetHoldingLimits:
+		return handleMsgSetHoldingLimits(ctx, keeper, msg)
+	case MsgRedenominate:
+		return handleMsgRedenominate(ctx, keeper, msg)
+	case MsgOpenConversion:
+		return handleMsgOpenConversion(ctx, keeper, msg)
+	case MsgConvert:
+		return handleMsgConvert(ctx, keeper, msg)
+	case MsgSetTokenStatus:
+		return handleMsgSetTokenStatus(ctx, keeper, msg)
+	case MsgSetCouncil:
+		return handleMsgSetCouncil(ctx, keeper, msg)
+	case MsgProposeAction:
+		return handleMsgProposeAction(ctx, keeper, msg)
+	case MsgApproveAction:
+		return handleMsgApproveAction(ctx, keeper, msg)
+	case MsgRevokeApproval:
+		return handleMsgRevokeApproval(ctx, keeper, msg)
+	case MsgSetTimelock:
+		return handleMsgSetTimelock(ctx, keeper, msg)
+	case MsgCancelQueuedAction:
+		return handleMsgCancelQueuedAction(ctx, keeper, msg)
+	case MsgGrant:
+		return handleMsgGrant(ctx, keeper, msg)
+	case MsgRevokeGrant:
+		return handleMsgRevokeGrant(ctx, keeper, msg)
+	case MsgExec:
+		return handleMsgExec(ctx, keeper, msg)
+	case MsgTransferOwnership:
+		return handleMsgTransferOwnership(ctx, keeper, msg)
+	case MsgSetTokenMetadata:
+		return handleMsgSetTokenMetadata(ctx, keeper, msg)
+	case MsgSetTokenPaused:
+		return handleMsgSetTokenPaused(ctx, keeper, msg)
+	default:
+		errMsg := fmt.Sprintf("Unrecognized assetmanagement Msg type: %v", msg.Type())
+		return sdk.ErrUnknownRequest(errMsg).Result()
 	}
 }
 
 // handle message to issue token
 func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg MsgIssueToken) sdk.Result {
-	defer func() {
-		if r := recover(); r != nil {
-			err := fmt.Sprintf("had to recover when issuing new token: %v", r)
-			ctx.Logger().Error(err)
-		}
-	}()
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
 
-	// must be lowercase otherwise NewToken will panic
-	var newSymbol = strings.ToLower(msg.Symbol)
+	// symbols are stored lowercase, the form coin denominations take
+	newSymbol := strings.ToLower(msg.Symbol)
+	// the symbols of retired tokens stay taken, their archived records keep them
+	if keeper.IsSymbolPresent(ctx, newSymbol) || keeper.IsSymbolRetired(ctx, newSymbol) {
+		return ErrTokenSymbolAlreadyExists(keeper.Codespace(), newSymbol).Result()
+	}
 
 	token := NewToken(msg.Name, newSymbol, msg.OriginalSymbol, msg.TotalSupply, msg.SourceAddress, msg.Mintable)
+	token.Clawbackable = msg.Clawbackable
+	token.ClawbackAdmin = msg.ClawbackAdmin
+	// an empty mode means free, store it explicitly
+	token.TransferMode, _ = TransferModeFromString(string(msg.TransferMode))
+	token.MaxBalancePerAccount = msg.MaxBalancePerAccount
+	token.MaxHolders = msg.MaxHolders
 
-	keeperErr := keeper.CoinKeeper.SetCoins(ctx, msg.SourceAddress, token.TotalSupply)
-	if keeperErr != nil {
-		return sdk.ErrUnknownRequest(fmt.Sprintf("failed to store new token in bank: %s", keeperErr)).Result()
+	err := keeper.MintCoins(ctx, msg.SourceAddress, token.TotalSupply)
+	if err != nil {
+		return err.Result()
 	}
 
-	err := keeper.SetToken(ctx, newSymbol, token)
+	err = keeper.SetToken(ctx, newSymbol, token)
 	if err != nil {
-		return sdk.ErrInternal(fmt.Sprintf("failed to store new token: '%s'", err)).Result()
+		return err.Result()
 	}
 
+	keeper.AfterTokenIssued(ctx, *token)
+
 	newSymbolLog := fmt.Sprintf("new_symbol=%s", newSymbol)
 	ctx.Logger().Info(newSymbolLog)
 	return sdk.Result{
@@ -61,86 +139,692 @@ func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg MsgIssueToken) sdk.
 
 // handle message to mint coins
 func handleMsgMintCoins(ctx sdk.Context, keeper Keeper, msg MsgMintCoins) sdk.Result {
-	owner, err := keeper.GetOwner(ctx, msg.Symbol)
+	token, err := keeper.GetToken(ctx, msg.Symbol)
 	if err != nil {
-		return sdk.ErrUnknownAddress(
-			fmt.Sprintf("Could not find the owner for the symbol '%s'", msg.Symbol)).Result()
+		return err.Result()
 	}
-	if !msg.Owner.Equals(owner) { // Checks if the msg sender is the same as the current owner
-		return sdk.ErrUnauthorized("Incorrect Owner").Result() // If not, throw an error
+	if !msg.Owner.Equals(token.Owner) { // Checks if the msg sender is the same as the current owner
+		return ErrInvalidOwner(keeper.Codespace(), msg.Owner, msg.Symbol).Result() // If not, throw an error
+	}
+	if !token.Mintable {
+		return ErrTokenNotMintable(keeper.Codespace(), msg.Symbol).Result()
+	}
+	if token.Disabled {
+		return ErrTokenDisabled(keeper.Codespace(), msg.Symbol).Result()
+	}
+	if token.IsDeprecated() {
+		return ErrTokenDeprecated(keeper.Codespace(), msg.Symbol).Result()
+	}
+	if token.Paused {
+		return ErrTokenPaused(keeper.Codespace(), msg.Symbol).Result()
 	}
 
-	coins, err := keeper.CoinKeeper.AddCoins(ctx, owner,
-		sdk.NewCoins(sdk.NewInt64Coin(msg.Symbol, msg.Amount)))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(msg.Symbol, msg.Amount))
+	err = keeper.MintCoins(ctx, token.Owner, coins)
 	if err != nil {
-		return sdk.ErrInternal(fmt.Sprintf("failed to mint coins: '%s'", err)).Result()
+		return err.Result()
 	}
 
-	err = keeper.SetTotalSupply(ctx, msg.Symbol, coins)
+	err = keeper.SetTotalSupply(ctx, msg.Symbol, token.TotalSupply.Add(coins))
 	if err != nil {
-		return sdk.ErrInternal(fmt.Sprintf("failed to set total supply when minting coins: '%s'", err)).Result()
+		return err.Result()
 	}
+
+	keeper.AfterMint(ctx, msg.Symbol, token.Owner, coins)
 	return sdk.Result{}
 }
 
 // handle message to burn coins
 func handleMsgBurnCoins(ctx sdk.Context, keeper Keeper, msg MsgBurnCoins) sdk.Result {
-	owner, err := keeper.GetOwner(ctx, msg.Symbol)
+	token, err := keeper.GetToken(ctx, msg.Symbol)
 	if err != nil {
-		return sdk.ErrUnknownAddress(
-			fmt.Sprintf("Could not find the owner for the symbol '%s'", msg.Symbol)).Result()
+		return err.Result()
+	}
+	if !msg.Owner.Equals(token.Owner) { // Checks if the msg sender is the same as the current owner
+		return ErrInvalidOwner(keeper.Codespace(), msg.Owner, msg.Symbol).Result() // If not, throw an error
 	}
-	if !msg.Owner.Equals(owner) { // Checks if the msg sender is the same as the current owner
-		return sdk.ErrUnauthorized("Incorrect Owner").Result() // If not, throw an error
+	if token.Paused {
+		return ErrTokenPaused(keeper.Codespace(), msg.Symbol).Result()
 	}
 
-	coins, err := keeper.CoinKeeper.SubtractCoins(ctx, owner,
-		sdk.NewCoins(sdk.NewInt64Coin(msg.Symbol, msg.Amount)))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(msg.Symbol, msg.Amount))
+	err = keeper.BurnCoins(ctx, token.Owner, coins)
 	if err != nil {
-		return sdk.ErrInternal(fmt.Sprintf("failed to burn coins: '%s'", err)).Result()
+		return err.Result()
 	}
 
-	err = keeper.SetTotalSupply(ctx, msg.Symbol, coins)
+	err = keeper.SetTotalSupply(ctx, msg.Symbol, token.TotalSupply.Sub(coins))
 	if err != nil {
-		return sdk.ErrInternal(fmt.Sprintf("failed to set total supply when burning coins: '%s'", err)).Result()
+		return err.Result()
 	}
+
+	keeper.AfterBurn(ctx, msg.Symbol, token.Owner, coins)
 	return sdk.Result{}
 }
 
 // handle message to freeze coins for specific wallet
 func handleMsgFreezeCoins(ctx sdk.Context, keeper Keeper, msg MsgFreezeCoins) sdk.Result {
-	owner := msg.Owner
+	// msg.Owner signs the message, itself or through a grant, so an account can only freeze its own coins
+	if token, err := keeper.GetToken(ctx, msg.Symbol); err == nil && token.Paused {
+		return ErrTokenPaused(keeper.Codespace(), msg.Symbol).Result()
+	}
+	err := keeper.FreezeCoins(ctx, msg.Owner, sdk.Coins{sdk.NewInt64Coin(msg.Symbol, msg.Amount)})
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
 
-	// Todo: Validate you are allowed access to account?
-	var customAccount, ok = keeper.AccountKeeper.GetAccount(ctx, owner).(CustomAccount)
-	if !ok {
-		return sdk.ErrInternal("failed to get correct account type to freeze coins").Result()
+// handle message to unfreeze coins for specific wallet
+func handleMsgUnfreezeCoins(ctx sdk.Context, keeper Keeper, msg MsgUnfreezeCoins) sdk.Result {
+	// msg.Owner signs the message, itself or through a grant, so an account can only unfreeze its own coins
+	if token, err := keeper.GetToken(ctx, msg.Symbol); err == nil && token.Paused {
+		return ErrTokenPaused(keeper.Codespace(), msg.Symbol).Result()
 	}
-	err := customAccount.FreezeCoins(sdk.Coins{sdk.NewInt64Coin(msg.Symbol, msg.Amount)})
+	err := keeper.UnfreezeCoins(ctx, msg.Owner, sdk.Coins{sdk.NewInt64Coin(msg.Symbol, msg.Amount)})
 	if err != nil {
-		return sdk.ErrInternal(fmt.Sprintf("failed to freeze coins: '%s'", err)).Result()
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// handle message to distribute coins from any holder to a list of recipients
+func handleMsgDistribute(ctx sdk.Context, keeper Keeper, msg MsgDistribute) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := keeper.DistributeCoins(ctx, msg.Sender, msg.Symbol, msg.Recipients)
+	if err != nil {
+		return err.Result()
+	}
+	// carries the transfer fee events, if any
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to fund a claimable airdrop
+func handleMsgCreateClaimCampaign(ctx sdk.Context, keeper Keeper, msg MsgCreateClaimCampaign) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
 	}
 
-	// Save changes to account
-	keeper.AccountKeeper.SetAccount(ctx, customAccount)
+	id, err := keeper.CreateClaimCampaign(ctx, msg.Owner, msg.Symbol, msg.MerkleRoot, msg.Deposit, msg.EndTime)
+	if err != nil {
+		return err.Result()
+	}
+
+	campaignLog := fmt.Sprintf("campaign_id=%d", id)
+	ctx.Logger().Info(campaignLog)
+	return sdk.Result{
+		Log: campaignLog,
+	}
+}
+
+// handle message to claim from a claimable airdrop
+func handleMsgClaim(ctx sdk.Context, keeper Keeper, msg MsgClaim) sdk.Result {
+	proof, decodeErr := msg.DecodeProof()
+	if decodeErr != nil {
+		return ErrInvalidClaimProof(keeper.Codespace(), msg.Claimant, msg.CampaignID).Result()
+	}
+
+	err := keeper.Claim(ctx, msg.Claimant, msg.CampaignID, msg.Amount, proof)
+	if err != nil {
+		return err.Result()
+	}
 	return sdk.Result{}
 }
 
-// handle message to freeze coins for specific wallet
-func handleMsgUnfreezeCoins(ctx sdk.Context, keeper Keeper, msg MsgUnfreezeCoins) sdk.Result {
-	owner := msg.Owner
+// handle message to lock coins of a token in a vesting grant
+func handleMsgCreateVestingGrant(ctx sdk.Context, keeper Keeper, msg MsgCreateVestingGrant) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	id, err := keeper.CreateVestingGrant(ctx, msg.Owner, msg.Beneficiary, msg.Symbol, msg.Total,
+		msg.StartTime, msg.CliffTime, msg.EndTime)
+	if err != nil {
+		return err.Result()
+	}
+
+	grantLog := fmt.Sprintf("grant_id=%d", id)
+	ctx.Logger().Info(grantLog)
+	return sdk.Result{
+		Log: grantLog,
+	}
+}
+
+// handle message to release the vested coins of a grant
+func handleMsgClaimVested(ctx sdk.Context, keeper Keeper, msg MsgClaimVested) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	released, err := keeper.ClaimVested(ctx, msg.Beneficiary, msg.GrantID)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Log: fmt.Sprintf("released=%s", released),
+	}
+}
+
+// handle message to create or replace the emission schedule of a token
+func handleMsgSetEmission(ctx sdk.Context, keeper Keeper, msg MsgSetEmission) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	emission := NewEmissionSchedule(msg.Symbol, msg.Recipient, msg.Rate, msg.StartHeight, msg.EndHeight,
+		msg.DecayInterval, msg.DecayRate, msg.MaxSupply)
+	if err := keeper.ScheduleEmission(ctx, msg.Owner, emission); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// handle message to pause or resume the emission schedule of a token
+func handleMsgSetEmissionPaused(ctx sdk.Context, keeper Keeper, msg MsgSetEmissionPaused) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.SetEmissionPaused(ctx, msg.Owner, msg.Symbol, msg.Paused); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// handle message to set the allowance of a spender over a token
+func handleMsgApprove(ctx sdk.Context, keeper Keeper, msg MsgApprove) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
 
-	// Todo: Validate you are allowed access to account?
-	var customAccount, ok = keeper.AccountKeeper.GetAccount(ctx, owner).(CustomAccount)
-	if !ok {
-		return sdk.ErrInternal("failed to get correct account type to unfreeze").Result()
+	if err := keeper.Approve(ctx, msg.Owner, msg.Spender, msg.Symbol, msg.Amount, msg.Expiry); err != nil {
+		return err.Result()
 	}
-	err := customAccount.UnfreezeCoins(sdk.Coins{sdk.NewInt64Coin(msg.Symbol, msg.Amount)})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to move coins out of an allowance
+func handleMsgTransferFrom(ctx sdk.Context, keeper Keeper, msg MsgTransferFrom) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	remaining, err := keeper.TransferFrom(ctx, msg.Spender, msg.From, msg.To, msg.Symbol, msg.Amount)
 	if err != nil {
-		return sdk.ErrInternal(fmt.Sprintf("failed to unfreeze coins: '%s'", err)).Result()
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Spender.String()),
+	))
+	return sdk.Result{
+		Log:    fmt.Sprintf("remaining=%s", remaining),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handle message to claw back coins from a holder
+func handleMsgClawback(ctx sdk.Context, keeper Keeper, msg MsgClawback) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
 	}
 
-	// Save changes to account
-	keeper.AccountKeeper.SetAccount(ctx, customAccount)
+	record, err := keeper.Clawback(ctx, msg.Authority, msg.Symbol, msg.From, msg.Recipient, msg.Amount, msg.Reason)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+	))
+	clawbackLog := fmt.Sprintf("clawback_id=%d", record.ID)
+	ctx.Logger().Info(clawbackLog)
+	return sdk.Result{
+		Log:    clawbackLog,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handle message to change the clawback admin of a token
+func handleMsgSetClawbackAdmin(ctx sdk.Context, keeper Keeper, msg MsgSetClawbackAdmin) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.SetClawbackAdmin(ctx, msg.Owner, msg.Symbol, msg.Admin); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// handle message to set the fee taken out of every transfer of a token
+func handleMsgSetTransferFee(ctx sdk.Context, keeper Keeper, msg MsgSetTransferFee) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := keeper.ConfigureTransferFee(ctx, msg.Owner, msg.Symbol, msg.Rate, msg.MinFee, msg.MaxFee, msg.Recipient)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// handle message to exempt an address from the transfer fee of a token, or to end its exemption
+func handleMsgSetTransferFeeExemption(ctx sdk.Context, keeper Keeper, msg MsgSetTransferFeeExemption) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.SetTransferFeeExemption(ctx, msg.Owner, msg.Symbol, msg.Address, msg.Exempt); err != nil {
+		return err.Result()
+	}
 	return sdk.Result{}
 }
+
+// handle message to change the holding limits of a token
+func handleMsgSetHoldingLimits(ctx sdk.Context, keeper Keeper, msg MsgSetHoldingLimits) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := keeper.SetHoldingLimits(ctx, msg.Owner, msg.Symbol, msg.MaxBalancePerAccount, msg.MaxHolders)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to redenominate a token
+func handleMsgRedenominate(ctx sdk.Context, keeper Keeper, msg MsgRedenominate) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	record, err := keeper.Redenominate(ctx, msg.Owner, msg.Symbol, msg.Numerator, msg.Denominator)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	redenominateLog := fmt.Sprintf("supply=%s%s dust=%s%s", record.SupplyAfter, msg.Symbol, record.Dust, msg.Symbol)
+	ctx.Logger().Info(redenominateLog)
+	return sdk.Result{
+		Log:    redenominateLog,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handle message to open a conversion window from one token to another
+func handleMsgOpenConversion(ctx sdk.Context, keeper Keeper, msg MsgOpenConversion) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := keeper.OpenConversion(ctx, msg.Owner, msg.Source, msg.Target, msg.Numerator, msg.Denominator,
+		msg.Deadline)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to convert coins through an open conversion window
+func handleMsgConvert(ctx sdk.Context, keeper Keeper, msg MsgConvert) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	received, err := keeper.Convert(ctx, msg.Holder, msg.Source, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Holder.String()),
+	))
+	convertLog := fmt.Sprintf("received=%s", received)
+	ctx.Logger().Info(convertLog)
+	return sdk.Result{
+		Log:    convertLog,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handle message to deprecate or retire a token
+func handleMsgSetTokenStatus(ctx sdk.Context, keeper Keeper, msg MsgSetTokenStatus) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.SetTokenStatus(ctx, msg.Owner, msg.Symbol, msg.Status); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to hand a token over to a new owner
+func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg MsgTransferOwnership) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.TransferOwnership(ctx, msg.Owner, msg.Symbol, msg.NewOwner); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to change the name and original symbol of a token
+func handleMsgSetTokenMetadata(ctx sdk.Context, keeper Keeper, msg MsgSetTokenMetadata) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.SetTokenMetadata(ctx, msg.Owner, msg.Symbol, msg.Name, msg.OriginalSymbol); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to pause or resume a token
+func handleMsgSetTokenPaused(ctx sdk.Context, keeper Keeper, msg MsgSetTokenPaused) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.SetTokenPaused(ctx, msg.Owner, msg.Symbol, msg.Paused); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to hand a token over to a council or change its council
+func handleMsgSetCouncil(ctx sdk.Context, keeper Keeper, msg MsgSetCouncil) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.SetTokenCouncil(ctx, msg.Owner, msg.Symbol, msg.Members, msg.Threshold); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to propose a privileged message to the council of a token
+func handleMsgProposeAction(ctx sdk.Context, keeper Keeper, msg MsgProposeAction) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	action, err := keeper.ProposeAction(ctx, msg.Proposer, msg.Symbol, msg.Action, msg.Expiry)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+	))
+	return executeIfApproved(ctx, keeper, action)
+}
+
+// handle message to approve a pending council action
+func handleMsgApproveAction(ctx sdk.Context, keeper Keeper, msg MsgApproveAction) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	action, err := keeper.ApproveAction(ctx, msg.Member, msg.ActionID)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Member.String()),
+	))
+	return executeIfApproved(ctx, keeper, action)
+}
+
+// handle message to revoke the approval of a pending council action
+func handleMsgRevokeApproval(ctx sdk.Context, keeper Keeper, msg MsgRevokeApproval) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.RevokeApproval(ctx, msg.Member, msg.ActionID); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Member.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// executeIfApproved runs a pending action through the module handler once enough council members approved it. The
+// action is dropped first, if it fails the message that approved it fails too and the action stays pending
+func executeIfApproved(ctx sdk.Context, keeper Keeper, action PendingAction) sdk.Result {
+	if !keeper.IsActionApproved(ctx, action) {
+		return sdk.Result{
+			Log:    fmt.Sprintf("action_id=%d", action.ID),
+			Events: ctx.EventManager().Events(),
+		}
+	}
+
+	keeper.DeletePendingAction(ctx, action)
+	res := NewHandler(keeper)(ctx, action.Action)
+	if !res.IsOK() {
+		return res
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeExecuteAction,
+		sdk.NewAttribute(AttributeKeyActionID, fmt.Sprintf("%d", action.ID)),
+		sdk.NewAttribute(AttributeKeySymbol, action.Symbol),
+		sdk.NewAttribute(AttributeKeyAction, action.Action.Type()),
+	))
+	log := fmt.Sprintf("action_id=%d executed", action.ID)
+	if res.Log != "" {
+		log = fmt.Sprintf("%s %s", log, res.Log)
+	}
+	return sdk.Result{
+		Data:   res.Data,
+		Log:    log,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handle message to set the timelock of a token
+func handleMsgSetTimelock(ctx sdk.Context, keeper Keeper, msg MsgSetTimelock) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.SetTimelock(ctx, msg.Owner, msg.Symbol, msg.Delay); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to cancel a queued action of a timelocked token
+func handleMsgCancelQueuedAction(ctx sdk.Context, keeper Keeper, msg MsgCancelQueuedAction) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.CancelQueuedAction(ctx, msg.Owner, msg.ID); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to let a grantee sign messages on behalf of the granter
+func handleMsgGrant(ctx sdk.Context, keeper Keeper, msg MsgGrant) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := keeper.Grant(ctx, msg.Granter, msg.Grantee, msg.Symbol, msg.MsgType, msg.SpendLimit, msg.Expiry)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to take back a grant
+func handleMsgRevokeGrant(ctx sdk.Context, keeper Keeper, msg MsgRevokeGrant) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.RevokeGrant(ctx, msg.Granter, msg.Grantee, msg.Symbol, msg.MsgType); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handle message to run messages on behalf of their granters, each under its grant. A failing message fails the
+// whole transaction, so the ones before it are rolled back along with what they spent of their grants
+func handleMsgExec(ctx sdk.Context, keeper Keeper, msg MsgExec) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	handler := NewHandler(keeper)
+	for _, inner := range msg.Msgs {
+		granter, err := keeper.UseGrant(ctx, msg.Grantee, inner)
+		if err != nil {
+			return err.Result()
+		}
+		if res := handler(ctx, inner); !res.IsOK() {
+			return res
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeExec,
+			sdk.NewAttribute(AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(AttributeKeyMsgType, inner.Type()),
+		))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleTimelockedMsg queues a rule change of a timelocked token instead of running it
+func handleTimelockedMsg(ctx sdk.Context, keeper Keeper, msg sdk.Msg) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	action, err := keeper.QueueAction(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, action.Owner.String()),
+	))
+	return sdk.Result{
+		Log:    fmt.Sprintf("queued as action %d, runs at %s", action.ID, action.ETA),
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -3,18 +3,584 @@ package assetmanagement
 import (
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/keeper"
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
 )
 
 func TestInvalidMsg(t *testing.T) {
-	h := NewHandler(NewKeeper(auth.AccountKeeper{}, nil, nil, nil))
+	h := NewHandler(NewKeeper(auth.AccountKeeper{}, nil, supply.Keeper{}, nil, nil, DefaultCodespace))
 
 	res := h(sdk.NewContext(nil, abci.Header{}, false, nil), sdk.NewTestMsg())
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "Unrecognized assetmanagement Msg type"))
 }
+
+func TestMintBurnTotalSupply(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, holder := types.KeyTestPubAddr()
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, true)).IsOK())
+	require.Nil(t, k.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("zap123", 300)))
+
+	// the supply counts every holder, not just the owner's balance
+	require.True(t, h(ctx, NewMsgMintCoins(10, "zap123", owner)).IsOK())
+	supply, err := k.GetTotalSupply(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 1010), supply)
+
+	require.True(t, h(ctx, NewMsgBurnCoins(5, "zap123", owner)).IsOK())
+	supply, err = k.GetTotalSupply(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 1005), supply)
+}
+
+// symbolHooks records the symbols of issued, minted and burned tokens
+type symbolHooks struct {
+	MultiAssetHooks
+	calls *[]string
+}
+
+func (h symbolHooks) AfterTokenIssued(_ sdk.Context, token Token) {
+	*h.calls = append(*h.calls, "issued "+token.Symbol)
+}
+
+func (h symbolHooks) AfterMint(_ sdk.Context, _ string, _ sdk.AccAddress, coins sdk.Coins) {
+	*h.calls = append(*h.calls, "mint "+coins.String())
+}
+
+func (h symbolHooks) AfterBurn(_ sdk.Context, _ string, _ sdk.AccAddress, coins sdk.Coins) {
+	*h.calls = append(*h.calls, "burn "+coins.String())
+}
+
+func TestHandlerHooks(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	var calls []string
+	k = *k.SetHooks(symbolHooks{calls: &calls})
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, true)).IsOK())
+	require.True(t, h(ctx, NewMsgMintCoins(10, "zap123", owner)).IsOK())
+	require.True(t, h(ctx, NewMsgBurnCoins(5, "zap123", owner)).IsOK())
+	require.False(t, h(ctx, NewMsgBurnCoins(5000, "zap123", owner)).IsOK())
+
+	require.Equal(t, []string{"issued zap123", "mint 10zap123", "burn 5zap123"}, calls)
+}
+
+func TestHandlerErrorCodes(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, other := types.KeyTestPubAddr()
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, false)).IsOK())
+
+	cases := []struct {
+		msg  sdk.Msg
+		code sdk.CodeType
+	}{
+		{NewMsgMintCoins(10, "nope12", owner), CodeTokenSymbolDoesNotExist},
+		{NewMsgMintCoins(10, "zap123", other), CodeInvalidOwner},
+		{NewMsgMintCoins(10, "zap123", owner), CodeTokenNotMintable},
+		{NewMsgBurnCoins(10, "zap123", other), CodeInvalidOwner},
+		{NewMsgBurnCoins(5000, "zap123", owner), CodeInsufficientCoins},
+		{NewMsgFreezeCoins(5000, "zap123", owner), CodeInsufficientCoins},
+		{NewMsgUnfreezeCoins(10, "zap123", owner), CodeInsufficientFrozenCoins},
+		{NewMsgFreezeCoins(10, "zap123", other), CodeUnknownAccount},
+	}
+	for i, tc := range cases {
+		res := h(ctx, tc.msg)
+		require.Equal(t, tc.code, res.Code, "case %d: %s", i, res.Log)
+		require.Equal(t, DefaultCodespace, res.Codespace, "case %d", i)
+	}
+}
+
+func TestIssueTokenFailsCleanly(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, other := types.KeyTestPubAddr()
+
+	// this symbol made NewToken panic, which was recovered and reported as success
+	res := h(ctx, NewMsgIssueToken(owner, "Zap", "ZAP-123", "ZAP", 1000, false))
+	require.Equal(t, CodeInvalidSymbol, res.Code, res.Log)
+	require.False(t, k.IsSymbolPresent(ctx, "zap-123"))
+	require.Nil(t, k.AccountKeeper.GetAccount(ctx, owner))
+	require.True(t, k.SupplyKeeper.GetSupply(ctx).GetTotal().Empty())
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, false)).IsOK())
+
+	// reissuing a symbol leaves the original token and balances alone
+	res = h(ctx, NewMsgIssueToken(other, "Other", "zap123", "OTH", 5, true))
+	require.Equal(t, CodeTokenSymbolAlreadyExists, res.Code, res.Log)
+	token, err := k.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, owner, token.Owner)
+	require.Nil(t, k.AccountKeeper.GetAccount(ctx, other))
+	require.Equal(t, sdk.NewInt(1000), k.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf("zap123"))
+
+	res = h(ctx, NewMsgIssueToken(owner, "Zap", "zap124", "ZAP", 0, false))
+	require.Equal(t, CodeInvalidAmount, res.Code, res.Log)
+	require.False(t, k.IsSymbolPresent(ctx, "zap124"))
+}
+
+func TestDistribute(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, holder := types.KeyTestPubAddr()
+	_, _, first := types.KeyTestPubAddr()
+	_, _, second := types.KeyTestPubAddr()
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, false)).IsOK())
+	require.True(t, h(ctx, NewMsgDistribute(owner, "zap123", []Recipient{NewRecipient(holder, 300)})).IsOK())
+
+	// any holder can distribute, not just the owner
+	res := h(ctx, NewMsgDistribute(holder, "zap123", []Recipient{NewRecipient(first, 100), NewRecipient(second, 50)}))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(150), k.CoinKeeper.GetCoins(ctx, holder).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(100), k.CoinKeeper.GetCoins(ctx, first).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(50), k.CoinKeeper.GetCoins(ctx, second).AmountOf("zap123"))
+
+	// frozen coins can't be distributed
+	require.True(t, h(ctx, NewMsgFreezeCoins(100, "zap123", holder)).IsOK())
+	res = h(ctx, NewMsgDistribute(holder, "zap123", []Recipient{NewRecipient(first, 30), NewRecipient(second, 30)}))
+	require.Equal(t, CodeInsufficientCoins, res.Code, res.Log)
+	require.Equal(t, sdk.NewInt(50), k.CoinKeeper.GetCoins(ctx, holder).AmountOf("zap123"))
+
+	res = h(ctx, NewMsgDistribute(holder, "nope12", []Recipient{NewRecipient(first, 1)}))
+	require.Equal(t, CodeTokenSymbolDoesNotExist, res.Code, res.Log)
+	res = h(ctx, NewMsgDistribute(first, "zap123", []Recipient{NewRecipient(second, 1)}))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(ctx, NewMsgDistribute(holder, "zap123", nil))
+	require.Equal(t, CodeInvalidRecipients, res.Code, res.Log)
+}
+
+func TestVestingGrantHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, beneficiary := types.KeyTestPubAddr()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, false)).IsOK())
+	res := h(ctx, NewMsgCreateVestingGrant(owner, beneficiary, "zap123", 100, start, start, start))
+	require.Equal(t, CodeInvalidVestingGrant, res.Code, res.Log)
+	res = h(ctx, NewMsgCreateVestingGrant(owner, beneficiary, "zap123", 100, start, start, start.Add(time.Hour)))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, "grant_id=1", res.Log)
+
+	res = h(ctx.WithBlockTime(start.Add(30*time.Minute)), NewMsgClaimVested(beneficiary, 1))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(50), k.CoinKeeper.GetCoins(ctx, beneficiary).AmountOf("zap123"))
+	res = h(ctx.WithBlockTime(start.Add(30*time.Minute)), NewMsgClaimVested(owner, 1))
+	require.Equal(t, CodeNotBeneficiary, res.Code, res.Log)
+}
+
+func TestEmissionHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, recipient := types.KeyTestPubAddr()
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, true)).IsOK())
+	res := h(ctx, NewMsgSetEmission(owner, "zap123", recipient, 10, 1, 0, 0, sdk.ZeroDec(), 0))
+	require.Equal(t, CodeInvalidEmission, res.Code, res.Log)
+	res = h(ctx, NewMsgSetEmission(owner, "zap123", recipient, 10, 1, 0, 0, sdk.ZeroDec(), 1100))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(ctx, NewMsgSetEmissionPaused(recipient, "zap123", true))
+	require.Equal(t, CodeInvalidOwner, res.Code, res.Log)
+	res = h(ctx, NewMsgSetEmissionPaused(owner, "zap123", true))
+	require.True(t, res.IsOK(), res.Log)
+
+	emission, err := k.GetEmission(ctx, "zap123")
+	require.Nil(t, err)
+	require.True(t, emission.Paused)
+}
+
+func hasEvent(res sdk.Result, eventType string) bool {
+	for _, event := range res.Events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAllowanceHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, spender := types.KeyTestPubAddr()
+	_, _, recipient := types.KeyTestPubAddr()
+
+	// every transaction gets its own event manager
+	deliver := func(msg sdk.Msg) sdk.Result {
+		return h(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	}
+
+	require.True(t, deliver(NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, false)).IsOK())
+	res := deliver(NewMsgApprove(owner, spender, "zap123", 100, time.Time{}))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeApprove))
+
+	res = deliver(NewMsgTransferFrom(spender, owner, recipient, "zap123", 101))
+	require.Equal(t, CodeInsufficientAllowance, res.Code, res.Log)
+	res = deliver(NewMsgTransferFrom(spender, owner, recipient, "zap123", 60))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, "remaining=40", res.Log)
+	require.True(t, hasEvent(res, EventTypeTransferFrom))
+	require.Equal(t, types.NewTestCoins("zap123", 60), k.CoinKeeper.GetCoins(ctx, recipient))
+}
+
+func TestClawbackHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, admin := types.KeyTestPubAddr()
+	_, _, holder := types.KeyTestPubAddr()
+
+	deliver := func(msg sdk.Msg) sdk.Result {
+		return h(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	}
+
+	require.True(t, deliver(NewMsgIssueToken(owner, "Zap", "fix123", "FIX", 1000, false)).IsOK())
+	issue := NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, false)
+	issue.Clawbackable = true
+	issue.ClawbackAdmin = admin
+	require.True(t, deliver(issue).IsOK())
+	token, err := k.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	require.True(t, token.Clawbackable)
+	require.Equal(t, admin, token.ClawbackAdmin)
+
+	require.Nil(t, k.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("zap123", 100)))
+	require.Nil(t, k.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("fix123", 100)))
+
+	res := deliver(NewMsgClawback(admin, "fix123", holder, nil, 10, "court order"))
+	require.Equal(t, CodeTokenNotClawbackable, res.Code, res.Log)
+	res = deliver(NewMsgClawback(holder, "zap123", owner, nil, 10, "court order"))
+	require.Equal(t, CodeNotClawbackAuthority, res.Code, res.Log)
+
+	res = deliver(NewMsgClawback(admin, "zap123", holder, owner, 60, "court order"))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, "clawback_id=1", res.Log)
+	require.True(t, hasEvent(res, EventTypeClawback))
+	require.Equal(t, sdk.NewInt(40), k.CoinKeeper.GetCoins(ctx, holder).AmountOf("zap123"))
+
+	require.True(t, deliver(NewMsgSetClawbackAdmin(owner, "zap123", nil)).IsOK())
+	res = deliver(NewMsgClawback(admin, "zap123", holder, nil, 10, "court order"))
+	require.Equal(t, CodeNotClawbackAuthority, res.Code, res.Log)
+}
+
+func TestRedenominateHandler(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, holder := types.KeyTestPubAddr()
+
+	deliver := func(msg sdk.Msg) sdk.Result {
+		return h(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	}
+
+	require.True(t, deliver(NewMsgIssueToken(owner, "Zap", "zap123", "ZAP", 1000, false)).IsOK())
+	require.Nil(t, k.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("zap123", 15)))
+
+	res := deliver(NewMsgRedenominate(holder, "zap123", 1, 10))
+	require.Equal(t, CodeInvalidOwner, res.Code, res.Log)
+	res = deliver(NewMsgRedenominate(owner, "zap123", 1, 10))
+	require.Equal(t, CodeInvalidRedenomination, res.Code, res.Log)
+
+	// the token is paused first, nothing moves at the old denomination meanwhile
+	res = deliver(NewMsgSetTokenPaused(holder, "zap123", true))
+	require.Equal(t, CodeInvalidOwner, res.Code, res.Log)
+	res = deliver(NewMsgSetTokenPaused(owner, "zap123", true))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeTokenPaused))
+	res = deliver(NewMsgBurnCoins(10, "zap123", owner))
+	require.Equal(t, CodeTokenPaused, res.Code, res.Log)
+	res = deliver(NewMsgFreezeCoins(10, "zap123", holder))
+	require.Equal(t, CodeTokenPaused, res.Code, res.Log)
+	res = deliver(NewMsgDistribute(owner, "zap123", []Recipient{NewRecipient(holder, 10)}))
+	require.Equal(t, CodeTokenPaused, res.Code, res.Log)
+
+	res = deliver(NewMsgRedenominate(owner, "zap123", 1, 10))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, "supply=100zap123 dust=1zap123", res.Log)
+	require.True(t, hasEvent(res, EventTypeRedenominate))
+	require.Equal(t, sdk.NewInt(1), k.CoinKeeper.GetCoins(ctx, holder).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(99), k.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+
+	require.True(t, deliver(NewMsgSetTokenPaused(owner, "zap123", false)).IsOK())
+	require.True(t, deliver(NewMsgBurnCoins(10, "zap123", owner)).IsOK())
+}
+
+func TestConversionHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, holder := types.KeyTestPubAddr()
+
+	deliver := func(msg sdk.Msg) sdk.Result {
+		return h(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	}
+
+	require.True(t, deliver(NewMsgIssueToken(owner, "Old", "old123", "OLD", 1000, true)).IsOK())
+	require.True(t, deliver(NewMsgIssueToken(owner, "New", "new123", "NEW", 1, true)).IsOK())
+	require.Nil(t, k.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("old123", 15)))
+
+	res := deliver(NewMsgOpenConversion(holder, "old123", "new123", 2, 1, start.Add(time.Hour)))
+	require.Equal(t, CodeInvalidOwner, res.Code, res.Log)
+	res = deliver(NewMsgOpenConversion(owner, "old123", "new123", 2, 1, start.Add(time.Hour)))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeOpenConversion))
+
+	res = deliver(NewMsgConvert(holder, "old123", 15))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, "received=30new123", res.Log)
+	require.True(t, hasEvent(res, EventTypeConvert))
+
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	EndBlocker(ctx, k)
+	res = deliver(NewMsgMintCoins(10, "old123", owner))
+	require.Equal(t, CodeTokenDisabled, res.Code, res.Log)
+	res = deliver(NewMsgMintCoins(10, "new123", owner))
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestTokenStatusHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+
+	deliver := func(msg sdk.Msg) sdk.Result {
+		return h(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	}
+
+	require.True(t, deliver(NewMsgIssueToken(owner, "Old", "old123", "OLD", 1000, true)).IsOK())
+	res := deliver(NewMsgSetTokenStatus(owner, "old123", TokenStatusDeprecated))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeTokenStatus))
+	res = deliver(NewMsgMintCoins(10, "old123", owner))
+	require.Equal(t, CodeTokenDeprecated, res.Code, res.Log)
+
+	res = deliver(NewMsgSetTokenStatus(owner, "old123", TokenStatusRetired))
+	require.Equal(t, CodeInvalidTokenStatus, res.Code, res.Log)
+	require.True(t, deliver(NewMsgBurnCoins(1000, "old123", owner)).IsOK())
+	res = deliver(NewMsgSetTokenStatus(owner, "old123", TokenStatusRetired))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the symbol of a retired token stays taken
+	res = deliver(NewMsgIssueToken(owner, "Old", "old123", "OLD", 1000, true))
+	require.Equal(t, CodeTokenSymbolAlreadyExists, res.Code, res.Log)
+}
+
+func TestCouncilHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	members := make([]sdk.AccAddress, 3)
+	for i := range members {
+		_, _, members[i] = types.KeyTestPubAddr()
+	}
+	council := CouncilAddress("abc123")
+	expiry := ctx.BlockTime().Add(time.Hour)
+
+	// like a transaction, a failed message leaves no trace
+	deliver := func(msg sdk.Msg) sdk.Result {
+		cacheCtx, write := ctx.WithEventManager(sdk.NewEventManager()).CacheContext()
+		res := h(cacheCtx, msg)
+		if res.IsOK() {
+			write()
+		}
+		return res
+	}
+
+	require.True(t, deliver(NewMsgIssueToken(owner, "Abc", "abc123", "ABC", 1000, true)).IsOK())
+	res := deliver(NewMsgSetCouncil(owner, "abc123", members, 2))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeSetCouncil))
+	res = deliver(NewMsgMintCoins(10, "abc123", owner))
+	require.Equal(t, CodeInvalidOwner, res.Code, res.Log)
+
+	// the action runs with the approval that reaches the threshold
+	res = deliver(NewMsgProposeAction(members[0], "abc123", NewMsgMintCoins(10, "abc123", council), expiry))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeProposeAction))
+	require.False(t, hasEvent(res, EventTypeExecuteAction))
+	res = deliver(NewMsgApproveAction(members[2], 1))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeExecuteAction))
+	require.Equal(t, int64(10), k.CoinKeeper.GetCoins(ctx, council).AmountOf("abc123").Int64())
+	_, err := k.GetPendingAction(ctx, 1)
+	require.Equal(t, CodeActionDoesNotExist, err.Code())
+
+	// a failing action fails the approval that runs it
+	res = deliver(NewMsgProposeAction(members[1], "abc123", NewMsgBurnCoins(100, "abc123", council), expiry))
+	require.True(t, res.IsOK(), res.Log)
+	res = deliver(NewMsgApproveAction(members[0], 2))
+	require.Equal(t, CodeInsufficientCoins, res.Code, res.Log)
+	action, err := k.GetPendingAction(ctx, 2)
+	require.Nil(t, err)
+	require.Len(t, action.Approvals, 1)
+
+	res = deliver(NewMsgProposeAction(owner, "abc123", NewMsgMintCoins(10, "abc123", council), expiry))
+	require.Equal(t, CodeNotCouncilMember, res.Code, res.Log)
+	res = deliver(NewMsgRevokeApproval(members[2], 2))
+	require.Equal(t, CodeInvalidAction, res.Code, res.Log)
+}
+
+func TestTimelockHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, stranger := types.KeyTestPubAddr()
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Abc", "abc123", "ABC", 1000, true)).IsOK())
+	res := h(ctx, NewMsgSetTimelock(owner, "abc123", time.Hour))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeSetTimelock))
+
+	// rule changes are queued instead of applied, everything else goes through
+	res = h(ctx.WithEventManager(sdk.NewEventManager()), NewMsgSetHoldingLimits(owner, "abc123", 500, 0))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeQueueAction))
+	res = h(ctx, NewMsgSetTimelock(owner, "abc123", 0))
+	require.True(t, res.IsOK(), res.Log)
+	token, _ := k.GetToken(ctx, "abc123")
+	require.Equal(t, int64(0), token.MaxBalancePerAccount)
+	require.Equal(t, time.Hour, token.Timelock)
+	require.True(t, h(ctx, NewMsgMintCoins(10, "abc123", owner)).IsOK())
+	res = h(ctx, NewMsgSetHoldingLimits(stranger, "abc123", 500, 0))
+	require.Equal(t, CodeInvalidOwner, res.Code, res.Log)
+
+	res = h(ctx, NewMsgCancelQueuedAction(stranger, 2))
+	require.Equal(t, CodeInvalidOwner, res.Code, res.Log)
+	res = h(ctx.WithEventManager(sdk.NewEventManager()), NewMsgCancelQueuedAction(owner, 2))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeCancelAction))
+
+	// the end blocker runs what is left once the delay has passed
+	EndBlocker(ctx.WithBlockTime(now.Add(59*time.Minute)), k)
+	token, _ = k.GetToken(ctx, "abc123")
+	require.Equal(t, int64(0), token.MaxBalancePerAccount)
+	blockCtx := ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	EndBlocker(blockCtx, k)
+	require.True(t, hasEvent(sdk.Result{Events: blockCtx.EventManager().Events()}, EventTypeRunAction))
+	token, _ = k.GetToken(ctx, "abc123")
+	require.Equal(t, int64(500), token.MaxBalancePerAccount)
+	require.Equal(t, time.Hour, token.Timelock)
+	require.Empty(t, k.GetTokenQueuedActions(ctx, "abc123"))
+}
+
+func TestGrantHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, grantee := types.KeyTestPubAddr()
+	_, _, stranger := types.KeyTestPubAddr()
+	mint := MsgMintCoins{}.Type()
+
+	// like a transaction, a failed message leaves no trace
+	deliver := func(msg sdk.Msg) sdk.Result {
+		cacheCtx, write := ctx.WithEventManager(sdk.NewEventManager()).CacheContext()
+		res := h(cacheCtx, msg)
+		if res.IsOK() {
+			write()
+		}
+		return res
+	}
+
+	require.True(t, deliver(NewMsgIssueToken(owner, "Abc", "abc123", "ABC", 1000, true)).IsOK())
+	res := deliver(NewMsgGrant(owner, grantee, "abc123", mint, 100, time.Time{}))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeGrant))
+
+	// the grantee mints for the owner within the spend limit, nobody else can
+	res = deliver(NewMsgExec(grantee, []sdk.Msg{NewMsgMintCoins(60, "abc123", owner)}))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeExec))
+	require.Equal(t, int64(1060), k.CoinKeeper.GetCoins(ctx, owner).AmountOf("abc123").Int64())
+	res = deliver(NewMsgExec(stranger, []sdk.Msg{NewMsgMintCoins(10, "abc123", owner)}))
+	require.Equal(t, CodeGrantDoesNotExist, res.Code, res.Log)
+	res = deliver(NewMsgExec(grantee, []sdk.Msg{NewMsgMintCoins(30, "abc123", owner),
+		NewMsgMintCoins(30, "abc123", owner)}))
+	require.Equal(t, CodeInsufficientGrant, res.Code, res.Log)
+	grant, _ := k.GetGrant(ctx, owner, grantee, "abc123", mint)
+	require.Equal(t, int64(40), grant.SpendLimit.Int64())
+	require.Equal(t, int64(1060), k.CoinKeeper.GetCoins(ctx, owner).AmountOf("abc123").Int64())
+
+	// the inner message still has to pass its own checks
+	require.True(t, deliver(NewMsgGrant(owner, grantee, "abc123", MsgBurnCoins{}.Type(), 0, time.Time{})).IsOK())
+	res = deliver(NewMsgExec(grantee, []sdk.Msg{NewMsgBurnCoins(2000, "abc123", owner)}))
+	require.False(t, res.IsOK(), res.Log)
+
+	res = deliver(NewMsgRevokeGrant(stranger, grantee, "abc123", mint))
+	require.Equal(t, CodeGrantDoesNotExist, res.Code, res.Log)
+	res = deliver(NewMsgRevokeGrant(owner, grantee, "abc123", mint))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeRevokeGrant))
+	res = deliver(NewMsgExec(grantee, []sdk.Msg{NewMsgMintCoins(10, "abc123", owner)}))
+	require.Equal(t, CodeGrantDoesNotExist, res.Code, res.Log)
+}
+
+func TestOwnershipHandlers(t *testing.T) {
+	ctx, k := keeper.CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	h := NewHandler(k)
+	_, _, owner := types.KeyTestPubAddr()
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+
+	require.True(t, h(ctx, NewMsgIssueToken(owner, "Abc", "abc123", "ABC", 1000, true)).IsOK())
+	res := h(ctx.WithEventManager(sdk.NewEventManager()), NewMsgSetTokenMetadata(owner, "abc123", "Xyz", "XYZ"))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeTokenMetadata))
+	res = h(ctx.WithEventManager(sdk.NewEventManager()), NewMsgTransferOwnership(owner, "abc123", alice))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeOwnership))
+	res = h(ctx, NewMsgTransferOwnership(owner, "abc123", bob))
+	require.Equal(t, CodeInvalidOwner, res.Code, res.Log)
+
+	// under a timelock the owner's grants and handovers wait like any other rule change
+	require.True(t, h(ctx, NewMsgSetTimelock(alice, "abc123", time.Hour)).IsOK())
+	res = h(ctx.WithEventManager(sdk.NewEventManager()), NewMsgGrant(alice, bob, "abc123", MsgMintCoins{}.Type(), 0,
+		time.Time{}))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeQueueAction))
+	_, err := k.GetGrant(ctx, alice, bob, "abc123", MsgMintCoins{}.Type())
+	require.Equal(t, CodeGrantDoesNotExist, err.Code())
+	res = h(ctx.WithEventManager(sdk.NewEventManager()), NewMsgTransferOwnership(alice, "abc123", bob))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, hasEvent(res, EventTypeQueueAction))
+	token, _ := k.GetToken(ctx, "abc123")
+	require.Equal(t, alice, token.Owner)
+	require.Len(t, k.GetTokenQueuedActions(ctx, "abc123"), 2)
+
+	// a holder granting over its own coins isn't held back
+	require.True(t, h(ctx, NewMsgGrant(bob, alice, "abc123", MsgBurnCoins{}.Type(), 0, time.Time{})).IsOK())
+	_, err = k.GetGrant(ctx, bob, alice, "abc123", MsgBurnCoins{}.Type())
+	require.Nil(t, err)
+
+	EndBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), k)
+	token, _ = k.GetToken(ctx, "abc123")
+	require.Equal(t, bob, token.Owner)
+	require.Empty(t, k.GetTokenQueuedActions(ctx, "abc123"))
+}
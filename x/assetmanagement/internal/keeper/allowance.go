@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetAllowance - gets the allowance of a spender over a token of an owner
+func (k Keeper) GetAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, symbol string) (types.Allowance,
+	sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AllowanceKey(owner, spender, symbol))
+	if bz == nil {
+		return types.Allowance{}, types.ErrAllowanceDoesNotExist(k.codespace, owner, spender, symbol)
+	}
+	var allowance types.Allowance
+	k.cdc.MustUnmarshalBinaryBare(bz, &allowance)
+	return allowance, nil
+}
+
+// SetAllowance - stores an allowance and indexes it by spender
+func (k Keeper) SetAllowance(ctx sdk.Context, allowance types.Allowance) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AllowanceKey(allowance.Owner, allowance.Spender, allowance.Symbol),
+		k.cdc.MustMarshalBinaryBare(allowance))
+	store.Set(types.SpenderAllowanceKey(allowance.Owner, allowance.Spender, allowance.Symbol), []byte{0x01})
+}
+
+// deleteAllowance - removes an allowance along with its index entry
+func (k Keeper) deleteAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, symbol string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AllowanceKey(owner, spender, symbol))
+	store.Delete(types.SpenderAllowanceKey(owner, spender, symbol))
+}
+
+// IterateAllowances - iterates over all allowances, grouped by owner, until the callback returns true
+func (k Keeper) IterateAllowances(ctx sdk.Context, cb func(allowance types.Allowance) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AllowanceKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.Allowance
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &allowance)
+		if cb(allowance) {
+			break
+		}
+	}
+}
+
+// GetOwnerAllowances - gets the allowances an owner granted, expired ones included
+func (k Keeper) GetOwnerAllowances(ctx sdk.Context, owner sdk.AccAddress) []types.Allowance {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.OwnerAllowancesPrefix(owner))
+	defer iterator.Close()
+	allowances := make([]types.Allowance, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.Allowance
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &allowance)
+		allowances = append(allowances, allowance)
+	}
+	return allowances
+}
+
+// GetSpenderAllowances - gets the allowances granted to a spender, expired ones included
+func (k Keeper) GetSpenderAllowances(ctx sdk.Context, spender sdk.AccAddress) []types.Allowance {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.SpenderAllowancesPrefix(spender)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	allowances := make([]types.Allowance, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(iterator.Key()[len(prefix):])
+		if bz == nil {
+			panic(fmt.Sprintf("indexed allowance is missing: %X", iterator.Key()))
+		}
+		var allowance types.Allowance
+		k.cdc.MustUnmarshalBinaryBare(bz, &allowance)
+		allowances = append(allowances, allowance)
+	}
+	return allowances
+}
+
+// Approve - lets the spender move up to the amount of a token out of the owner's account until the expiry, a zero
+// expiry never expires. It replaces any earlier allowance of the spender over the token, an amount of zero revokes it
+func (k Keeper) Approve(ctx sdk.Context, owner, spender sdk.AccAddress, symbol string, amount int64,
+	expiry time.Time) sdk.Error {
+	if !k.IsSymbolPresent(ctx, symbol) {
+		return types.ErrTokenSymbolDoesNotExist(k.codespace, symbol)
+	}
+
+	allowance := types.NewAllowance(owner, spender, symbol, amount, expiry)
+	if amount == 0 {
+		k.deleteAllowance(ctx, owner, spender, symbol)
+	} else {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+		if allowance.IsExpired(ctx.BlockTime()) {
+			return types.ErrInvalidAllowance(k.codespace, fmt.Sprintf("expiry %s has already passed", expiry))
+		}
+		k.SetAllowance(ctx, allowance)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeApprove,
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeySpender, spender.String()),
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, allowance.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiry, expiry.Format(time.RFC3339)),
+	))
+	return nil
+}
+
+// TransferFrom - moves coins of a token from an account to the recipient on behalf of the spender and takes them off
+// the allowance the account granted it. Only free coins can be moved, frozen ones stay put, and the transfer is
+// subject to the same send restrictions as a bank transfer. Returns what is left of the allowance
+func (k Keeper) TransferFrom(ctx sdk.Context, spender, from, to sdk.AccAddress, symbol string,
+	amount int64) (sdk.Int, sdk.Error) {
+	if !k.CoinKeeper.GetSendEnabled(ctx) {
+		return sdk.ZeroInt(), bank.ErrSendDisabled(bank.DefaultCodespace)
+	}
+	if !k.IsSymbolPresent(ctx, symbol) {
+		return sdk.ZeroInt(), types.ErrTokenSymbolDoesNotExist(k.codespace, symbol)
+	}
+	allowance, err := k.GetAllowance(ctx, from, spender, symbol)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if allowance.IsExpired(ctx.BlockTime()) {
+		return sdk.ZeroInt(), types.ErrAllowanceExpired(k.codespace, from, spender, symbol)
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin(symbol, amount))
+	if allowance.Amount.LT(coins.AmountOf(symbol)) {
+		return sdk.ZeroInt(), types.ErrInsufficientAllowance(k.codespace,
+			fmt.Sprintf("%s may only spend %s%s of %s, not %s", spender, allowance.Amount, symbol, from, coins))
+	}
+	if k.CoinKeeper.BlacklistedAddr(to) {
+		return sdk.ZeroInt(), sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", to))
+	}
+	if err := k.CheckTransferMode(ctx, from, to, coins); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	account := k.AccountKeeper.GetAccount(ctx, from)
+	if account == nil {
+		return sdk.ZeroInt(), types.ErrUnknownAccount(k.codespace, from)
+	}
+	if !account.GetCoins().IsAllGTE(coins) {
+		return sdk.ZeroInt(), types.ErrInsufficientCoins(k.codespace,
+			fmt.Sprintf("%s holds %s, not enough to transfer %s", from, account.GetCoins(), coins))
+	}
+	err = k.CheckHoldingLimits(ctx, []bank.Input{bank.NewInput(from, coins)}, []bank.Output{bank.NewOutput(to, coins)})
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if err := k.CoinKeeper.SendCoins(ctx, from, to, coins); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if _, err := k.ChargeTransferFee(ctx, from, to, coins); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	allowance.Amount = allowance.Amount.Sub(coins.AmountOf(symbol))
+	if allowance.Amount.IsZero() {
+		k.deleteAllowance(ctx, from, spender, symbol)
+	} else {
+		k.SetAllowance(ctx, allowance)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferFrom,
+		sdk.NewAttribute(types.AttributeKeySpender, spender.String()),
+		sdk.NewAttribute(types.AttributeKeySender, from.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, to.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		sdk.NewAttribute(types.AttributeKeyRemaining, allowance.Amount.String()),
+	))
+	return allowance.Amount, nil
+}
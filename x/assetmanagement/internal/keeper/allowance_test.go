@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestAllowance(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, spender := types.KeyTestPubAddr()
+	_, _, recipient := types.KeyTestPubAddr()
+
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist,
+		keeper.Approve(ctx, owner, spender, "abc123", 100, time.Time{}).Code())
+	require.Equal(t, types.CodeInvalidAllowance, keeper.Approve(ctx, owner, spender, "zap123", 100, now).Code())
+	require.Nil(t, keeper.Approve(ctx, owner, spender, "zap123", 300, now.Add(time.Hour)))
+	require.Len(t, keeper.GetOwnerAllowances(ctx, owner), 1)
+	require.Len(t, keeper.GetSpenderAllowances(ctx, spender), 1)
+
+	_, err := keeper.TransferFrom(ctx, recipient, owner, recipient, "zap123", 100)
+	require.Equal(t, types.CodeAllowanceDoesNotExist, err.Code())
+	_, err = keeper.TransferFrom(ctx, spender, owner, recipient, "zap123", 301)
+	require.Equal(t, types.CodeInsufficientAllowance, err.Code())
+
+	remaining, err := keeper.TransferFrom(ctx, spender, owner, recipient, "zap123", 100)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(200), remaining)
+	require.Equal(t, sdk.NewInt(100), keeper.CoinKeeper.GetCoins(ctx, recipient).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(900), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+
+	// frozen coins can't be moved, even within the allowance
+	require.Nil(t, keeper.FreezeCoins(ctx, owner, types.NewTestCoins("zap123", 800)))
+	_, err = keeper.TransferFrom(ctx, spender, owner, recipient, "zap123", 150)
+	require.Equal(t, types.CodeInsufficientCoins, err.Code())
+	require.Nil(t, keeper.UnfreezeCoins(ctx, owner, types.NewTestCoins("zap123", 800)))
+
+	// nor can anything be moved while sends are disabled
+	keeper.CoinKeeper.SetSendEnabled(ctx, false)
+	_, err = keeper.TransferFrom(ctx, spender, owner, recipient, "zap123", 150)
+	require.NotNil(t, err)
+	keeper.CoinKeeper.SetSendEnabled(ctx, true)
+
+	_, err = keeper.TransferFrom(ctx.WithBlockTime(now.Add(time.Hour)), spender, owner, recipient, "zap123", 150)
+	require.Equal(t, types.CodeAllowanceExpired, err.Code())
+
+	// using up the allowance removes it
+	remaining, err = keeper.TransferFrom(ctx, spender, owner, recipient, "zap123", 200)
+	require.Nil(t, err)
+	require.True(t, remaining.IsZero())
+	_, err = keeper.GetAllowance(ctx, owner, spender, "zap123")
+	require.Equal(t, types.CodeAllowanceDoesNotExist, err.Code())
+	require.Empty(t, keeper.GetSpenderAllowances(ctx, spender))
+
+	// approving zero revokes
+	require.Nil(t, keeper.Approve(ctx, owner, spender, "zap123", 50, time.Time{}))
+	require.Nil(t, keeper.Approve(ctx, owner, spender, "zap123", 0, time.Time{}))
+	require.Empty(t, keeper.GetOwnerAllowances(ctx, owner))
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAllowanceIndexes(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, spender := types.KeyTestPubAddr()
+	_, _, other := types.KeyTestPubAddr()
+
+	require.Nil(t, keeper.Approve(ctx, owner, spender, "zap123", 10, time.Time{}))
+	require.Nil(t, keeper.Approve(ctx, owner, other, "zap123", 20, time.Time{}))
+	require.Nil(t, keeper.Approve(ctx, other, spender, "zap123", 30, time.Time{}))
+
+	require.Len(t, keeper.GetOwnerAllowances(ctx, owner), 2)
+	require.Len(t, keeper.GetOwnerAllowances(ctx, other), 1)
+	require.Len(t, keeper.GetSpenderAllowances(ctx, spender), 2)
+	require.Len(t, keeper.GetSpenderAllowances(ctx, other), 1)
+	require.Equal(t, sdk.NewInt(20), keeper.GetSpenderAllowances(ctx, other)[0].Amount)
+}
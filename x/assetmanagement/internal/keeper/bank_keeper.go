@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+var _ bank.Keeper = BankKeeper{}
+
+// BankKeeper wraps the bank keeper so that the holders of the tokens follow every change of balance, whichever
+// module makes it. The app hands it to all the keepers moving coins in place of the plain bank keeper
+type BankKeeper struct {
+	bank.Keeper
+	holders holderIndex
+}
+
+// NewBankKeeper wraps a bank keeper, the store key is the one of the assetmanagement module
+func NewBankKeeper(bankKeeper bank.Keeper, accountKeeper auth.AccountKeeper, storeKey sdk.StoreKey) BankKeeper {
+	return BankKeeper{
+		Keeper:  bankKeeper,
+		holders: holderIndex{accountKeeper: accountKeeper, storeKey: storeKey},
+	}
+}
+
+func (bk BankKeeper) InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error {
+	if err := bk.Keeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	for _, input := range inputs {
+		bk.holders.update(ctx, input.Address, input.Coins)
+	}
+	for _, output := range outputs {
+		bk.holders.update(ctx, output.Address, output.Coins)
+	}
+	return nil
+}
+
+func (bk BankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress,
+	amt sdk.Coins) sdk.Error {
+	if err := bk.Keeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	bk.holders.update(ctx, fromAddr, amt)
+	bk.holders.update(ctx, toAddr, amt)
+	return nil
+}
+
+func (bk BankKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
+	coins, err := bk.Keeper.SubtractCoins(ctx, addr, amt)
+	if err != nil {
+		return coins, err
+	}
+	bk.holders.update(ctx, addr, amt)
+	return coins, nil
+}
+
+func (bk BankKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
+	coins, err := bk.Keeper.AddCoins(ctx, addr, amt)
+	if err != nil {
+		return coins, err
+	}
+	bk.holders.update(ctx, addr, amt)
+	return coins, nil
+}
+
+func (bk BankKeeper) SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	// the tokens the account no longer holds are among its previous coins
+	previous := bk.GetCoins(ctx, addr)
+	if err := bk.Keeper.SetCoins(ctx, addr, amt); err != nil {
+		return err
+	}
+	bk.holders.update(ctx, addr, previous.Add(amt))
+	return nil
+}
+
+func (bk BankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress,
+	amt sdk.Coins) sdk.Error {
+	if err := bk.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+	bk.holders.update(ctx, delegatorAddr, amt)
+	return nil
+}
+
+func (bk BankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress,
+	amt sdk.Coins) sdk.Error {
+	if err := bk.Keeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+	bk.holders.update(ctx, delegatorAddr, amt)
+	return nil
+}
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetNextCampaignID - gets the ID the next claim campaign will be created with
+func (k Keeper) GetNextCampaignID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextCampaignIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextCampaignID - sets the ID the next claim campaign will be created with
+func (k Keeper) SetNextCampaignID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextCampaignIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetClaimCampaign - gets a claim campaign by ID
+func (k Keeper) GetClaimCampaign(ctx sdk.Context, id uint64) (types.ClaimCampaign, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CampaignKey(id))
+	if bz == nil {
+		return types.ClaimCampaign{}, types.ErrCampaignDoesNotExist(k.codespace, id)
+	}
+	var campaign types.ClaimCampaign
+	k.cdc.MustUnmarshalBinaryBare(bz, &campaign)
+	return campaign, nil
+}
+
+// SetClaimCampaign - stores a claim campaign and queues it to expire at its end time
+func (k Keeper) SetClaimCampaign(ctx sdk.Context, campaign types.ClaimCampaign) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CampaignKey(campaign.ID), k.cdc.MustMarshalBinaryBare(campaign))
+	store.Set(types.CampaignQueueKey(campaign.EndTime, campaign.ID), sdk.Uint64ToBigEndian(campaign.ID))
+}
+
+// deleteClaimCampaign - removes a claim campaign along with its queue entry and claim records
+func (k Keeper) deleteClaimCampaign(ctx sdk.Context, campaign types.ClaimCampaign) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CampaignKey(campaign.ID))
+	store.Delete(types.CampaignQueueKey(campaign.EndTime, campaign.ID))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimedKeysPrefix(campaign.ID))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateClaimCampaigns - iterates over all claim campaigns in ID order until the callback returns true
+func (k Keeper) IterateClaimCampaigns(ctx sdk.Context, cb func(campaign types.ClaimCampaign) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CampaignKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var campaign types.ClaimCampaign
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &campaign)
+		if cb(campaign) {
+			break
+		}
+	}
+}
+
+// HasClaimed - returns whether the address already claimed from the campaign
+func (k Keeper) HasClaimed(ctx sdk.Context, id uint64, address sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ClaimedKey(id, address))
+}
+
+// SetClaimed - records that the address claimed from the campaign
+func (k Keeper) SetClaimed(ctx sdk.Context, id uint64, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.ClaimedKey(id, address), []byte{0x01})
+}
+
+// IterateClaimRecords - iterates over the claims of all campaigns until the callback returns true
+func (k Keeper) IterateClaimRecords(ctx sdk.Context, cb func(record types.ClaimRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClaimedKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.ClaimedKeyPrefix):]
+		record := types.ClaimRecord{
+			CampaignID: binary.BigEndian.Uint64(key[:8]),
+			Address:    sdk.AccAddress(key[8:]),
+		}
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// CreateClaimCampaign - moves the deposit from the token owner into the claims pool and registers the campaign.
+// Returns the ID of the new campaign
+func (k Keeper) CreateClaimCampaign(ctx sdk.Context, owner sdk.AccAddress, symbol, merkleRoot string,
+	deposit int64, endTime time.Time) (uint64, sdk.Error) {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return 0, err
+	}
+	if !owner.Equals(token.Owner) {
+		return 0, types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	if token.Disabled {
+		return 0, types.ErrTokenDisabled(k.codespace, symbol)
+	}
+	if token.IsDeprecated() {
+		return 0, types.ErrTokenDeprecated(k.codespace, symbol)
+	}
+	if token.Paused {
+		return 0, types.ErrTokenPaused(k.codespace, symbol)
+	}
+	if !endTime.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidCampaign(k.codespace,
+			fmt.Sprintf("end time %s is not after the current block time %s", endTime, ctx.BlockTime()))
+	}
+
+	campaign := types.NewClaimCampaign(k.GetNextCampaignID(ctx), owner, symbol, merkleRoot, deposit, endTime)
+	account := k.AccountKeeper.GetAccount(ctx, owner)
+	if account == nil {
+		return 0, types.ErrUnknownAccount(k.codespace, owner)
+	}
+	if !account.GetCoins().IsAllGTE(campaign.Deposit) {
+		return 0, types.ErrInsufficientCoins(k.codespace,
+			fmt.Sprintf("%s holds %s, not enough to deposit %s", owner, account.GetCoins(), campaign.Deposit))
+	}
+	if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ClaimsPoolName, campaign.Deposit); err != nil {
+		return 0, err
+	}
+
+	k.SetClaimCampaign(ctx, campaign)
+	k.SetNextCampaignID(ctx, campaign.ID+1)
+	return campaign.ID, nil
+}
+
+// countClaimCampaigns counts the live claim campaigns of a token
+func (k Keeper) countClaimCampaigns(ctx sdk.Context, symbol string) int {
+	campaigns := 0
+	k.IterateClaimCampaigns(ctx, func(campaign types.ClaimCampaign) bool {
+		if campaign.Symbol == symbol {
+			campaigns++
+		}
+		return false
+	})
+	return campaigns
+}
+
+// Claim - pays the claimant the amount the campaign's claim tree assigns to it, once. The payout follows the rules of a
+// transfer from the token's owner, so a disabled, paused or deprecated token only reaches claimants a transfer would
+func (k Keeper) Claim(ctx sdk.Context, claimant sdk.AccAddress, id uint64, amount int64, proof [][]byte) sdk.Error {
+	campaign, err := k.GetClaimCampaign(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ctx.BlockTime().Before(campaign.EndTime) {
+		return types.ErrCampaignExpired(k.codespace, id)
+	}
+	if k.HasClaimed(ctx, id, claimant) {
+		return types.ErrAlreadyClaimed(k.codespace, claimant, id)
+	}
+	root, _ := hex.DecodeString(campaign.MerkleRoot)
+	if !types.VerifyClaimProof(root, types.ClaimLeaf(claimant, amount), proof) {
+		return types.ErrInvalidClaimProof(k.codespace, claimant, id)
+	}
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(campaign.Symbol, amount))
+	if !campaign.Deposit.IsAllGTE(coins) {
+		return types.ErrInsufficientCoins(k.codespace,
+			fmt.Sprintf("campaign %d has %s left, not enough to pay %s", id, campaign.Deposit, coins))
+	}
+	token, err := k.GetToken(ctx, campaign.Symbol)
+	if err != nil {
+		return err
+	}
+	if err := k.CheckTransferMode(ctx, token.Owner, claimant, coins); err != nil {
+		return err
+	}
+	if err := k.CheckHoldingLimits(ctx, nil, []bank.Output{bank.NewOutput(claimant, coins)}); err != nil {
+		return err
+	}
+	if err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ClaimsPoolName, claimant, coins); err != nil {
+		return err
+	}
+
+	campaign.Deposit = campaign.Deposit.Sub(coins)
+	k.SetClaimCampaign(ctx, campaign)
+	k.SetClaimed(ctx, id, claimant)
+	return nil
+}
+
+// ExpireClaimCampaigns - returns what is left of every campaign that has reached its end time to the owner and
+// removes the campaign. A refund that fails keeps its campaign queued, so it is tried again in the next block
+func (k Keeper) ExpireClaimCampaigns(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.CampaignQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.CampaignQueueTimeKey(ctx.BlockTime())))
+	var keys [][]byte
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for i, id := range ids {
+		campaign, err := k.GetClaimCampaign(ctx, id)
+		if err != nil {
+			store.Delete(keys[i])
+			ctx.Logger().Error(fmt.Sprintf("queued claim campaign %d is missing, dropped from the queue", id))
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if !campaign.Deposit.IsZero() {
+			err = k.SupplyKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ClaimsPoolName, campaign.Owner,
+				campaign.Deposit)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("failed to refund claim campaign %d, retrying in the next block: %s",
+					id, err))
+				continue
+			}
+		}
+		k.deleteClaimCampaign(cacheCtx, campaign)
+		write()
+		ctx.Logger().Info(fmt.Sprintf("claim campaign %d ended, refunded %s to %s", id, campaign.Deposit, campaign.Owner))
+	}
+}
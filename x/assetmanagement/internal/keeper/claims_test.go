@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestClaimCampaign(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+
+	var recipients []sdk.AccAddress
+	var leaves [][]byte
+	for i := 0; i < 3; i++ {
+		_, _, recipient := types.KeyTestPubAddr()
+		recipients = append(recipients, recipient)
+		leaves = append(leaves, types.ClaimLeaf(recipient, 100))
+	}
+	root, proofs := types.BuildClaimTree(leaves)
+
+	_, _, stranger := types.KeyTestPubAddr()
+	_, err := keeper.CreateClaimCampaign(ctx, stranger, "zap123", hex.EncodeToString(root), 300, start.Add(time.Hour))
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	_, err = keeper.CreateClaimCampaign(ctx, owner, "zap123", hex.EncodeToString(root), 300, start)
+	require.Equal(t, types.CodeInvalidCampaign, err.Code())
+	_, err = keeper.CreateClaimCampaign(ctx, owner, "zap123", hex.EncodeToString(root), 5000, start.Add(time.Hour))
+	require.Equal(t, types.CodeInsufficientCoins, err.Code())
+
+	id, err := keeper.CreateClaimCampaign(ctx, owner, "zap123", hex.EncodeToString(root), 300, start.Add(time.Hour))
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, uint64(2), keeper.GetNextCampaignID(ctx))
+	require.Equal(t, sdk.NewInt(700), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+
+	// campaign records don't show up as tokens
+	var symbols []string
+	keeper.IterateTokens(ctx, func(token types.Token) bool {
+		symbols = append(symbols, token.Symbol)
+		return false
+	})
+	require.Equal(t, []string{"zap123"}, symbols)
+
+	require.Nil(t, keeper.Claim(ctx, recipients[0], id, 100, proofs[0]))
+	require.Equal(t, sdk.NewInt(100), keeper.CoinKeeper.GetCoins(ctx, recipients[0]).AmountOf("zap123"))
+	require.Equal(t, types.CodeAlreadyClaimed, keeper.Claim(ctx, recipients[0], id, 100, proofs[0]).Code())
+	require.Equal(t, types.CodeInvalidClaimProof, keeper.Claim(ctx, recipients[1], id, 200, proofs[1]).Code())
+	require.Equal(t, types.CodeInvalidClaimProof, keeper.Claim(ctx, stranger, id, 100, proofs[1]).Code())
+	require.Equal(t, types.CodeCampaignDoesNotExist, keeper.Claim(ctx, recipients[1], 7, 100, proofs[1]).Code())
+	require.Nil(t, keeper.Claim(ctx, recipients[1], id, 100, proofs[1]))
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// nothing expires before the end time
+	keeper.ExpireClaimCampaigns(ctx.WithBlockTime(start.Add(time.Minute)))
+	campaign, err := keeper.GetClaimCampaign(ctx, id)
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 100), campaign.Deposit)
+
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	require.Equal(t, types.CodeCampaignExpired, keeper.Claim(ctx, recipients[2], id, 100, proofs[2]).Code())
+	keeper.ExpireClaimCampaigns(ctx)
+	_, err = keeper.GetClaimCampaign(ctx, id)
+	require.Equal(t, types.CodeCampaignDoesNotExist, err.Code())
+	require.False(t, keeper.HasClaimed(ctx, id, recipients[0]))
+	require.Equal(t, sdk.NewInt(800), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+
+	_, broken = AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestClaimFollowsTransferRules(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupConversion(t, ctx, keeper)
+	_, _, holder := types.KeyTestPubAddr()
+	_, _, newcomer := types.KeyTestPubAddr()
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "old123", []types.Recipient{types.NewRecipient(holder, 1)}))
+
+	root, proofs := types.BuildClaimTree([][]byte{types.ClaimLeaf(holder, 100), types.ClaimLeaf(newcomer, 100)})
+	id, err := keeper.CreateClaimCampaign(ctx, owner, "old123", hex.EncodeToString(root), 200, start.Add(time.Hour))
+	require.Nil(t, err)
+
+	// a source whose claims would be stranded once it is disabled can't be converted away
+	err = keeper.OpenConversion(ctx, owner, "old123", "new456", 1, 1, start.Add(2*time.Hour))
+	require.Equal(t, types.CodeInvalidConversion, err.Code())
+
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "old123", true))
+	require.Equal(t, types.CodeTokenPaused, keeper.Claim(ctx, holder, id, 100, proofs[0]).Code())
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "old123", false))
+
+	// a deprecated token only pays out to accounts already holding it
+	require.Nil(t, keeper.SetTokenStatus(ctx, owner, "old123", types.TokenStatusDeprecated))
+	require.Equal(t, types.CodeTokenDeprecated, keeper.Claim(ctx, newcomer, id, 100, proofs[1]).Code())
+	require.False(t, keeper.HasClaimed(ctx, id, newcomer))
+	require.Nil(t, keeper.Claim(ctx, holder, id, 100, proofs[0]))
+	require.Equal(t, sdk.NewInt(101), keeper.CoinKeeper.GetCoins(ctx, holder).AmountOf("old123"))
+}
+
+func TestExpireClaimCampaignFailure(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, recipient := types.KeyTestPubAddr()
+	root, _ := types.BuildClaimTree([][]byte{types.ClaimLeaf(recipient, 100)})
+	id, err := keeper.CreateClaimCampaign(ctx, owner, "zap123", hex.EncodeToString(root), 100, start.Add(time.Hour))
+	require.Nil(t, err)
+
+	// a refund the pool can't pay keeps the campaign until a later block can
+	deposit := types.NewTestCoins("zap123", 100)
+	require.Nil(t, keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ClaimsPoolName, recipient, deposit))
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	keeper.ExpireClaimCampaigns(ctx)
+	_, err = keeper.GetClaimCampaign(ctx, id)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(900), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+
+	require.Nil(t, keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, recipient, types.ClaimsPoolName, deposit))
+	keeper.ExpireClaimCampaigns(ctx.WithBlockTime(start.Add(2 * time.Hour)))
+	_, err = keeper.GetClaimCampaign(ctx, id)
+	require.Equal(t, types.CodeCampaignDoesNotExist, err.Code())
+	require.Equal(t, sdk.NewInt(1000), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+
+	// a queued campaign that is gone is dropped from the queue
+	id, err = keeper.CreateClaimCampaign(ctx, owner, "zap123", hex.EncodeToString(root), 100, start.Add(3*time.Hour))
+	require.Nil(t, err)
+	ctx.KVStore(keeper.storeKey).Delete(types.CampaignKey(id))
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour))
+	keeper.ExpireClaimCampaigns(ctx)
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(types.CampaignQueueKey(start.Add(3*time.Hour), id)))
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetNextClawbackID - gets the ID the next clawback will be recorded with
+func (k Keeper) GetNextClawbackID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextClawbackIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextClawbackID - sets the ID the next clawback will be recorded with
+func (k Keeper) SetNextClawbackID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextClawbackIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetClawbackRecord - stores an entry of the clawback history of a token
+func (k Keeper) SetClawbackRecord(ctx sdk.Context, record types.ClawbackRecord) {
+	ctx.KVStore(k.storeKey).Set(types.ClawbackKey(record.Symbol, record.ID), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// IterateClawbackRecords - iterates over the clawback history of all tokens until the callback returns true
+func (k Keeper) IterateClawbackRecords(ctx sdk.Context, cb func(record types.ClawbackRecord) (stop bool)) {
+	k.iterateClawbackRecords(ctx, types.ClawbackKeyPrefix, cb)
+}
+
+// GetTokenClawbacks - gets the clawback history of a token, oldest first
+func (k Keeper) GetTokenClawbacks(ctx sdk.Context, symbol string) []types.ClawbackRecord {
+	records := make([]types.ClawbackRecord, 0)
+	k.iterateClawbackRecords(ctx, types.TokenClawbacksPrefix(symbol), func(record types.ClawbackRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+func (k Keeper) iterateClawbackRecords(ctx sdk.Context, prefix []byte, cb func(record types.ClawbackRecord) bool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ClawbackRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// SetClawbackAdmin - changes who besides the owner may claw back coins of a clawbackable token, an empty admin
+// leaves it to the owner alone
+func (k Keeper) SetClawbackAdmin(ctx sdk.Context, owner sdk.AccAddress, symbol string, admin sdk.AccAddress) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	if !token.Clawbackable {
+		return types.ErrTokenNotClawbackable(k.codespace, symbol)
+	}
+
+	token.ClawbackAdmin = admin
+	return k.SetToken(ctx, symbol, token)
+}
+
+// Clawback - takes coins of a clawbackable token from a holder on behalf of its owner or clawback admin. The free
+// balance is used first, whatever is missing is unfrozen. The coins are moved to the recipient, or burned when there
+// is none. Every clawback is recorded in the token's history, which is returned
+func (k Keeper) Clawback(ctx sdk.Context, authority sdk.AccAddress, symbol string, from, recipient sdk.AccAddress,
+	amount int64, reason string) (types.ClawbackRecord, sdk.Error) {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return types.ClawbackRecord{}, err
+	}
+	if !token.Clawbackable {
+		return types.ClawbackRecord{}, types.ErrTokenNotClawbackable(k.codespace, symbol)
+	}
+	if !token.CanClawback(authority) {
+		return types.ClawbackRecord{}, types.ErrNotClawbackAuthority(k.codespace, authority, symbol)
+	}
+	if token.Paused {
+		return types.ClawbackRecord{}, types.ErrTokenPaused(k.codespace, symbol)
+	}
+	if err := types.ValidateClawbackReason(reason); err != nil {
+		return types.ClawbackRecord{}, err
+	}
+	// module accounts hold coins on behalf of campaigns, grants and frozen balances, taking them would break those
+	if k.CoinKeeper.BlacklistedAddr(from) {
+		return types.ClawbackRecord{}, types.ErrInvalidClawback(k.codespace,
+			fmt.Sprintf("%s is a module account, its coins can't be clawed back", from))
+	}
+	if !recipient.Empty() && k.CoinKeeper.BlacklistedAddr(recipient) {
+		return types.ClawbackRecord{}, sdk.ErrUnauthorized(
+			fmt.Sprintf("%s is not allowed to receive transactions", recipient))
+	}
+
+	account := k.AccountKeeper.GetAccount(ctx, from)
+	if account == nil {
+		return types.ClawbackRecord{}, types.ErrUnknownAccount(k.codespace, from)
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin(symbol, amount))
+	free := account.GetCoins().AmountOf(symbol)
+	frozen := k.GetFrozenCoins(ctx, from).AmountOf(symbol)
+	if free.Add(frozen).LT(coins.AmountOf(symbol)) {
+		return types.ClawbackRecord{}, types.ErrInsufficientCoins(k.codespace,
+			fmt.Sprintf("%s holds %s%s free and %s%s frozen, not enough to claw back %s", from, free, symbol,
+				frozen, symbol, coins))
+	}
+	if shortfall := coins.AmountOf(symbol).Sub(free); shortfall.IsPositive() {
+		if err := k.UnfreezeCoins(ctx, from, sdk.NewCoins(sdk.NewCoin(symbol, shortfall))); err != nil {
+			return types.ClawbackRecord{}, err
+		}
+	}
+
+	if recipient.Empty() {
+		if err := k.BurnCoins(ctx, from, coins); err != nil {
+			return types.ClawbackRecord{}, err
+		}
+		if err := k.SetTotalSupply(ctx, symbol, token.TotalSupply.Sub(coins)); err != nil {
+			return types.ClawbackRecord{}, err
+		}
+		k.AfterBurn(ctx, symbol, from, coins)
+	} else {
+		err := k.CheckHoldingLimits(ctx, []bank.Input{bank.NewInput(from, coins)},
+			[]bank.Output{bank.NewOutput(recipient, coins)})
+		if err != nil {
+			return types.ClawbackRecord{}, err
+		}
+		if err := k.CoinKeeper.SendCoins(ctx, from, recipient, coins); err != nil {
+			return types.ClawbackRecord{}, err
+		}
+	}
+
+	record := types.ClawbackRecord{
+		ID:        k.GetNextClawbackID(ctx),
+		Symbol:    symbol,
+		Authority: authority,
+		From:      from,
+		Recipient: recipient,
+		Amount:    coins,
+		Reason:    reason,
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+	}
+	k.SetClawbackRecord(ctx, record)
+	k.SetNextClawbackID(ctx, record.ID+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClawback,
+		sdk.NewAttribute(types.AttributeKeyClawback, fmt.Sprintf("%d", record.ID)),
+		sdk.NewAttribute(types.AttributeKeyAuthority, authority.String()),
+		sdk.NewAttribute(types.AttributeKeySender, from.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+	return record, nil
+}
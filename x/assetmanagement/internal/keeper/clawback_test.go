@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func setupClawbackableToken(t *testing.T, ctx sdk.Context, keeper Keeper, symbol string, supply int64) sdk.AccAddress {
+	owner := setupToken(t, ctx, keeper, symbol, supply)
+	token, err := keeper.GetToken(ctx, symbol)
+	require.Nil(t, err)
+	token.Clawbackable = true
+	require.Nil(t, keeper.SetToken(ctx, symbol, token))
+	return owner
+}
+
+func TestClawback(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupClawbackableToken(t, ctx, keeper, "zap123", 1000)
+	_, _, holder := types.KeyTestPubAddr()
+	_, _, admin := types.KeyTestPubAddr()
+
+	require.Nil(t, keeper.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("zap123", 300)))
+	require.Nil(t, keeper.FreezeCoins(ctx, holder, types.NewTestCoins("zap123", 200)))
+
+	_, err := keeper.Clawback(ctx, admin, "zap123", holder, owner, 10, "court order")
+	require.Equal(t, types.CodeNotClawbackAuthority, err.Code())
+	_, err = keeper.Clawback(ctx, owner, "zap123", holder, owner, 10, "")
+	require.Equal(t, types.CodeInvalidClawback, err.Code())
+	_, err = keeper.Clawback(ctx, owner, "zap123", holder, owner, 301, "court order")
+	require.Equal(t, types.CodeInsufficientCoins, err.Code())
+
+	// the free balance goes first, the rest is unfrozen
+	record, err := keeper.Clawback(ctx, owner, "zap123", holder, owner, 150, "court order")
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), record.ID)
+	require.False(t, record.Burned())
+	require.True(t, keeper.CoinKeeper.GetCoins(ctx, holder).AmountOf("zap123").IsZero())
+	require.Equal(t, types.NewTestCoins("zap123", 150), keeper.GetFrozenCoins(ctx, holder))
+	require.Equal(t, sdk.NewInt(850), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+
+	// the admin can act too, and burning without a recipient shrinks the supply
+	require.Nil(t, keeper.SetClawbackAdmin(ctx, owner, "zap123", admin))
+	record, err = keeper.Clawback(ctx, admin, "zap123", holder, nil, 150, "stolen funds")
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), record.ID)
+	require.True(t, record.Burned())
+	require.True(t, keeper.GetFrozenCoins(ctx, holder).Empty())
+	token, err := keeper.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 850), token.TotalSupply)
+
+	history := keeper.GetTokenClawbacks(ctx, "zap123")
+	require.Len(t, history, 2)
+	require.Equal(t, owner, history[0].Authority)
+	require.Equal(t, "stolen funds", history[1].Reason)
+	require.Equal(t, uint64(3), keeper.GetNextClawbackID(ctx))
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestClawbackNotClawbackable(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, holder := types.KeyTestPubAddr()
+	require.Nil(t, keeper.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("zap123", 300)))
+
+	_, err := keeper.Clawback(ctx, owner, "zap123", holder, owner, 10, "court order")
+	require.Equal(t, types.CodeTokenNotClawbackable, err.Code())
+	require.Equal(t, types.CodeTokenNotClawbackable, keeper.SetClawbackAdmin(ctx, owner, "zap123", holder).Code())
+	require.Empty(t, keeper.GetTokenClawbacks(ctx, "zap123"))
+}
+
+func TestSetClawbackAdmin(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupClawbackableToken(t, ctx, keeper, "zap123", 1000)
+	_, _, admin := types.KeyTestPubAddr()
+
+	require.Equal(t, types.CodeInvalidOwner, keeper.SetClawbackAdmin(ctx, admin, "zap123", admin).Code())
+	require.Nil(t, keeper.SetClawbackAdmin(ctx, owner, "zap123", admin))
+	token, err := keeper.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	require.True(t, token.CanClawback(admin))
+
+	// an admin can't hand the role on, and clearing it leaves the owner alone
+	require.Equal(t, types.CodeInvalidOwner, keeper.SetClawbackAdmin(ctx, admin, "zap123", nil).Code())
+	require.Nil(t, keeper.SetClawbackAdmin(ctx, owner, "zap123", nil))
+	token, err = keeper.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	require.False(t, token.CanClawback(admin))
+	require.True(t, token.CanClawback(owner))
+}
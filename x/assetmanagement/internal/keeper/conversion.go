@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetConversionWindow - gets the conversion window of a source token
+func (k Keeper) GetConversionWindow(ctx sdk.Context, source string) (types.ConversionWindow, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ConversionKey(source))
+	if bz == nil {
+		return types.ConversionWindow{}, types.ErrConversionDoesNotExist(k.codespace, source)
+	}
+	var window types.ConversionWindow
+	k.cdc.MustUnmarshalBinaryBare(bz, &window)
+	return window, nil
+}
+
+// SetConversionWindow - stores a conversion window and, while it is open, queues it to close at its deadline
+func (k Keeper) SetConversionWindow(ctx sdk.Context, window types.ConversionWindow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConversionKey(window.Source), k.cdc.MustMarshalBinaryBare(window))
+	if window.Closed {
+		store.Delete(types.ConversionQueueKey(window.Deadline, window.Source))
+	} else {
+		store.Set(types.ConversionQueueKey(window.Deadline, window.Source), []byte(window.Source))
+	}
+}
+
+// IterateConversionWindows - iterates over the conversion windows, open and closed, in source symbol order until
+// the callback returns true
+func (k Keeper) IterateConversionWindows(ctx sdk.Context, cb func(window types.ConversionWindow) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ConversionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var window types.ConversionWindow
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &window)
+		if cb(window) {
+			break
+		}
+	}
+}
+
+// hasOpenConversion tells whether a token is the source or the target of an open conversion window
+func (k Keeper) hasOpenConversion(ctx sdk.Context, symbol string) bool {
+	found := false
+	k.IterateConversionWindows(ctx, func(window types.ConversionWindow) bool {
+		found = !window.Closed && (window.Source == symbol || window.Target == symbol)
+		return found
+	})
+	return found
+}
+
+// OpenConversion - opens a conversion window from a source token to a mintable target token on behalf of the owner
+// of both. A token can only be the source of a single window, it is disabled when the window closes, so a token with
+// claim campaigns or vesting grants can't be one
+func (k Keeper) OpenConversion(ctx sdk.Context, owner sdk.AccAddress, source, target string, numerator,
+	denominator uint64, deadline time.Time) sdk.Error {
+	sourceToken, err := k.GetToken(ctx, source)
+	if err != nil {
+		return err
+	}
+	targetToken, err := k.GetToken(ctx, target)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(sourceToken.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, source)
+	}
+	if !owner.Equals(targetToken.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, target)
+	}
+	window := types.NewConversionWindow(source, target, owner, numerator, denominator, deadline)
+	if err := window.Validate(); err != nil {
+		return err
+	}
+	if sourceToken.Disabled {
+		return types.ErrTokenDisabled(k.codespace, source)
+	}
+	if targetToken.Disabled {
+		return types.ErrTokenDisabled(k.codespace, target)
+	}
+	if targetToken.IsDeprecated() {
+		return types.ErrTokenDeprecated(k.codespace, target)
+	}
+	if !targetToken.Mintable {
+		return types.ErrTokenNotMintable(k.codespace, target)
+	}
+	if _, err := k.GetConversionWindow(ctx, source); err == nil {
+		return types.ErrInvalidConversion(k.codespace,
+			fmt.Sprintf("token '%s' already has a conversion window", source))
+	}
+	// the source is disabled once the window closes, its claims and vesting grants would then have nowhere to go
+	if campaigns := k.countClaimCampaigns(ctx, source); campaigns > 0 {
+		return types.ErrInvalidConversion(k.codespace,
+			fmt.Sprintf("token '%s' has %d claim campaigns, wait for them to end", source, campaigns))
+	}
+	if grants := k.GetTokenVestingGrants(ctx, source); len(grants) > 0 {
+		return types.ErrInvalidConversion(k.codespace,
+			fmt.Sprintf("token '%s' has %d vesting grants, wait for them to be released", source, len(grants)))
+	}
+	if !deadline.After(ctx.BlockTime()) {
+		return types.ErrInvalidConversion(k.codespace,
+			fmt.Sprintf("deadline %s is not after the current block time %s", deadline, ctx.BlockTime()))
+	}
+
+	k.SetConversionWindow(ctx, window)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOpenConversion,
+		sdk.NewAttribute(types.AttributeKeySymbol, source),
+		sdk.NewAttribute(types.AttributeKeyTarget, target),
+		sdk.NewAttribute(types.AttributeKeyRatio, fmt.Sprintf("%d/%d", numerator, denominator)),
+		sdk.NewAttribute(types.AttributeKeyDeadline, deadline.Format(time.RFC3339)),
+	))
+	return nil
+}
+
+// Convert - burns an amount of the source token of an open conversion window from the holder and mints the target
+// token in its place. Returns the coins of the target the holder received
+func (k Keeper) Convert(ctx sdk.Context, holder sdk.AccAddress, source string, amount int64) (sdk.Coins, sdk.Error) {
+	window, err := k.GetConversionWindow(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	if !window.IsOpen(ctx.BlockTime()) {
+		return nil, types.ErrInvalidConversion(k.codespace,
+			fmt.Sprintf("the conversion window of token '%s' closed at %s", source, window.Deadline))
+	}
+	sourceToken, err := k.GetToken(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	targetToken, err := k.GetToken(ctx, window.Target)
+	if err != nil {
+		return nil, err
+	}
+	if targetToken.Disabled {
+		return nil, types.ErrTokenDisabled(k.codespace, window.Target)
+	}
+	if targetToken.IsDeprecated() {
+		return nil, types.ErrTokenDeprecated(k.codespace, window.Target)
+	}
+	if sourceToken.Paused {
+		return nil, types.ErrTokenPaused(k.codespace, source)
+	}
+	if targetToken.Paused {
+		return nil, types.ErrTokenPaused(k.codespace, window.Target)
+	}
+
+	burnt := sdk.NewCoins(sdk.NewInt64Coin(source, amount))
+	received := window.TargetAmount(sdk.NewInt(amount))
+	if !received.IsPositive() {
+		return nil, types.ErrInvalidConversion(k.codespace,
+			fmt.Sprintf("converting %s gives less than 1%s", burnt, window.Target))
+	}
+	minted := sdk.NewCoins(sdk.NewCoin(window.Target, received))
+	if err := k.CheckHoldingLimits(ctx, nil, []bank.Output{bank.NewOutput(holder, minted)}); err != nil {
+		return nil, err
+	}
+
+	if err := k.BurnCoins(ctx, holder, burnt); err != nil {
+		return nil, err
+	}
+	if err := k.SetTotalSupply(ctx, source, sourceToken.TotalSupply.Sub(burnt)); err != nil {
+		return nil, err
+	}
+	k.AfterBurn(ctx, source, holder, burnt)
+	if err := k.MintCoins(ctx, holder, minted); err != nil {
+		return nil, err
+	}
+	if err := k.SetTotalSupply(ctx, window.Target, targetToken.TotalSupply.Add(minted)); err != nil {
+		return nil, err
+	}
+	k.AfterMint(ctx, window.Target, holder, minted)
+
+	window.Converted = window.Converted.Add(sdk.NewInt(amount))
+	window.Issued = window.Issued.Add(received)
+	window.Conversions++
+	k.SetConversionWindow(ctx, window)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConvert,
+		sdk.NewAttribute(types.AttributeKeySender, holder.String()),
+		sdk.NewAttribute(types.AttributeKeySymbol, source),
+		sdk.NewAttribute(types.AttributeKeyTarget, window.Target),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, burnt.String()),
+		sdk.NewAttribute(types.AttributeKeyReceived, minted.String()),
+	))
+	return minted, nil
+}
+
+// CloseConversions - closes every conversion window that has reached its deadline and disables its source token.
+// The windows are kept for their stats
+func (k Keeper) CloseConversions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ConversionQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.ConversionQueueTimeKey(ctx.BlockTime())))
+	var sources []string
+	for ; iterator.Valid(); iterator.Next() {
+		sources = append(sources, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, source := range sources {
+		window, err := k.GetConversionWindow(ctx, source)
+		if err != nil {
+			panic(fmt.Sprintf("queued conversion window of '%s' is missing", source))
+		}
+		window.Closed = true
+		k.SetConversionWindow(ctx, window)
+
+		token, err := k.GetToken(ctx, source)
+		if err != nil {
+			panic(fmt.Sprintf("conversion window of a missing token: %s", err))
+		}
+		token.Disabled = true
+		if err := k.SetToken(ctx, source, token); err != nil {
+			panic(fmt.Sprintf("failed to disable token '%s': %s", source, err))
+		}
+		k.deleteEmission(ctx, source)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCloseConversion,
+			sdk.NewAttribute(types.AttributeKeySymbol, source),
+			sdk.NewAttribute(types.AttributeKeyTarget, window.Target),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, window.Converted.String()),
+			sdk.NewAttribute(types.AttributeKeyReceived, window.Issued.String()),
+		))
+		ctx.Logger().Info(fmt.Sprintf("conversion window of %s closed after converting %s%s to %s%s, %s disabled",
+			source, window.Converted, source, window.Issued, window.Target, source))
+	}
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// setupConversion issues an old token and a new mintable token of the same owner
+func setupConversion(t *testing.T, ctx sdk.Context, keeper Keeper) sdk.AccAddress {
+	owner := setupToken(t, ctx, keeper, "old123", 1000)
+	token := types.NewToken("New", "new456", "NEW", 10, owner, true)
+	require.Nil(t, keeper.MintCoins(ctx, owner, token.TotalSupply))
+	require.Nil(t, keeper.SetToken(ctx, "new456", token))
+	return owner
+}
+
+func TestConvert(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupConversion(t, ctx, keeper)
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "old123", []types.Recipient{
+		types.NewRecipient(alice, 300),
+		types.NewRecipient(bob, 200),
+	}))
+
+	deadline := start.Add(time.Hour)
+	require.Nil(t, keeper.OpenConversion(ctx, owner, "old123", "new456", 1, 10, deadline))
+
+	received, err := keeper.Convert(ctx, alice, "old123", 255)
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("new456", 25), received)
+	require.Equal(t, sdk.NewInt(45), keeper.CoinKeeper.GetCoins(ctx, alice).AmountOf("old123"))
+	require.Equal(t, sdk.NewInt(25), keeper.CoinKeeper.GetCoins(ctx, alice).AmountOf("new456"))
+	_, err = keeper.Convert(ctx, bob, "old123", 200)
+	require.Nil(t, err)
+	_, err = keeper.Convert(ctx, bob, "old123", 10)
+	require.Equal(t, types.CodeInsufficientCoins, err.Code())
+	_, err = keeper.Convert(ctx, alice, "old123", 9)
+	require.Equal(t, types.CodeInvalidConversion, err.Code())
+
+	window, err := keeper.GetConversionWindow(ctx, "old123")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(455), window.Converted)
+	require.Equal(t, sdk.NewInt(45), window.Issued)
+	require.Equal(t, uint64(2), window.Conversions)
+	oldToken, err := keeper.GetToken(ctx, "old123")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(545), oldToken.TotalSupply.AmountOf("old123"))
+	newToken, err := keeper.GetToken(ctx, "new456")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(55), newToken.TotalSupply.AmountOf("new456"))
+	require.False(t, keeper.IsHolder(ctx, "old123", bob))
+	require.True(t, keeper.IsHolder(ctx, "new456", bob))
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// the window stays open until the block reaching the deadline
+	keeper.CloseConversions(ctx.WithBlockTime(deadline.Add(-time.Second)))
+	oldToken, _ = keeper.GetToken(ctx, "old123")
+	require.False(t, oldToken.Disabled)
+
+	ctx = ctx.WithBlockTime(deadline)
+	keeper.CloseConversions(ctx)
+	window, err = keeper.GetConversionWindow(ctx, "old123")
+	require.Nil(t, err)
+	require.True(t, window.Closed)
+	oldToken, _ = keeper.GetToken(ctx, "old123")
+	require.True(t, oldToken.Disabled)
+
+	_, err = keeper.Convert(ctx, alice, "old123", 45)
+	require.Equal(t, types.CodeInvalidConversion, err.Code())
+	err = keeper.CheckTransferMode(ctx, alice, bob, types.NewTestCoins("old123", 1))
+	require.Equal(t, types.CodeTokenDisabled, err.Code())
+	require.Nil(t, keeper.CheckTransferMode(ctx, alice, bob, types.NewTestCoins("new456", 1)))
+	err = keeper.ScheduleEmission(ctx, owner, types.NewEmissionSchedule("old123", owner, 10, 1, 0, 0,
+		sdk.ZeroDec(), 0))
+	require.Equal(t, types.CodeTokenDisabled, err.Code())
+	err = keeper.OpenConversion(ctx, owner, "new456", "old123", 1, 1, deadline.Add(time.Hour))
+	require.Equal(t, types.CodeTokenDisabled, err.Code())
+}
+
+func TestOpenConversionRejected(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupConversion(t, ctx, keeper)
+	other := setupToken(t, ctx, keeper, "zap123", 1000)
+	deadline := start.Add(time.Hour)
+
+	err := keeper.OpenConversion(ctx, other, "old123", "new456", 1, 1, deadline)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	err = keeper.OpenConversion(ctx, owner, "old123", "zap123", 1, 1, deadline)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	err = keeper.OpenConversion(ctx, owner, "old123", "abc123", 1, 1, deadline)
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+	err = keeper.OpenConversion(ctx, owner, "old123", "new456", 1, 1, start)
+	require.Equal(t, types.CodeInvalidConversion, err.Code())
+
+	require.Nil(t, keeper.OpenConversion(ctx, owner, "old123", "new456", 1, 1, deadline))
+	err = keeper.OpenConversion(ctx, owner, "old123", "new456", 2, 1, deadline)
+	require.Equal(t, types.CodeInvalidConversion, err.Code())
+	_, err = keeper.Redenominate(ctx, owner, "new456", 2, 1)
+	require.Equal(t, types.CodeInvalidRedenomination, err.Code())
+	_, err = keeper.Convert(ctx, owner, "new456", 1)
+	require.Equal(t, types.CodeConversionDoesNotExist, err.Code())
+
+	// the converted coins are minted
+	token := types.NewToken("Fixed", "fix789", "FIX", 10, owner, false)
+	require.Nil(t, keeper.MintCoins(ctx, owner, token.TotalSupply))
+	require.Nil(t, keeper.SetToken(ctx, "fix789", token))
+	err = keeper.OpenConversion(ctx, owner, "new456", "fix789", 1, 1, deadline)
+	require.Equal(t, types.CodeTokenNotMintable, err.Code())
+}
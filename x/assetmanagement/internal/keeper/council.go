@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetCouncil - gets the council owning a token
+func (k Keeper) GetCouncil(ctx sdk.Context, symbol string) (types.Council, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CouncilKey(symbol))
+	if bz == nil {
+		return types.Council{}, types.ErrCouncilDoesNotExist(k.codespace, symbol)
+	}
+	var council types.Council
+	k.cdc.MustUnmarshalBinaryBare(bz, &council)
+	return council, nil
+}
+
+// SetCouncil - stores the council of a token
+func (k Keeper) SetCouncil(ctx sdk.Context, council types.Council) {
+	ctx.KVStore(k.storeKey).Set(types.CouncilKey(council.Symbol), k.cdc.MustMarshalBinaryBare(council))
+}
+
+// IterateCouncils - iterates over all councils in symbol order until the callback returns true
+func (k Keeper) IterateCouncils(ctx sdk.Context, cb func(council types.Council) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CouncilKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var council types.Council
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &council)
+		if cb(council) {
+			break
+		}
+	}
+}
+
+// GetNextActionID - gets the ID the next proposed action will get
+func (k Keeper) GetNextActionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextActionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextActionID - sets the ID the next proposed action will get
+func (k Keeper) SetNextActionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextActionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetPendingAction - gets a pending action by ID
+func (k Keeper) GetPendingAction(ctx sdk.Context, id uint64) (types.PendingAction, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ActionKey(id))
+	if bz == nil {
+		return types.PendingAction{}, types.ErrActionDoesNotExist(k.codespace, id)
+	}
+	var action types.PendingAction
+	k.cdc.MustUnmarshalBinaryBare(bz, &action)
+	return action, nil
+}
+
+// SetPendingAction - stores a pending action and queues it to expire
+func (k Keeper) SetPendingAction(ctx sdk.Context, action types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActionKey(action.ID), k.cdc.MustMarshalBinaryBare(action))
+	store.Set(types.ActionQueueKey(action.Expiry, action.ID), sdk.Uint64ToBigEndian(action.ID))
+}
+
+// DeletePendingAction - removes a pending action along with its queue entry
+func (k Keeper) DeletePendingAction(ctx sdk.Context, action types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ActionKey(action.ID))
+	store.Delete(types.ActionQueueKey(action.Expiry, action.ID))
+}
+
+// IteratePendingActions - iterates over all pending actions in ID order until the callback returns true
+func (k Keeper) IteratePendingActions(ctx sdk.Context, cb func(action types.PendingAction) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ActionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.PendingAction
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &action)
+		if cb(action) {
+			break
+		}
+	}
+}
+
+// GetTokenPendingActions - gets the pending actions of a token
+func (k Keeper) GetTokenPendingActions(ctx sdk.Context, symbol string) []types.PendingAction {
+	var actions []types.PendingAction
+	k.IteratePendingActions(ctx, func(action types.PendingAction) bool {
+		if action.Symbol == symbol {
+			actions = append(actions, action)
+		}
+		return false
+	})
+	return actions
+}
+
+// deleteTokenPendingActions drops the pending actions of a token, their approvals were given to an earlier council
+func (k Keeper) deleteTokenPendingActions(ctx sdk.Context, symbol string) {
+	for _, action := range k.GetTokenPendingActions(ctx, symbol) {
+		k.DeletePendingAction(ctx, action)
+	}
+}
+
+// SetTokenCouncil - hands a token over to a council, or replaces its council, on behalf of its owner. The token is
+// then owned by the council address, so its privileged messages must be proposed to the council. The pending actions
+// of the previous council are dropped, and so are the timelocked actions the previous owner queued
+func (k Keeper) SetTokenCouncil(ctx sdk.Context, owner sdk.AccAddress, symbol string, members []sdk.AccAddress,
+	threshold uint64) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	council := types.NewCouncil(symbol, members, threshold)
+	if err := council.Validate(); err != nil {
+		return err
+	}
+
+	if !token.Owner.Equals(council.Address()) {
+		if err := k.SetOwner(ctx, symbol, council.Address()); err != nil {
+			return err
+		}
+		k.deleteTokenQueuedActions(ctx, symbol)
+	}
+	k.SetCouncil(ctx, council)
+	k.deleteTokenPendingActions(ctx, symbol)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetCouncil,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyOwner, council.Address().String()),
+		sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d/%d", threshold, len(members))),
+	))
+	return nil
+}
+
+// ProposeAction - proposes a privileged message of a council owned token on behalf of a council member, who
+// approves it right away. Returns the pending action, which may already have enough approvals to run
+func (k Keeper) ProposeAction(ctx sdk.Context, proposer sdk.AccAddress, symbol string, msg sdk.Msg,
+	expiry time.Time) (types.PendingAction, sdk.Error) {
+	council, err := k.GetCouncil(ctx, symbol)
+	if err != nil {
+		return types.PendingAction{}, err
+	}
+	if !council.IsMember(proposer) {
+		return types.PendingAction{}, types.ErrNotCouncilMember(k.codespace, proposer, symbol)
+	}
+	if err := types.ValidateAction(symbol, msg); err != nil {
+		return types.PendingAction{}, err
+	}
+	if !expiry.After(ctx.BlockTime()) || expiry.After(ctx.BlockTime().Add(types.MaxActionDuration)) {
+		return types.PendingAction{}, types.ErrInvalidAction(k.codespace,
+			fmt.Sprintf("expiry %s must be after the current block time and within %s of it", expiry,
+				types.MaxActionDuration))
+	}
+
+	id := k.GetNextActionID(ctx)
+	k.SetNextActionID(ctx, id+1)
+	action := types.NewPendingAction(id, symbol, proposer, msg, expiry)
+	k.SetPendingAction(ctx, action)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposeAction,
+		sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeySender, proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyAction, msg.Type()),
+		sdk.NewAttribute(types.AttributeKeyExpiry, expiry.Format(time.RFC3339)),
+	))
+	return action, nil
+}
+
+// ApproveAction - records the approval of a pending action by a council member. Returns the pending action, which
+// may now have enough approvals to run
+func (k Keeper) ApproveAction(ctx sdk.Context, member sdk.AccAddress, id uint64) (types.PendingAction, sdk.Error) {
+	action, err := k.GetPendingAction(ctx, id)
+	if err != nil {
+		return types.PendingAction{}, err
+	}
+	if action.IsExpired(ctx.BlockTime()) {
+		return types.PendingAction{}, types.ErrInvalidAction(k.codespace,
+			fmt.Sprintf("pending action %d expired at %s", id, action.Expiry))
+	}
+	council, err := k.GetCouncil(ctx, action.Symbol)
+	if err != nil {
+		return types.PendingAction{}, err
+	}
+	if !council.IsMember(member) {
+		return types.PendingAction{}, types.ErrNotCouncilMember(k.codespace, member, action.Symbol)
+	}
+	if action.HasApproved(member) {
+		return types.PendingAction{}, types.ErrInvalidAction(k.codespace,
+			fmt.Sprintf("%s already approved pending action %d", member, id))
+	}
+
+	action.Approvals = append(action.Approvals, member)
+	k.SetPendingAction(ctx, action)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeApproveAction,
+		sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeySender, member.String()),
+		sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d/%d", len(action.Approvals), council.Threshold)),
+	))
+	return action, nil
+}
+
+// RevokeApproval - takes back the approval of a pending action by a council member. An action nobody approves
+// anymore is dropped
+func (k Keeper) RevokeApproval(ctx sdk.Context, member sdk.AccAddress, id uint64) sdk.Error {
+	action, err := k.GetPendingAction(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !action.HasApproved(member) {
+		return types.ErrInvalidAction(k.codespace, fmt.Sprintf("%s didn't approve pending action %d", member, id))
+	}
+
+	approvals := make([]sdk.AccAddress, 0, len(action.Approvals)-1)
+	for _, approval := range action.Approvals {
+		if !approval.Equals(member) {
+			approvals = append(approvals, approval)
+		}
+	}
+	action.Approvals = approvals
+	if len(approvals) == 0 {
+		k.DeletePendingAction(ctx, action)
+	} else {
+		k.SetPendingAction(ctx, action)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokeApproval,
+		sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeySender, member.String()),
+		sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", len(approvals))),
+	))
+	return nil
+}
+
+// IsActionApproved - tells whether enough members of its council approved a pending action for it to run
+func (k Keeper) IsActionApproved(ctx sdk.Context, action types.PendingAction) bool {
+	council, err := k.GetCouncil(ctx, action.Symbol)
+	if err != nil {
+		return false
+	}
+	return uint64(len(action.Approvals)) >= council.Threshold
+}
+
+// ExpireActions - drops every pending action that has reached its expiry without enough approvals
+func (k Keeper) ExpireActions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ActionQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.ActionQueueTimeKey(ctx.BlockTime())))
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		action, err := k.GetPendingAction(ctx, id)
+		if err != nil {
+			panic(fmt.Sprintf("queued pending action %d is missing", id))
+		}
+		k.DeletePendingAction(ctx, action)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExpireAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeySymbol, action.Symbol),
+		))
+		ctx.Logger().Info(fmt.Sprintf("pending action %d of %s expired with %d approvals", id, action.Symbol,
+			len(action.Approvals)))
+	}
+}
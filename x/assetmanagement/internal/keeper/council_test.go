@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func setupCouncil(t *testing.T, ctx sdk.Context, keeper Keeper, symbol string, threshold uint64) []sdk.AccAddress {
+	owner := setupToken(t, ctx, keeper, symbol, 1000)
+	members := make([]sdk.AccAddress, 3)
+	for i := range members {
+		_, _, members[i] = types.KeyTestPubAddr()
+	}
+	require.Nil(t, keeper.SetTokenCouncil(ctx, owner, symbol, members, threshold))
+	return members
+}
+
+func TestSetTokenCouncil(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+
+	err := keeper.SetTokenCouncil(ctx, alice, "abc123", []sdk.AccAddress{alice, bob}, 2)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	err = keeper.SetTokenCouncil(ctx, owner, "abc123", []sdk.AccAddress{alice, bob}, 3)
+	require.Equal(t, types.CodeInvalidCouncil, err.Code())
+	err = keeper.SetTokenCouncil(ctx, owner, "abc123", []sdk.AccAddress{alice, alice}, 1)
+	require.Equal(t, types.CodeInvalidCouncil, err.Code())
+
+	require.Nil(t, keeper.SetTokenCouncil(ctx, owner, "abc123", []sdk.AccAddress{alice, bob}, 2))
+	token, _ := keeper.GetToken(ctx, "abc123")
+	require.Equal(t, types.CouncilAddress("abc123"), token.Owner)
+	council, err := keeper.GetCouncil(ctx, "abc123")
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), council.Threshold)
+
+	// the previous owner lost its privileges, the council address replaces the council
+	err = keeper.SetTokenCouncil(ctx, owner, "abc123", []sdk.AccAddress{alice}, 1)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	require.Nil(t, keeper.SetTokenCouncil(ctx, types.CouncilAddress("abc123"), "abc123", []sdk.AccAddress{alice}, 1))
+	council, _ = keeper.GetCouncil(ctx, "abc123")
+	require.Equal(t, []sdk.AccAddress{alice}, council.Members)
+	_, err = keeper.GetCouncil(ctx, "xyz123")
+	require.Equal(t, types.CodeCouncilDoesNotExist, err.Code())
+}
+
+func TestSetTokenCouncilHooks(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	var calls []string
+	keeper = *keeper.SetHooks(recordingHooks{&calls})
+	_, _, alice := types.KeyTestPubAddr()
+
+	// handing the token over changes its owner, replacing the council doesn't
+	require.Nil(t, keeper.SetTokenCouncil(ctx, owner, "abc123", []sdk.AccAddress{alice}, 1))
+	require.Equal(t, []string{"owner"}, calls)
+	require.Nil(t, keeper.SetTokenCouncil(ctx, types.CouncilAddress("abc123"), "abc123", []sdk.AccAddress{alice}, 1))
+	require.Equal(t, []string{"owner"}, calls)
+}
+
+func TestCouncilActions(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	members := setupCouncil(t, ctx, keeper, "abc123", 2)
+	_, _, stranger := types.KeyTestPubAddr()
+	council := types.CouncilAddress("abc123")
+	mint := types.NewMsgMintCoins(100, "abc123", council)
+	expiry := now.Add(time.Hour)
+
+	_, err := keeper.ProposeAction(ctx, stranger, "abc123", mint, expiry)
+	require.Equal(t, types.CodeNotCouncilMember, err.Code())
+	_, err = keeper.ProposeAction(ctx, members[0], "abc123", types.NewMsgMintCoins(100, "abc123", members[0]), expiry)
+	require.Equal(t, types.CodeInvalidAction, err.Code())
+	_, err = keeper.ProposeAction(ctx, members[0], "abc123", mint, now)
+	require.Equal(t, types.CodeInvalidAction, err.Code())
+	_, err = keeper.ProposeAction(ctx, members[0], "abc123", mint, now.Add(types.MaxActionDuration+time.Second))
+	require.Equal(t, types.CodeInvalidAction, err.Code())
+
+	action, err := keeper.ProposeAction(ctx, members[0], "abc123", mint, expiry)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), action.ID)
+	require.False(t, keeper.IsActionApproved(ctx, action))
+
+	_, err = keeper.ApproveAction(ctx, members[0], action.ID)
+	require.Equal(t, types.CodeInvalidAction, err.Code())
+	_, err = keeper.ApproveAction(ctx, stranger, action.ID)
+	require.Equal(t, types.CodeNotCouncilMember, err.Code())
+	_, err = keeper.ApproveAction(ctx, members[1], 7)
+	require.Equal(t, types.CodeActionDoesNotExist, err.Code())
+	action, err = keeper.ApproveAction(ctx, members[1], action.ID)
+	require.Nil(t, err)
+	require.True(t, keeper.IsActionApproved(ctx, action))
+	require.Len(t, keeper.GetTokenPendingActions(ctx, "abc123"), 1)
+
+	// revoking every approval drops the action
+	err = keeper.RevokeApproval(ctx, members[2], action.ID)
+	require.Equal(t, types.CodeInvalidAction, err.Code())
+	require.Nil(t, keeper.RevokeApproval(ctx, members[0], action.ID))
+	action, _ = keeper.GetPendingAction(ctx, action.ID)
+	require.Equal(t, []sdk.AccAddress{members[1]}, action.Approvals)
+	require.Nil(t, keeper.RevokeApproval(ctx, members[1], action.ID))
+	_, err = keeper.GetPendingAction(ctx, action.ID)
+	require.Equal(t, types.CodeActionDoesNotExist, err.Code())
+}
+
+func TestExpireActions(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	members := setupCouncil(t, ctx, keeper, "abc123", 3)
+	mint := types.NewMsgMintCoins(100, "abc123", types.CouncilAddress("abc123"))
+
+	early, err := keeper.ProposeAction(ctx, members[0], "abc123", mint, now.Add(time.Hour))
+	require.Nil(t, err)
+	late, err := keeper.ProposeAction(ctx, members[1], "abc123", mint, now.Add(2*time.Hour))
+	require.Nil(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	_, err = keeper.ApproveAction(ctx, members[1], early.ID)
+	require.Equal(t, types.CodeInvalidAction, err.Code())
+	keeper.ExpireActions(ctx)
+	_, err = keeper.GetPendingAction(ctx, early.ID)
+	require.Equal(t, types.CodeActionDoesNotExist, err.Code())
+	_, err = keeper.GetPendingAction(ctx, late.ID)
+	require.Nil(t, err)
+
+	// a new council drops the actions approved by the previous one
+	require.Nil(t, keeper.SetTokenCouncil(ctx, types.CouncilAddress("abc123"), "abc123", members[:2], 2))
+	require.Len(t, keeper.GetTokenPendingActions(ctx, "abc123"), 0)
+	require.Equal(t, uint64(3), keeper.GetNextActionID(ctx))
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetEmission - gets the emission schedule of a token
+func (k Keeper) GetEmission(ctx sdk.Context, symbol string) (types.EmissionSchedule, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.EmissionKey(symbol))
+	if bz == nil {
+		return types.EmissionSchedule{}, types.ErrEmissionDoesNotExist(k.codespace, symbol)
+	}
+	var emission types.EmissionSchedule
+	k.cdc.MustUnmarshalBinaryBare(bz, &emission)
+	return emission, nil
+}
+
+// SetEmission - stores the emission schedule of a token
+func (k Keeper) SetEmission(ctx sdk.Context, emission types.EmissionSchedule) {
+	ctx.KVStore(k.storeKey).Set(types.EmissionKey(emission.Symbol), k.cdc.MustMarshalBinaryBare(emission))
+}
+
+// deleteEmission - removes the emission schedule of a token
+func (k Keeper) deleteEmission(ctx sdk.Context, symbol string) {
+	ctx.KVStore(k.storeKey).Delete(types.EmissionKey(symbol))
+}
+
+// IterateEmissions - iterates over all emission schedules in symbol order until the callback returns true
+func (k Keeper) IterateEmissions(ctx sdk.Context, cb func(emission types.EmissionSchedule) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EmissionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var emission types.EmissionSchedule
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &emission)
+		if cb(emission) {
+			break
+		}
+	}
+}
+
+// ScheduleEmission - creates or replaces the emission schedule of a mintable token on behalf of its owner.
+// A replaced schedule starts over, what it already emitted is forgotten
+func (k Keeper) ScheduleEmission(ctx sdk.Context, owner sdk.AccAddress, emission types.EmissionSchedule) sdk.Error {
+	token, err := k.GetToken(ctx, emission.Symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, emission.Symbol)
+	}
+	if !token.Mintable {
+		return types.ErrTokenNotMintable(k.codespace, emission.Symbol)
+	}
+	if token.Disabled {
+		return types.ErrTokenDisabled(k.codespace, emission.Symbol)
+	}
+	if token.IsDeprecated() {
+		return types.ErrTokenDeprecated(k.codespace, emission.Symbol)
+	}
+	if err := emission.Validate(); err != nil {
+		return err
+	}
+	if emission.EndHeight != 0 && emission.EndHeight < ctx.BlockHeight() {
+		return types.ErrInvalidEmission(k.codespace,
+			fmt.Sprintf("end height %d has already passed", emission.EndHeight))
+	}
+	if emission.MaxSupply.IsPositive() && emission.MaxSupply.LTE(token.TotalSupply.AmountOf(emission.Symbol)) {
+		return types.ErrInvalidEmission(k.codespace,
+			fmt.Sprintf("max supply %s is already reached, the total supply is %s", emission.MaxSupply,
+				token.TotalSupply))
+	}
+	if k.CoinKeeper.BlacklistedAddr(emission.Recipient) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", emission.Recipient))
+	}
+
+	k.SetEmission(ctx, emission)
+	return nil
+}
+
+// SetEmissionPaused - pauses or resumes the emission schedule of a token on behalf of its owner
+func (k Keeper) SetEmissionPaused(ctx sdk.Context, owner sdk.AccAddress, symbol string, paused bool) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	emission, err := k.GetEmission(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	emission.Paused = paused
+	k.SetEmission(ctx, emission)
+	return nil
+}
+
+// ProcessEmissions - mints this block's coins of every running emission schedule. A schedule is removed once it
+// reaches its end height or max supply, or its rate has decayed to nothing. A mint that fails pauses its schedule
+// without changing anything else, so a single broken schedule can't halt the chain
+func (k Keeper) ProcessEmissions(ctx sdk.Context) {
+	var emissions []types.EmissionSchedule
+	k.IterateEmissions(ctx, func(emission types.EmissionSchedule) bool {
+		emissions = append(emissions, emission)
+		return false
+	})
+
+	height := ctx.BlockHeight()
+	for _, emission := range emissions {
+		if emission.Paused || height < emission.StartHeight {
+			continue
+		}
+		if emission.EndHeight != 0 && height > emission.EndHeight {
+			k.deleteEmission(ctx, emission.Symbol)
+			continue
+		}
+		token, err := k.GetToken(ctx, emission.Symbol)
+		if err != nil {
+			k.deleteEmission(ctx, emission.Symbol)
+			ctx.Logger().Error(fmt.Sprintf("emission schedule of a missing token removed: %s", err))
+			continue
+		}
+		// a paused token emits nothing until it is resumed, the schedule carries on from there
+		if token.Paused {
+			continue
+		}
+
+		amount := emission.RateAt(height)
+		supply := token.TotalSupply.AmountOf(emission.Symbol)
+		capped := emission.MaxSupply.IsPositive()
+		if capped && amount.GT(emission.MaxSupply.Sub(supply)) {
+			amount = emission.MaxSupply.Sub(supply)
+		}
+		if amount.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(emission.Symbol, amount))
+			// the block's emission is skipped while it would take the recipient over the token's holding limits
+			if err := k.CheckHoldingLimits(ctx, nil, []bank.Output{bank.NewOutput(emission.Recipient, coins)}); err != nil {
+				ctx.Logger().Info(fmt.Sprintf("emission of %s skipped: %s", coins, err))
+				continue
+			}
+			if err := k.emit(ctx, token, emission.Recipient, coins); err != nil {
+				emission.Paused = true
+				k.SetEmission(ctx, emission)
+				ctx.Logger().Error(fmt.Sprintf("emission of %s to %s failed, schedule paused: %s", coins,
+					emission.Recipient, err))
+				continue
+			}
+			emission.Emitted = emission.Emitted.Add(amount)
+			supply = supply.Add(amount)
+		}
+
+		finished := emission.EndHeight != 0 && height >= emission.EndHeight ||
+			capped && supply.GTE(emission.MaxSupply) || !emission.RateAt(height+1).IsPositive()
+		if finished {
+			k.deleteEmission(ctx, emission.Symbol)
+			ctx.Logger().Info(fmt.Sprintf("emission of %s finished after %s%s", emission.Symbol, emission.Emitted,
+				emission.Symbol))
+			continue
+		}
+		k.SetEmission(ctx, emission)
+	}
+}
+
+// emit mints the coins of a block's emission to the recipient against a cache, which is only written once every
+// step succeeded
+func (k Keeper) emit(ctx sdk.Context, token *types.Token, recipient sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := k.MintCoins(cacheCtx, recipient, coins); err != nil {
+		return err
+	}
+	if err := k.SetTotalSupply(cacheCtx, token.Symbol, token.TotalSupply.Add(coins)); err != nil {
+		return err
+	}
+	k.AfterMint(cacheCtx, token.Symbol, recipient, coins)
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
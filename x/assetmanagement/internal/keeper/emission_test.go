@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestEmission(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockHeight(5)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, recipient := types.KeyTestPubAddr()
+
+	emission := types.NewEmissionSchedule("zap123", recipient, 100, 10, 12, 0, sdk.ZeroDec(), 0)
+	require.Equal(t, types.CodeInvalidOwner, keeper.ScheduleEmission(ctx, recipient, emission).Code())
+	require.Nil(t, keeper.ScheduleEmission(ctx, owner, emission))
+
+	// nothing is minted before the start height
+	keeper.ProcessEmissions(ctx.WithBlockHeight(9))
+	require.True(t, keeper.CoinKeeper.GetCoins(ctx, recipient).Empty())
+
+	keeper.ProcessEmissions(ctx.WithBlockHeight(10))
+	require.Nil(t, keeper.SetEmissionPaused(ctx, owner, "zap123", true))
+	keeper.ProcessEmissions(ctx.WithBlockHeight(11))
+	require.Nil(t, keeper.SetEmissionPaused(ctx, owner, "zap123", false))
+	keeper.ProcessEmissions(ctx.WithBlockHeight(12))
+
+	require.Equal(t, sdk.NewInt(200), keeper.CoinKeeper.GetCoins(ctx, recipient).AmountOf("zap123"))
+	supply, err := keeper.GetTotalSupply(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 1200), supply)
+	// the schedule is removed after its end height
+	_, err = keeper.GetEmission(ctx, "zap123")
+	require.Equal(t, types.CodeEmissionDoesNotExist, err.Code())
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestEmissionMaxSupply(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockHeight(1)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, recipient := types.KeyTestPubAddr()
+
+	emission := types.NewEmissionSchedule("zap123", recipient, 100, 1, 0, 0, sdk.ZeroDec(), 1000)
+	require.Equal(t, types.CodeInvalidEmission, keeper.ScheduleEmission(ctx, owner, emission).Code())
+
+	emission.MaxSupply = sdk.NewInt(1250)
+	require.Nil(t, keeper.ScheduleEmission(ctx, owner, emission))
+	for height := int64(1); height <= 3; height++ {
+		keeper.ProcessEmissions(ctx.WithBlockHeight(height))
+	}
+
+	// the last block only mints up to the max supply and finishes the schedule
+	require.Equal(t, sdk.NewInt(250), keeper.CoinKeeper.GetCoins(ctx, recipient).AmountOf("zap123"))
+	_, err := keeper.GetEmission(ctx, "zap123")
+	require.Equal(t, types.CodeEmissionDoesNotExist, err.Code())
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestEmissionRequiresMintable(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	_, _, owner := types.KeyTestPubAddr()
+	token := types.NewToken("Zap", "zap123", "ZAP", 1000, owner, false)
+	require.Nil(t, keeper.SetToken(ctx, "zap123", token))
+
+	emission := types.NewEmissionSchedule("zap123", owner, 100, 1, 10, 0, sdk.ZeroDec(), 0)
+	require.Equal(t, types.CodeTokenNotMintable, keeper.ScheduleEmission(ctx, owner, emission).Code())
+	require.Equal(t, types.CodeEmissionDoesNotExist, keeper.SetEmissionPaused(ctx, owner, "zap123", true).Code())
+}
+
+func TestEmissionFailure(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockHeight(5)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, recipient := types.KeyTestPubAddr()
+	require.Nil(t, keeper.ScheduleEmission(ctx, owner,
+		types.NewEmissionSchedule("zap123", recipient, 100, 5, 10, 0, sdk.ZeroDec(), 0)))
+
+	// a token that can't be stored anymore fails the mint, which pauses the schedule and changes nothing else
+	token, err := keeper.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	token.Owner = nil
+	ctx.KVStore(keeper.storeKey).Set(types.TokenKey("zap123"), keeper.cdc.MustMarshalBinaryBare(*token))
+	keeper.ProcessEmissions(ctx)
+	require.True(t, keeper.CoinKeeper.GetCoins(ctx, recipient).Empty())
+	require.Equal(t, types.NewTestCoins("zap123", 1000), keeper.SupplyKeeper.GetSupply(ctx).GetTotal())
+	emission, err := keeper.GetEmission(ctx, "zap123")
+	require.Nil(t, err)
+	require.True(t, emission.Paused)
+
+	// the schedule of a token that is gone is removed
+	ctx.KVStore(keeper.storeKey).Delete(types.TokenKey("zap123"))
+	emission.Paused = false
+	keeper.SetEmission(ctx, emission)
+	keeper.ProcessEmissions(ctx.WithBlockHeight(6))
+	_, err = keeper.GetEmission(ctx, "zap123")
+	require.Equal(t, types.CodeEmissionDoesNotExist, err.Code())
+}
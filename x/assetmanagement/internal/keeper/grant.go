@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetGrant - gets the grant of a grantee to sign the messages of a type over a token for a granter
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, symbol, msgType string) (types.Grant,
+	sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GrantKey(granter, grantee, symbol, msgType))
+	if bz == nil {
+		return types.Grant{}, types.ErrGrantDoesNotExist(k.codespace, granter, grantee, symbol, msgType)
+	}
+	var grant types.Grant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, nil
+}
+
+// SetGrant - stores a grant and indexes it by grantee
+func (k Keeper) SetGrant(ctx sdk.Context, grant types.Grant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GrantKey(grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType),
+		k.cdc.MustMarshalBinaryBare(grant))
+	store.Set(types.GranteeGrantKey(grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType), []byte{0x01})
+}
+
+// deleteGrant - removes a grant along with its index entry
+func (k Keeper) deleteGrant(ctx sdk.Context, grant types.Grant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GrantKey(grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType))
+	store.Delete(types.GranteeGrantKey(grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType))
+}
+
+// IterateGrants - iterates over all grants, grouped by granter, until the callback returns true
+func (k Keeper) IterateGrants(ctx sdk.Context, cb func(grant types.Grant) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GrantKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// GetGranterGrants - gets the grants a granter issued, expired ones included
+func (k Keeper) GetGranterGrants(ctx sdk.Context, granter sdk.AccAddress) []types.Grant {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GranterGrantsPrefix(granter))
+	defer iterator.Close()
+	grants := make([]types.Grant, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// GetGranteeGrants - gets the grants issued to a grantee, expired ones included
+func (k Keeper) GetGranteeGrants(ctx sdk.Context, grantee sdk.AccAddress) []types.Grant {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GranteeGrantsPrefix(grantee)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	grants := make([]types.Grant, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(iterator.Key()[len(prefix):])
+		if bz == nil {
+			panic(fmt.Sprintf("indexed grant is missing: %X", iterator.Key()))
+		}
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// deleteTokenGrants drops the grants over a token, eg once it is retired
+func (k Keeper) deleteTokenGrants(ctx sdk.Context, symbol string) {
+	var grants []types.Grant
+	k.IterateGrants(ctx, func(grant types.Grant) bool {
+		if grant.Symbol == symbol {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+	for _, grant := range grants {
+		k.deleteGrant(ctx, grant)
+	}
+}
+
+// Grant - lets the grantee sign the messages of a type over a token on behalf of the granter until the expiry, up to
+// the spend limit. It replaces any earlier grant of the grantee for that type and token
+func (k Keeper) Grant(ctx sdk.Context, granter, grantee sdk.AccAddress, symbol, msgType string, spendLimit int64,
+	expiry time.Time) sdk.Error {
+	if !k.IsSymbolPresent(ctx, symbol) {
+		return types.ErrTokenSymbolDoesNotExist(k.codespace, symbol)
+	}
+
+	grant := types.NewGrant(granter, grantee, symbol, msgType, spendLimit, expiry)
+	if err := grant.Validate(); err != nil {
+		return err
+	}
+	if grant.IsExpired(ctx.BlockTime()) {
+		return types.ErrInvalidGrant(k.codespace, fmt.Sprintf("expiry %s has already passed", expiry))
+	}
+	k.SetGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGrant,
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		sdk.NewAttribute(types.AttributeKeySpendLimit, grant.SpendLimit.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiry, expiry.Format(time.RFC3339)),
+	))
+	return nil
+}
+
+// RevokeGrant - takes back a grant on behalf of its granter
+func (k Keeper) RevokeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, symbol, msgType string) sdk.Error {
+	grant, err := k.GetGrant(ctx, granter, grantee, symbol, msgType)
+	if err != nil {
+		return err
+	}
+	k.deleteGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokeGrant,
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+	))
+	return nil
+}
+
+// UseGrant - authorizes a message the grantee runs on behalf of its signer and takes what it spends off the spend
+// limit of the grant, which is used up once nothing is left. Returns the granter the message runs for
+func (k Keeper) UseGrant(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) (sdk.AccAddress, sdk.Error) {
+	symbol, granter, spend, ok := types.GrantedMsg(msg)
+	if !ok {
+		return nil, types.ErrInvalidGrant(k.codespace,
+			fmt.Sprintf("a %s message of %s cannot be run through a grant", msg.Type(), msg.Route()))
+	}
+	grant, err := k.GetGrant(ctx, granter, grantee, symbol, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	if grant.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrGrantExpired(k.codespace, granter, grantee, symbol, msg.Type())
+	}
+	if !grant.IsLimited() {
+		return granter, nil
+	}
+
+	if grant.SpendLimit.LT(spend) {
+		return nil, types.ErrInsufficientGrant(k.codespace, fmt.Sprintf("%s may only spend %s%s for %s, not %s%s",
+			grantee, grant.SpendLimit, symbol, granter, spend, symbol))
+	}
+	grant.SpendLimit = grant.SpendLimit.Sub(spend)
+	if grant.SpendLimit.IsZero() {
+		k.deleteGrant(ctx, grant)
+	} else {
+		k.SetGrant(ctx, grant)
+	}
+	return granter, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestGrant(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	_, _, grantee := types.KeyTestPubAddr()
+	mint := types.MsgMintCoins{}.Type()
+
+	err := keeper.Grant(ctx, owner, grantee, "xyz123", mint, 0, time.Time{})
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+	err = keeper.Grant(ctx, owner, owner, "abc123", mint, 0, time.Time{})
+	require.Equal(t, types.CodeInvalidGrant, err.Code())
+	err = keeper.Grant(ctx, owner, grantee, "abc123", types.MsgSetHoldingLimits{}.Type(), 0, time.Time{})
+	require.Equal(t, types.CodeInvalidGrant, err.Code())
+	err = keeper.Grant(ctx, owner, grantee, "abc123", types.MsgSetEmissionPaused{}.Type(), 10, time.Time{})
+	require.Equal(t, types.CodeInvalidGrant, err.Code())
+	err = keeper.Grant(ctx, owner, grantee, "abc123", mint, 0, now)
+	require.Equal(t, types.CodeInvalidGrant, err.Code())
+
+	require.Nil(t, keeper.Grant(ctx, owner, grantee, "abc123", mint, 100, now.Add(time.Hour)))
+	require.Nil(t, keeper.Grant(ctx, owner, grantee, "abc123", types.MsgBurnCoins{}.Type(), 0, time.Time{}))
+	grant, err := keeper.GetGrant(ctx, owner, grantee, "abc123", mint)
+	require.Nil(t, err)
+	require.Equal(t, int64(100), grant.SpendLimit.Int64())
+	require.Len(t, keeper.GetGranterGrants(ctx, owner), 2)
+	require.Len(t, keeper.GetGranteeGrants(ctx, grantee), 2)
+	require.Empty(t, keeper.GetGranteeGrants(ctx, owner))
+
+	err = keeper.RevokeGrant(ctx, grantee, owner, "abc123", mint)
+	require.Equal(t, types.CodeGrantDoesNotExist, err.Code())
+	require.Nil(t, keeper.RevokeGrant(ctx, owner, grantee, "abc123", types.MsgBurnCoins{}.Type()))
+	require.Len(t, keeper.GetGranterGrants(ctx, owner), 1)
+	require.Len(t, keeper.GetGranteeGrants(ctx, grantee), 1)
+}
+
+func TestUseGrant(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	_, _, grantee := types.KeyTestPubAddr()
+	_, _, stranger := types.KeyTestPubAddr()
+	mint := types.MsgMintCoins{}.Type()
+	require.Nil(t, keeper.Grant(ctx, owner, grantee, "abc123", mint, 100, now.Add(time.Hour)))
+	require.Nil(t, keeper.Grant(ctx, owner, grantee, "abc123", types.MsgFreezeCoins{}.Type(), 0, time.Time{}))
+
+	_, err := keeper.UseGrant(ctx, stranger, types.NewMsgMintCoins(10, "abc123", owner))
+	require.Equal(t, types.CodeGrantDoesNotExist, err.Code())
+	_, err = keeper.UseGrant(ctx, grantee, types.NewMsgSetHoldingLimits(owner, "abc123", 10, 0))
+	require.Equal(t, types.CodeInvalidGrant, err.Code())
+	_, err = keeper.UseGrant(ctx, grantee, types.NewMsgMintCoins(101, "abc123", owner))
+	require.Equal(t, types.CodeInsufficientGrant, err.Code())
+
+	// spending takes off the limit until it is used up
+	granter, err := keeper.UseGrant(ctx, grantee, types.NewMsgMintCoins(60, "abc123", owner))
+	require.Nil(t, err)
+	require.Equal(t, owner, granter)
+	grant, _ := keeper.GetGrant(ctx, owner, grantee, "abc123", mint)
+	require.Equal(t, int64(40), grant.SpendLimit.Int64())
+	_, err = keeper.UseGrant(ctx.WithBlockTime(now.Add(time.Hour)), grantee, types.NewMsgMintCoins(10, "abc123", owner))
+	require.Equal(t, types.CodeGrantExpired, err.Code())
+	_, err = keeper.UseGrant(ctx, grantee, types.NewMsgMintCoins(40, "abc123", owner))
+	require.Nil(t, err)
+	_, err = keeper.GetGrant(ctx, owner, grantee, "abc123", mint)
+	require.Equal(t, types.CodeGrantDoesNotExist, err.Code())
+
+	// an unlimited grant is never used up
+	for i := 0; i < 3; i++ {
+		_, err = keeper.UseGrant(ctx, grantee, types.NewMsgFreezeCoins(1000, "abc123", owner))
+		require.Nil(t, err)
+	}
+	require.Len(t, keeper.GetGranteeGrants(ctx, grantee), 1)
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// holderIndex keeps track of the accounts holding each token, that is with a positive free plus frozen balance.
+// Module accounts never count as holders. It only needs the stores, so that the bank keeper wrapper can share it
+type holderIndex struct {
+	accountKeeper auth.AccountKeeper
+	storeKey      sdk.StoreKey
+}
+
+func (k Keeper) holders() holderIndex {
+	return holderIndex{accountKeeper: k.AccountKeeper, storeKey: k.storeKey}
+}
+
+// balance returns the free plus frozen balance of a token of an account, and whether it is a module account
+func (h holderIndex) balance(ctx sdk.Context, symbol string, address sdk.AccAddress) (sdk.Int, bool) {
+	account := h.accountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		return sdk.ZeroInt(), false
+	}
+	if _, ok := account.(exported.ModuleAccountI); ok {
+		return account.GetCoins().AmountOf(symbol), true
+	}
+	return account.GetCoins().AmountOf(symbol).Add(frozenCoins(account).AmountOf(symbol)), false
+}
+
+func (h holderIndex) count(ctx sdk.Context, symbol string) uint64 {
+	bz := ctx.KVStore(h.storeKey).Get(types.HolderCountKey(symbol))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (h holderIndex) isHolder(ctx sdk.Context, symbol string, address sdk.AccAddress) bool {
+	return ctx.KVStore(h.storeKey).Has(types.HolderKey(symbol, address))
+}
+
+// update brings the index in line with the balances of an account of the tokens among the coins
+func (h holderIndex) update(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
+	store := ctx.KVStore(h.storeKey)
+	for _, coin := range coins {
+		if !store.Has(types.TokenKey(coin.Denom)) {
+			continue
+		}
+		balance, module := h.balance(ctx, coin.Denom, address)
+		holds := balance.IsPositive() && !module
+		if holds == h.isHolder(ctx, coin.Denom, address) {
+			continue
+		}
+
+		count := h.count(ctx, coin.Denom)
+		if holds {
+			store.Set(types.HolderKey(coin.Denom, address), []byte{0x01})
+			count++
+		} else {
+			store.Delete(types.HolderKey(coin.Denom, address))
+			count--
+		}
+		store.Set(types.HolderCountKey(coin.Denom), sdk.Uint64ToBigEndian(count))
+	}
+}
+
+// GetHolderCount - gets the number of accounts holding a token
+func (k Keeper) GetHolderCount(ctx sdk.Context, symbol string) uint64 {
+	return k.holders().count(ctx, symbol)
+}
+
+// IsHolder - tells whether an account holds a token
+func (k Keeper) IsHolder(ctx sdk.Context, symbol string, address sdk.AccAddress) bool {
+	return k.holders().isHolder(ctx, symbol, address)
+}
+
+// GetHolding - gets the free plus frozen balance of a token of an account
+func (k Keeper) GetHolding(ctx sdk.Context, symbol string, address sdk.AccAddress) sdk.Int {
+	balance, _ := k.holders().balance(ctx, symbol, address)
+	return balance
+}
+
+// IterateHolders - iterates over the accounts holding a token in address order until the callback returns true
+func (k Keeper) IterateHolders(ctx sdk.Context, symbol string, cb func(address sdk.AccAddress) bool) {
+	prefix := types.TokenHoldersPrefix(symbol)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key()[len(prefix):])) {
+			break
+		}
+	}
+}
+
+// UpdateHolders - brings the holders of the tokens among the coins in line with the balances of the accounts
+func (k Keeper) UpdateHolders(ctx sdk.Context, coins sdk.Coins, addresses ...sdk.AccAddress) {
+	for _, address := range addresses {
+		k.holders().update(ctx, address, coins)
+	}
+}
+
+// RebuildHolders - indexes the holders of every token from the balances of all accounts, eg after a genesis import
+func (k Keeper) RebuildHolders(ctx sdk.Context) {
+	k.AccountKeeper.IterateAccounts(ctx, func(account auth.Account) bool {
+		k.holders().update(ctx, account.GetAddress(), account.GetCoins().Add(frozenCoins(account)))
+		return false
+	})
+}
+
+func (k Keeper) deleteHolders(ctx sdk.Context, symbol string) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenHoldersPrefix(symbol))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.HolderCountKey(symbol))
+}
+
+// SetHoldingLimits - changes how much any account but the owner may hold of a token and how many accounts may hold
+// it, on behalf of the token's owner. Lower limits than the current holdings are accepted, they only stop the
+// accounts over them from receiving more
+func (k Keeper) SetHoldingLimits(ctx sdk.Context, owner sdk.AccAddress, symbol string, maxBalancePerAccount int64,
+	maxHolders uint64) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	if err := types.ValidateHoldingLimits(maxBalancePerAccount, maxHolders); err != nil {
+		return err
+	}
+	token.MaxBalancePerAccount = maxBalancePerAccount
+	token.MaxHolders = maxHolders
+	if err := k.SetToken(ctx, symbol, token); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHoldingLimits,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyMaxBalance, fmt.Sprintf("%d", maxBalancePerAccount)),
+		sdk.NewAttribute(types.AttributeKeyMaxHolders, fmt.Sprintf("%d", maxHolders)),
+	))
+	return nil
+}
+
+// holdingChange is the change of balance of a token of an account a movement of coins would make
+type holdingChange struct {
+	address sdk.AccAddress
+	delta   sdk.Int
+}
+
+// CheckHoldingLimits - checks that moving coins from the inputs to the outputs keeps every token within its
+// holding limits, it must run before the coins move. Only accounts receiving coins are held to the balance limit,
+// the owner and module accounts are never limited
+func (k Keeper) CheckHoldingLimits(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error {
+	// gather the net change of every account per token, in the order they appear
+	var symbols []string
+	changes := make(map[string][]holdingChange)
+	add := func(symbol string, address sdk.AccAddress, delta sdk.Int) {
+		if _, ok := changes[symbol]; !ok {
+			symbols = append(symbols, symbol)
+		}
+		for i, change := range changes[symbol] {
+			if change.address.Equals(address) {
+				changes[symbol][i].delta = change.delta.Add(delta)
+				return
+			}
+		}
+		changes[symbol] = append(changes[symbol], holdingChange{address: address, delta: delta})
+	}
+	for _, input := range inputs {
+		for _, coin := range input.Coins {
+			add(coin.Denom, input.Address, coin.Amount.Neg())
+		}
+	}
+	for _, output := range outputs {
+		for _, coin := range output.Coins {
+			add(coin.Denom, output.Address, coin.Amount)
+		}
+	}
+
+	for _, symbol := range symbols {
+		token, err := k.GetToken(ctx, symbol)
+		if err != nil || !token.HasHoldingLimits() {
+			continue
+		}
+		count := int64(k.GetHolderCount(ctx, symbol))
+		holders := count
+		entering := false
+		for _, change := range changes[symbol] {
+			before, module := k.holders().balance(ctx, symbol, change.address)
+			if module {
+				continue
+			}
+			after := before.Add(change.delta)
+			switch {
+			case !before.IsPositive() && after.IsPositive():
+				holders++
+				entering = entering || !change.address.Equals(token.Owner)
+			case before.IsPositive() && !after.IsPositive():
+				holders--
+			}
+			if change.address.Equals(token.Owner) || !change.delta.IsPositive() {
+				continue
+			}
+			if token.MaxBalancePerAccount > 0 && after.GT(sdk.NewInt(token.MaxBalancePerAccount)) {
+				return types.ErrMaxBalanceExceeded(k.codespace, symbol, change.address, after,
+					token.MaxBalancePerAccount)
+			}
+		}
+		if token.MaxHolders > 0 && entering && holders > count && uint64(holders) > token.MaxHolders {
+			return types.ErrMaxHoldersReached(k.codespace, symbol, token.MaxHolders)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestHolderCount(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	require.Equal(t, uint64(1), keeper.GetHolderCount(ctx, "zap123"))
+	require.True(t, keeper.IsHolder(ctx, "zap123", owner))
+
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{
+		types.NewRecipient(alice, 100),
+		types.NewRecipient(bob, 100),
+	}))
+	require.Equal(t, uint64(3), keeper.GetHolderCount(ctx, "zap123"))
+
+	// frozen coins are still held
+	require.Nil(t, keeper.FreezeCoins(ctx, alice, types.NewTestCoins("zap123", 100)))
+	require.True(t, keeper.IsHolder(ctx, "zap123", alice))
+	require.Equal(t, sdk.NewInt(100), keeper.GetHolding(ctx, "zap123", alice))
+
+	require.Nil(t, keeper.CoinKeeper.SendCoins(ctx, bob, owner, types.NewTestCoins("zap123", 100)))
+	require.False(t, keeper.IsHolder(ctx, "zap123", bob))
+	require.Equal(t, uint64(2), keeper.GetHolderCount(ctx, "zap123"))
+
+	require.Nil(t, keeper.BurnCoins(ctx, owner, types.NewTestCoins("zap123", 900)))
+	require.False(t, keeper.IsHolder(ctx, "zap123", owner))
+
+	var holders []sdk.AccAddress
+	keeper.IterateHolders(ctx, "zap123", func(address sdk.AccAddress) bool {
+		holders = append(holders, address)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{alice}, holders)
+
+	_, broken := TokenHoldersInvariant(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestTokenHoldersInvariantBroken(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, alice := types.KeyTestPubAddr()
+
+	// a balance that never went through the bank keeper
+	account := keeper.AccountKeeper.NewAccountWithAddress(ctx, alice)
+	require.Nil(t, account.SetCoins(types.NewTestCoins("zap123", 10)))
+	keeper.AccountKeeper.SetAccount(ctx, account)
+	_, broken := TokenHoldersInvariant(keeper)(ctx)
+	require.True(t, broken)
+
+	keeper.RebuildHolders(ctx)
+	_, broken = TokenHoldersInvariant(keeper)(ctx)
+	require.False(t, broken)
+	require.Equal(t, uint64(2), keeper.GetHolderCount(ctx, "zap123"))
+}
+
+func TestSetHoldingLimits(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, alice := types.KeyTestPubAddr()
+
+	err := keeper.SetHoldingLimits(ctx, alice, "zap123", 100, 2)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	err = keeper.SetHoldingLimits(ctx, owner, "zap123", -1, 2)
+	require.Equal(t, types.CodeInvalidHoldingLimits, err.Code())
+	err = keeper.SetHoldingLimits(ctx, owner, "abc123", 100, 2)
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+
+	require.Nil(t, keeper.SetHoldingLimits(ctx, owner, "zap123", 100, 2))
+	token, err := keeper.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, int64(100), token.MaxBalancePerAccount)
+	require.Equal(t, uint64(2), token.MaxHolders)
+}
+
+func TestCheckHoldingLimits(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	_, _, carol := types.KeyTestPubAddr()
+	require.Nil(t, keeper.SetHoldingLimits(ctx, owner, "zap123", 100, 3))
+
+	err := keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{types.NewRecipient(alice, 101)})
+	require.Equal(t, types.CodeMaxBalanceExceeded, err.Code())
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{
+		types.NewRecipient(alice, 100),
+		types.NewRecipient(bob, 50),
+	}))
+
+	// the third holder besides the owner is one too many
+	err = keeper.DistributeCoins(ctx, bob, "zap123", []types.Recipient{types.NewRecipient(carol, 10)})
+	require.Equal(t, types.CodeMaxHoldersReached, err.Code())
+	// unless the sender stops holding the token
+	require.Nil(t, keeper.DistributeCoins(ctx, bob, "zap123", []types.Recipient{types.NewRecipient(carol, 50)}))
+	require.Equal(t, uint64(3), keeper.GetHolderCount(ctx, "zap123"))
+
+	// the owner is never limited
+	require.Nil(t, keeper.DistributeCoins(ctx, alice, "zap123", []types.Recipient{types.NewRecipient(owner, 100)}))
+	require.Nil(t, keeper.DistributeCoins(ctx, carol, "zap123", []types.Recipient{types.NewRecipient(owner, 50)}))
+	require.Equal(t, uint64(1), keeper.GetHolderCount(ctx, "zap123"))
+
+	// coins without limits pass
+	require.Nil(t, keeper.CheckHoldingLimits(ctx,
+		[]bank.Input{bank.NewInput(owner, types.NewTestCoins("stake", 1000))},
+		[]bank.Output{bank.NewOutput(alice, types.NewTestCoins("stake", 1000))}))
+}
+
+func TestCheckHoldingLimitsTransferFrom(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{
+		types.NewRecipient(alice, 200),
+		types.NewRecipient(bob, 50),
+	}))
+
+	// lowering the limit below the current holdings only stops more coming in
+	require.Nil(t, keeper.SetHoldingLimits(ctx, owner, "zap123", 100, 0))
+	require.Nil(t, keeper.Approve(ctx, alice, bob, "zap123", 200, time.Time{}))
+	_, err := keeper.TransferFrom(ctx, bob, alice, bob, "zap123", 60)
+	require.Equal(t, types.CodeMaxBalanceExceeded, err.Code())
+	_, err = keeper.TransferFrom(ctx, bob, alice, bob, "zap123", 50)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(150), keeper.GetHolding(ctx, "zap123", alice))
+}
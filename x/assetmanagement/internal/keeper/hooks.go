@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// Implements AssetHooks interface
+var _ types.AssetHooks = Keeper{}
+
+// AfterTokenIssued - call hook if registered
+func (k Keeper) AfterTokenIssued(ctx sdk.Context, token types.Token) {
+	if k.hooks != nil {
+		k.hooks.AfterTokenIssued(ctx, token)
+	}
+}
+
+// AfterMint - call hook if registered
+func (k Keeper) AfterMint(ctx sdk.Context, symbol string, recipient sdk.AccAddress, coins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterMint(ctx, symbol, recipient, coins)
+	}
+}
+
+// AfterBurn - call hook if registered
+func (k Keeper) AfterBurn(ctx sdk.Context, symbol string, holder sdk.AccAddress, coins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterBurn(ctx, symbol, holder, coins)
+	}
+}
+
+// AfterFreeze - call hook if registered
+func (k Keeper) AfterFreeze(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterFreeze(ctx, address, coins)
+	}
+}
+
+// AfterUnfreeze - call hook if registered
+func (k Keeper) AfterUnfreeze(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterUnfreeze(ctx, address, coins)
+	}
+}
+
+// AfterOwnerChanged - call hook if registered
+func (k Keeper) AfterOwnerChanged(ctx sdk.Context, symbol string, previousOwner, newOwner sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterOwnerChanged(ctx, symbol, previousOwner, newOwner)
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// recordingHooks keeps the name of every hook called, in order
+type recordingHooks struct {
+	calls *[]string
+}
+
+func (h recordingHooks) AfterTokenIssued(_ sdk.Context, _ types.Token) {
+	*h.calls = append(*h.calls, "issued")
+}
+
+func (h recordingHooks) AfterMint(_ sdk.Context, _ string, _ sdk.AccAddress, _ sdk.Coins) {
+	*h.calls = append(*h.calls, "mint")
+}
+
+func (h recordingHooks) AfterBurn(_ sdk.Context, _ string, _ sdk.AccAddress, _ sdk.Coins) {
+	*h.calls = append(*h.calls, "burn")
+}
+
+func (h recordingHooks) AfterFreeze(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	*h.calls = append(*h.calls, "freeze")
+}
+
+func (h recordingHooks) AfterUnfreeze(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	*h.calls = append(*h.calls, "unfreeze")
+}
+
+func (h recordingHooks) AfterOwnerChanged(_ sdk.Context, _ string, _, _ sdk.AccAddress) {
+	*h.calls = append(*h.calls, "owner")
+}
+
+func TestHooks(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	var first, second []string
+	keeper = *keeper.SetHooks(types.NewMultiAssetHooks(recordingHooks{&first}, recordingHooks{&second}))
+	require.Panics(t, func() { keeper.SetHooks(recordingHooks{&first}) })
+
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	require.Nil(t, keeper.FreezeCoins(ctx, owner, types.NewTestCoins("zap123", 100)))
+	require.Nil(t, keeper.UnfreezeCoins(ctx, owner, types.NewTestCoins("zap123", 50)))
+
+	// failed freezes don't call the hooks
+	require.NotNil(t, keeper.FreezeCoins(ctx, owner, types.NewTestCoins("zap123", 5000)))
+
+	// setting the same owner again is not a change
+	_, _, newOwner := types.KeyTestPubAddr()
+	require.Nil(t, keeper.SetOwner(ctx, "zap123", owner))
+	require.Nil(t, keeper.SetOwner(ctx, "zap123", newOwner))
+
+	require.Equal(t, []string{"freeze", "unfreeze", "owner"}, first)
+	require.Equal(t, first, second)
+}
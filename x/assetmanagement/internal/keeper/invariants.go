@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// RegisterInvariants registers all assetmanagement invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "token-supply", TokenSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "frozen-coins", FrozenCoinsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "token-records", TokenRecordsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claim-campaigns", ClaimCampaignsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vesting-grants", VestingGrantsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "token-holders", TokenHoldersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the assetmanagement module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TokenRecordsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = FrozenCoinsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ClaimCampaignsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = VestingGrantsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = TokenHoldersInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return TokenSupplyInvariant(k)(ctx)
+	}
+}
+
+// TokenSupplyInvariant checks that the recorded supply of every token equals the free plus frozen coins
+// held across all accounts. The frozen pool is skipped since it mirrors the frozen balances
+func TokenSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var held sdk.Coins
+		frozenPool := supply.NewModuleAddress(types.FrozenPoolName)
+
+		k.AccountKeeper.IterateAccounts(ctx, func(acc auth.Account) bool {
+			if !acc.GetAddress().Equals(frozenPool) {
+				held = held.Add(acc.GetCoins())
+			}
+			held = held.Add(k.GetFrozenCoins(ctx, acc.GetAddress()))
+			return false
+		})
+
+		var msg string
+		count := 0
+		k.IterateTokens(ctx, func(token types.Token) bool {
+			recorded := token.TotalSupply.AmountOf(token.Symbol)
+			if !recorded.Equal(held.AmountOf(token.Symbol)) {
+				count++
+				msg += fmt.Sprintf("\t%s has recorded supply %s but accounts hold %s\n",
+					token.Symbol, recorded, held.AmountOf(token.Symbol))
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "token supply",
+			fmt.Sprintf("%d tokens with a mismatched supply found\n%s", count, msg)), count != 0
+	}
+}
+
+// FrozenCoinsInvariant checks that no account has negative or zero frozen coins and that the frozen pool
+// holds exactly the coins frozen across all accounts
+func FrozenCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var totalFrozen sdk.Coins
+		count := 0
+
+		k.AccountKeeper.IterateAccounts(ctx, func(acc auth.Account) bool {
+			frozen := k.GetFrozenCoins(ctx, acc.GetAddress())
+			if !frozen.IsValid() {
+				count++
+				msg += fmt.Sprintf("\t%s has invalid frozen coins: %s\n", acc.GetAddress(), frozen)
+			}
+			totalFrozen = totalFrozen.Add(frozen)
+			return false
+		})
+
+		var pooled sdk.Coins
+		if pool := k.AccountKeeper.GetAccount(ctx, supply.NewModuleAddress(types.FrozenPoolName)); pool != nil {
+			pooled = pool.GetCoins()
+		}
+		if !pooled.IsEqual(totalFrozen) {
+			count++
+			msg += fmt.Sprintf("\tfrozen pool holds %s but accounts have %s frozen\n", pooled, totalFrozen)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "frozen coins",
+			fmt.Sprintf("%d invalid frozen balances found\n%s", count, msg)), count != 0
+	}
+}
+
+// TokenRecordsInvariant checks that every token key decodes to a token with a matching symbol and an owner
+func TokenRecordsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		iterator := k.GetTokensIterator(ctx)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			key := string(iterator.Key())
+
+			var token types.Token
+			if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &token); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s does not decode to a token: %s\n", key, err)
+				continue
+			}
+			if token.Symbol != key {
+				count++
+				msg += fmt.Sprintf("\t%s holds a token with symbol %s\n", key, token.Symbol)
+			}
+			if token.Owner.Empty() {
+				count++
+				msg += fmt.Sprintf("\t%s has no owner\n", key)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "token records",
+			fmt.Sprintf("%d invalid token records found\n%s", count, msg)), count != 0
+	}
+}
+
+// ClaimCampaignsInvariant checks that every campaign deposit is in the campaign's token and that the claims pool
+// holds exactly the deposits left across all campaigns
+func ClaimCampaignsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var deposits sdk.Coins
+		count := 0
+
+		k.IterateClaimCampaigns(ctx, func(campaign types.ClaimCampaign) bool {
+			if !campaign.Deposit.IsValid() || !campaign.Deposit.IsZero() &&
+				(campaign.Deposit.Len() != 1 || campaign.Deposit[0].Denom != campaign.Symbol) {
+				count++
+				msg += fmt.Sprintf("\tcampaign %d for %s has an invalid deposit: %s\n",
+					campaign.ID, campaign.Symbol, campaign.Deposit)
+			}
+			deposits = deposits.Add(campaign.Deposit)
+			return false
+		})
+
+		var pooled sdk.Coins
+		if pool := k.AccountKeeper.GetAccount(ctx, supply.NewModuleAddress(types.ClaimsPoolName)); pool != nil {
+			pooled = pool.GetCoins()
+		}
+		if !pooled.IsEqual(deposits) {
+			count++
+			msg += fmt.Sprintf("\tclaims pool holds %s but campaigns have %s left\n", pooled, deposits)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "claim campaigns",
+			fmt.Sprintf("%d invalid claim campaigns found\n%s", count, msg)), count != 0
+	}
+}
+
+// VestingGrantsInvariant checks that every grant releases no more than its total in the grant's token and that the
+// vesting pool holds exactly the coins still locked across all grants
+func VestingGrantsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var locked sdk.Coins
+		count := 0
+
+		k.IterateVestingGrants(ctx, func(grant types.VestingGrant) bool {
+			if !grant.Total.IsValid() || grant.Total.Len() != 1 || grant.Total[0].Denom != grant.Symbol ||
+				!grant.Released.IsValid() || !grant.Total.IsAllGTE(grant.Released) {
+				count++
+				msg += fmt.Sprintf("\tgrant %d for %s has released %s of %s\n",
+					grant.ID, grant.Symbol, grant.Released, grant.Total)
+				return false
+			}
+			locked = locked.Add(grant.Locked())
+			return false
+		})
+
+		var pooled sdk.Coins
+		if pool := k.AccountKeeper.GetAccount(ctx, supply.NewModuleAddress(types.VestingPoolName)); pool != nil {
+			pooled = pool.GetCoins()
+		}
+		if !pooled.IsEqual(locked) {
+			count++
+			msg += fmt.Sprintf("\tvesting pool holds %s but grants have %s locked\n", pooled, locked)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "vesting grants",
+			fmt.Sprintf("%d invalid vesting grants found\n%s", count, msg)), count != 0
+	}
+}
+
+// TokenHoldersInvariant checks that the holders indexed for every token are exactly the accounts other than module
+// accounts holding free or frozen coins of it, and that the holder counts match
+func TokenHoldersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		holding := make(map[string]uint64)
+		k.AccountKeeper.IterateAccounts(ctx, func(acc auth.Account) bool {
+			if _, ok := acc.(exported.ModuleAccountI); ok {
+				return false
+			}
+			for _, coin := range acc.GetCoins().Add(frozenCoins(acc)) {
+				if !k.IsSymbolPresent(ctx, coin.Denom) || !coin.IsPositive() {
+					continue
+				}
+				holding[coin.Denom]++
+				if !k.IsHolder(ctx, coin.Denom, acc.GetAddress()) {
+					count++
+					msg += fmt.Sprintf("\t%s holds %s but isn't indexed as a holder\n", acc.GetAddress(), coin)
+				}
+			}
+			return false
+		})
+
+		k.IterateTokens(ctx, func(token types.Token) bool {
+			var indexed uint64
+			k.IterateHolders(ctx, token.Symbol, func(address sdk.AccAddress) bool {
+				indexed++
+				return false
+			})
+			recorded := k.GetHolderCount(ctx, token.Symbol)
+			if indexed != holding[token.Symbol] || recorded != indexed {
+				count++
+				msg += fmt.Sprintf("\t%s has %d holders, %d indexed and a recorded count of %d\n",
+					token.Symbol, holding[token.Symbol], indexed, recorded)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "token holders",
+			fmt.Sprintf("%d holder mismatches found\n%s", count, msg)), count != 0
+	}
+}
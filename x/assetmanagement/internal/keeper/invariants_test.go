@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func setupToken(t *testing.T, ctx sdk.Context, keeper Keeper, symbol string, supply int64) sdk.AccAddress {
+	_, _, owner := types.KeyTestPubAddr()
+	token := types.NewToken("Zap", symbol, "ZAP", supply, owner, true)
+	require.Nil(t, keeper.MintCoins(ctx, owner, token.TotalSupply))
+	require.Nil(t, keeper.SetToken(ctx, symbol, token))
+	return owner
+}
+
+func TestInvariantsHold(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, holder := types.KeyTestPubAddr()
+
+	err := keeper.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("zap123", 300))
+	require.Nil(t, err)
+	require.Nil(t, keeper.FreezeCoins(ctx, holder, types.NewTestCoins("zap123", 200)))
+	require.Nil(t, keeper.FreezeCoins(ctx, owner, types.NewTestCoins("zap123", 100)))
+	require.Nil(t, keeper.UnfreezeCoins(ctx, holder, types.NewTestCoins("zap123", 50)))
+
+	require.True(t, sdk.NewInt(150).Equal(keeper.GetFrozenCoins(ctx, holder).AmountOf("zap123")))
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestTokenSupplyInvariantBroken(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	setupToken(t, ctx, keeper, "zap123", 1000)
+
+	require.Nil(t, keeper.SetTotalSupply(ctx, "zap123", types.NewTestCoins("zap123", 999)))
+	_, broken := TokenSupplyInvariant(keeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestFrozenCoinsInvariantBroken(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	require.Nil(t, keeper.FreezeCoins(ctx, owner, types.NewTestCoins("zap123", 100)))
+
+	// frozen coins that never made it into the frozen pool
+	account := keeper.AccountKeeper.GetAccount(ctx, owner).(types.CustomAccount)
+	require.Nil(t, account.SetFrozenCoins(types.NewTestCoins("zap123", 200)))
+	keeper.AccountKeeper.SetAccount(ctx, account)
+	_, broken := FrozenCoinsInvariant(keeper)(ctx)
+	require.True(t, broken)
+
+	// zero frozen coins are invalid
+	_, pub, addr := types.KeyTestPubAddr()
+	invalid := types.NewCustomAccount(addr, nil, types.NewTestCoins("zap123", 0), pub, 5, 0)
+	keeper.AccountKeeper.SetAccount(ctx, *invalid)
+	res, broken := FrozenCoinsInvariant(keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, res, addr.String())
+}
+
+func TestTokenRecordsInvariantBroken(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+
+	mismatched := types.NewToken("Zap", "zap123", "ZAP", 1000, owner, true)
+	require.Nil(t, keeper.SetToken(ctx, "zap456", mismatched))
+	_, broken := TokenRecordsInvariant(keeper)(ctx)
+	require.True(t, broken)
+
+	keeper.DeleteToken(ctx, "zap456")
+	_, broken = TokenRecordsInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	ctx.KVStore(keeper.storeKey).Set(types.TokenKey("zap789"), []byte("garbage"))
+	_, broken = TokenRecordsInvariant(keeper)(ctx)
+	require.True(t, broken)
+}
@@ -1,13 +1,13 @@
 package keeper
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
 )
@@ -17,128 +17,423 @@ import (
 type Keeper struct {
 	AccountKeeper auth.AccountKeeper
 	CoinKeeper    bank.Keeper
+	SupplyKeeper  supply.Keeper
 
 	storeKey sdk.StoreKey // Unexposed key to access store from sdk.Context
 
 	cdc *codec.Codec // The wire codec for binary encoding/decoding.
+
+	codespace sdk.CodespaceType
+
+	hooks types.AssetHooks
 }
 
 // NewKeeper creates new instances of the assetmanagement Keeper
-func NewKeeper(accountKeeper auth.AccountKeeper, coinKeeper bank.Keeper, storeKey sdk.StoreKey,
-	cdc *codec.Codec) Keeper {
+func NewKeeper(accountKeeper auth.AccountKeeper, coinKeeper bank.Keeper, supplyKeeper supply.Keeper,
+	storeKey sdk.StoreKey, cdc *codec.Codec, codespace sdk.CodespaceType) Keeper {
 	return Keeper{
 		AccountKeeper: accountKeeper,
 		CoinKeeper:    coinKeeper,
+		SupplyKeeper:  supplyKeeper,
 		storeKey:      storeKey,
 		cdc:           cdc,
+		codespace:     codespace,
+	}
+}
+
+// SetHooks sets the asset hooks
+func (k *Keeper) SetHooks(ah types.AssetHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set asset hooks twice")
 	}
+	k.hooks = ah
+	return k
+}
+
+// Codespace returns the codespace of the module errors
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
 }
 
 // GetToken gets the entire Token metadata struct by symbol. False if not found, true otherwise
-func (k Keeper) GetToken(ctx sdk.Context, symbol string) (*types.Token, error) {
+func (k Keeper) GetToken(ctx sdk.Context, symbol string) (*types.Token, sdk.Error) {
 	store := ctx.KVStore(k.storeKey)
 	if !k.IsSymbolPresent(ctx, symbol) {
-		return nil, fmt.Errorf("could not find Token for symbol '%s'", symbol)
+		return nil, types.ErrTokenSymbolDoesNotExist(k.codespace, symbol)
 	}
-	bz := store.Get([]byte(symbol))
+	bz := store.Get(types.TokenKey(symbol))
 	var token types.Token
 	k.cdc.MustUnmarshalBinaryBare(bz, &token)
 	return &token, nil
 }
 
 // SetToken sets the entire Token metadata struct by symbol. Owner must be set. Returns success
-func (k Keeper) SetToken(ctx sdk.Context, symbol string, token *types.Token) error {
+func (k Keeper) SetToken(ctx sdk.Context, symbol string, token *types.Token) sdk.Error {
 	if token == nil {
-		return errors.New("unable to store nil/empty token")
+		return types.ErrInvalidToken(k.codespace, "unable to store nil/empty token")
 	}
 	if token.Owner.Empty() {
-		return fmt.Errorf("unable to store token because owner for symbol '%s' is empty", symbol)
+		return types.ErrMissingOwner(k.codespace, symbol)
 	}
+	issued := !k.IsSymbolPresent(ctx, symbol)
 	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte(symbol), k.cdc.MustMarshalBinaryBare(*token))
+	store.Set(types.TokenKey(symbol), k.cdc.MustMarshalBinaryBare(*token))
+	if issued {
+		// the coins of a new token are minted to its owner before the token is stored
+		k.UpdateHolders(ctx, token.TotalSupply, token.Owner)
+	}
 	return nil
 }
 
 // DeleteToken - deletes the entire Token metadata struct by symbol
 func (k Keeper) DeleteToken(ctx sdk.Context, symbol string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete([]byte(symbol))
+	store.Delete(types.TokenKey(symbol))
+	k.deleteHolders(ctx, symbol)
 }
 
 // ResolveName - returns the name string that the symbol resolves to
-func (k Keeper) ResolveName(ctx sdk.Context, symbol string) (string, error) {
+func (k Keeper) ResolveName(ctx sdk.Context, symbol string) (string, sdk.Error) {
 	found, err := k.GetToken(ctx, symbol)
-	if err == nil {
-		return found.Name, nil
+	if err != nil {
+		return "", err
 	}
-	return "", fmt.Errorf("couldn't resolve name for symbol '%s' because: %s", symbol, err)
+	return found.Name, nil
 }
 
 // SetName - sets the name string that a symbol resolves to
-func (k Keeper) SetName(ctx sdk.Context, symbol string, name string) error {
+func (k Keeper) SetName(ctx sdk.Context, symbol string, name string) sdk.Error {
 	token, err := k.GetToken(ctx, symbol)
-	if err == nil {
-		token.Name = name
-		return k.SetToken(ctx, symbol, token)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("failed to set token name for symbol '%s' because: %s", symbol, err)
+	token.Name = name
+	return k.SetToken(ctx, symbol, token)
 }
 
 // HasOwner - returns whether or not the symbol already has an owner
-func (k Keeper) HasOwner(ctx sdk.Context, symbol string) (bool, error) {
+func (k Keeper) HasOwner(ctx sdk.Context, symbol string) (bool, sdk.Error) {
 	token, err := k.GetToken(ctx, symbol)
-	if err == nil {
-		return !token.Owner.Empty(), nil
+	if err != nil {
+		return false, err
 	}
-	return false, fmt.Errorf("unable to check owner for symbol '%s' because: %s", symbol, err)
+	return !token.Owner.Empty(), nil
 }
 
 // GetOwner - get the current owner of a symbol
-func (k Keeper) GetOwner(ctx sdk.Context, symbol string) (sdk.AccAddress, error) {
+func (k Keeper) GetOwner(ctx sdk.Context, symbol string) (sdk.AccAddress, sdk.Error) {
 	token, err := k.GetToken(ctx, symbol)
-	if err == nil {
-		return token.Owner, nil
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("unable to get owner for symbol '%s' because: %s", symbol, err)
+	return token.Owner, nil
 }
 
 // SetOwner - sets the current owner of a symbol
-func (k Keeper) SetOwner(ctx sdk.Context, symbol string, owner sdk.AccAddress) error {
+func (k Keeper) SetOwner(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	previousOwner := token.Owner
+	token.Owner = owner
+	if err := k.SetToken(ctx, symbol, token); err != nil {
+		return err
+	}
+	if !previousOwner.Equals(owner) {
+		k.AfterOwnerChanged(ctx, symbol, previousOwner, owner)
+	}
+	return nil
+}
+
+// TransferOwnership - hands a token over to a new owner on behalf of its owner. A council owned token leaves its
+// council, which is dissolved along with its pending actions. The timelocked actions the previous owner queued are
+// dropped
+func (k Keeper) TransferOwnership(ctx sdk.Context, owner sdk.AccAddress, symbol string,
+	newOwner sdk.AccAddress) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	if newOwner.Equals(types.CouncilAddress(symbol)) {
+		return types.ErrInvalidCouncil(k.codespace, "a token is handed to a council with a set council message")
+	}
+
+	if err := k.SetOwner(ctx, symbol, newOwner); err != nil {
+		return err
+	}
+	k.deleteTokenQueuedActions(ctx, symbol)
+	k.deleteTokenPendingActions(ctx, symbol)
+	ctx.KVStore(k.storeKey).Delete(types.CouncilKey(symbol))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOwnership,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+	))
+	return nil
+}
+
+// SetTokenMetadata - changes the name and original symbol of a token on behalf of its owner
+func (k Keeper) SetTokenMetadata(ctx sdk.Context, owner sdk.AccAddress, symbol, name, originalSymbol string) sdk.Error {
 	token, err := k.GetToken(ctx, symbol)
-	if err == nil {
-		token.Owner = owner
-		return k.SetToken(ctx, symbol, token)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
 	}
-	return fmt.Errorf("unable to set owner for symbol '%s' because: %s", symbol, err)
+
+	token.Name = name
+	token.OriginalSymbol = originalSymbol
+	if err := k.SetToken(ctx, symbol, token); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTokenMetadata,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyName, name),
+	))
+	return nil
+}
+
+// SetTokenPaused - pauses or resumes a token on behalf of its owner. While it is paused its coins can't be sent,
+// minted, burnt, frozen, claimed, released, converted, clawed back or emitted
+func (k Keeper) SetTokenPaused(ctx sdk.Context, owner sdk.AccAddress, symbol string, paused bool) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+
+	token.Paused = paused
+	if err := k.SetToken(ctx, symbol, token); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTokenPaused,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyPaused, fmt.Sprintf("%v", paused)),
+	))
+	return nil
 }
 
 // GetTotalSupply - gets the current total supply of a symbol
-func (k Keeper) GetTotalSupply(ctx sdk.Context, symbol string) (sdk.Coins, error) {
+func (k Keeper) GetTotalSupply(ctx sdk.Context, symbol string) (sdk.Coins, sdk.Error) {
 	token, err := k.GetToken(ctx, symbol)
-	if err == nil {
-		return token.TotalSupply, nil
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("failed to get total supply for symbol '%s' because: %s", symbol, err)
+	return token.TotalSupply, nil
 }
 
 // SetTotalSupply - sets the current total supply of a symbol
-func (k Keeper) SetTotalSupply(ctx sdk.Context, symbol string, totalSupply sdk.Coins) error {
+func (k Keeper) SetTotalSupply(ctx sdk.Context, symbol string, totalSupply sdk.Coins) sdk.Error {
 	token, err := k.GetToken(ctx, symbol)
-	if err == nil {
-		token.TotalSupply = totalSupply
-		return k.SetToken(ctx, symbol, token)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("failed to set total supply for symbol '%s' because: %s", symbol, err)
+	token.TotalSupply = totalSupply
+	return k.SetToken(ctx, symbol, token)
 }
 
 // GetTokensIterator - Get an iterator over all symbols in which the keys are the symbols and the values are the token
 func (k Keeper) GetTokensIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
-	return sdk.KVStorePrefixIterator(store, nil)
+	return store.Iterator(types.TokenKeysStart, nil)
+}
+
+// IterateTokens - iterates over all tokens in symbol order until the callback returns true
+func (k Keeper) IterateTokens(ctx sdk.Context, cb func(token types.Token) (stop bool)) {
+	iterator := k.GetTokensIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.Token
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &token)
+		if cb(token) {
+			break
+		}
+	}
 }
 
 // IsSymbolPresent - Check if the symbol is present in the store or not
 func (k Keeper) IsSymbolPresent(ctx sdk.Context, symbol string) bool {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has([]byte(symbol))
+	return store.Has(types.TokenKey(symbol))
+}
+
+// GetFrozenCoins - gets the coins frozen on an account, empty if the account can't hold frozen coins
+func (k Keeper) GetFrozenCoins(ctx sdk.Context, address sdk.AccAddress) sdk.Coins {
+	return frozenCoins(k.AccountKeeper.GetAccount(ctx, address))
+}
+
+func frozenCoins(account auth.Account) sdk.Coins {
+	switch account := account.(type) {
+	case types.CustomAccount:
+		return account.GetFrozenCoins()
+	case *types.CustomAccount:
+		return account.GetFrozenCoins()
+	default:
+		return sdk.NewCoins()
+	}
+}
+
+// MintCoins - creates new coins through the supply module and credits them to the recipient
+func (k Keeper) MintCoins(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	if err := k.SupplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
+// BurnCoins - takes coins from the holder and destroys them through the supply module
+func (k Keeper) BurnCoins(ctx sdk.Context, holder sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	account := k.AccountKeeper.GetAccount(ctx, holder)
+	if account == nil {
+		return types.ErrUnknownAccount(k.codespace, holder)
+	}
+	if !account.GetCoins().IsAllGTE(coins) {
+		return types.ErrInsufficientCoins(k.codespace,
+			fmt.Sprintf("%s holds %s, not enough to burn %s", holder, account.GetCoins(), coins))
+	}
+	if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.SupplyKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// DistributeCoins - sends coins of a token from the sender to every recipient in a single transfer, each recipient
+// pays the transfer fee on what it receives
+func (k Keeper) DistributeCoins(ctx sdk.Context, sender sdk.AccAddress, symbol string,
+	recipients []types.Recipient) sdk.Error {
+	if !k.IsSymbolPresent(ctx, symbol) {
+		return types.ErrTokenSymbolDoesNotExist(k.codespace, symbol)
+	}
+
+	total := sdk.NewCoins()
+	outputs := make([]bank.Output, 0, len(recipients))
+	for _, recipient := range recipients {
+		if k.CoinKeeper.BlacklistedAddr(recipient.Address) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", recipient.Address))
+		}
+		coins := sdk.NewCoins(sdk.NewInt64Coin(symbol, recipient.Amount))
+		if err := k.CheckTransferMode(ctx, sender, recipient.Address, coins); err != nil {
+			return err
+		}
+		outputs = append(outputs, bank.NewOutput(recipient.Address, coins))
+		total = total.Add(coins)
+	}
+
+	account := k.AccountKeeper.GetAccount(ctx, sender)
+	if account == nil {
+		return types.ErrUnknownAccount(k.codespace, sender)
+	}
+	if !account.GetCoins().IsAllGTE(total) {
+		return types.ErrInsufficientCoins(k.codespace,
+			fmt.Sprintf("%s holds %s, not enough to distribute %s", sender, account.GetCoins(), total))
+	}
+	inputs := []bank.Input{bank.NewInput(sender, total)}
+	if err := k.CheckHoldingLimits(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	if err := k.CoinKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	for _, output := range outputs {
+		if _, err := k.ChargeTransferFee(ctx, sender, output.Address, output.Coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FreezeCoins - moves coins from the free into the frozen balance of an account. The frozen coins are
+// parked in the frozen pool module account so that the supply module still accounts for them
+func (k Keeper) FreezeCoins(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	account, err := k.getCustomAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if err := account.FreezeCoins(coins); err != nil {
+		return err
+	}
+	k.AccountKeeper.SetAccount(ctx, account)
+
+	pool := k.SupplyKeeper.GetModuleAccount(ctx, types.FrozenPoolName)
+	if _, err := k.CoinKeeper.AddCoins(ctx, pool.GetAddress(), coins); err != nil {
+		return err
+	}
+	k.AfterFreeze(ctx, address, coins)
+	return nil
+}
+
+// UnfreezeCoins - moves coins from the frozen back into the free balance of an account
+func (k Keeper) UnfreezeCoins(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	account, err := k.getCustomAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if err := account.UnfreezeCoins(coins); err != nil {
+		return err
+	}
+	k.AccountKeeper.SetAccount(ctx, account)
+
+	pool := k.SupplyKeeper.GetModuleAccount(ctx, types.FrozenPoolName)
+	if _, err := k.CoinKeeper.SubtractCoins(ctx, pool.GetAddress(), coins); err != nil {
+		return err
+	}
+	k.AfterUnfreeze(ctx, address, coins)
+	return nil
+}
+
+// SetFrozenCoins - records frozen coins on an account without moving anything into the frozen pool.
+// Only meant for restoring state at genesis, where the pool balance is carried by the accounts section
+func (k Keeper) SetFrozenCoins(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	account, err := k.getCustomAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if err := account.SetFrozenCoins(coins); err != nil {
+		return types.ErrInvalidAmount(k.codespace, err.Error())
+	}
+	k.AccountKeeper.SetAccount(ctx, account)
+	return nil
+}
+
+// IterateFrozenCoins - iterates over every account able to hold frozen coins, stopping when the callback returns true
+func (k Keeper) IterateFrozenCoins(ctx sdk.Context, cb func(address sdk.AccAddress, frozen sdk.Coins) bool) {
+	k.AccountKeeper.IterateAccounts(ctx, func(account auth.Account) bool {
+		switch account := account.(type) {
+		case types.CustomAccount:
+			return cb(account.Address, account.FrozenCoins)
+		case *types.CustomAccount:
+			return cb(account.Address, account.FrozenCoins)
+		default:
+			return false
+		}
+	})
+}
+
+// getCustomAccount loads an account as a CustomAccount, upgrading plain base accounts on the fly
+func (k Keeper) getCustomAccount(ctx sdk.Context, address sdk.AccAddress) (types.CustomAccount, sdk.Error) {
+	switch account := k.AccountKeeper.GetAccount(ctx, address).(type) {
+	case types.CustomAccount:
+		return account, nil
+	case *types.CustomAccount:
+		return *account, nil
+	case *auth.BaseAccount:
+		return types.CustomAccount{BaseAccount: account}, nil
+	case nil:
+		return types.CustomAccount{}, types.ErrUnknownAccount(k.codespace, address)
+	default:
+		return types.CustomAccount{}, types.ErrAccountCannotFreeze(k.codespace, address)
+	}
 }
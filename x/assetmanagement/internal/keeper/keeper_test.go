@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestFreezeCoinsUsesFrozenPool(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	_, _, owner := types.KeyTestPubAddr()
+	require.Nil(t, keeper.MintCoins(ctx, owner, types.NewTestCoins("zap123", 1000)))
+
+	require.Nil(t, keeper.FreezeCoins(ctx, owner, types.NewTestCoins("zap123", 300)))
+	require.Nil(t, keeper.UnfreezeCoins(ctx, owner, types.NewTestCoins("zap123", 100)))
+
+	pool := keeper.SupplyKeeper.GetModuleAccount(ctx, types.FrozenPoolName)
+	require.Equal(t, types.NewTestCoins("zap123", 200), pool.GetCoins())
+	require.Equal(t, types.NewTestCoins("zap123", 200), keeper.GetFrozenCoins(ctx, owner))
+	require.Equal(t, types.NewTestCoins("zap123", 800), keeper.CoinKeeper.GetCoins(ctx, owner))
+
+	// the supply module keeps counting the frozen coins
+	require.Equal(t, types.NewTestCoins("zap123", 1000), keeper.SupplyKeeper.GetSupply(ctx).GetTotal())
+
+	require.Nil(t, keeper.BurnCoins(ctx, owner, types.NewTestCoins("zap123", 800)))
+	require.Equal(t, types.NewTestCoins("zap123", 200), keeper.SupplyKeeper.GetSupply(ctx).GetTotal())
+}
+
+func TestFreezeRequiresCoinAccount(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	_, _, addr := types.KeyTestPubAddr()
+
+	require.NotNil(t, keeper.FreezeCoins(ctx, addr, types.NewTestCoins("zap123", 1)))
+
+	account := auth.NewBaseAccountWithAddress(addr)
+	keeper.AccountKeeper.SetAccount(ctx, &account)
+	require.NotNil(t, keeper.FreezeCoins(ctx, addr, types.NewTestCoins("zap123", 1)))
+}
+
+func TestTransferOwnership(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	members := setupCouncil(t, ctx, keeper, "abc123", 2)
+	council := types.CouncilAddress("abc123")
+	_, _, alice := types.KeyTestPubAddr()
+	var calls []string
+	keeper = *keeper.SetHooks(recordingHooks{&calls})
+
+	err := keeper.TransferOwnership(ctx, members[0], "abc123", alice)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	err = keeper.TransferOwnership(ctx, council, "abc123", council)
+	require.Equal(t, types.CodeInvalidCouncil, err.Code())
+	err = keeper.TransferOwnership(ctx, council, "xyz123", alice)
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+
+	_, err = keeper.ProposeAction(ctx, members[0], "abc123", types.NewMsgMintCoins(100, "abc123", council),
+		ctx.BlockTime().Add(time.Hour))
+	require.Nil(t, err)
+
+	// the council steps down, taking what it left undecided with it
+	require.Nil(t, keeper.TransferOwnership(ctx, council, "abc123", alice))
+	require.Equal(t, []string{"owner"}, calls)
+	token, _ := keeper.GetToken(ctx, "abc123")
+	require.Equal(t, alice, token.Owner)
+	_, err = keeper.GetCouncil(ctx, "abc123")
+	require.Equal(t, types.CodeCouncilDoesNotExist, err.Code())
+	require.Empty(t, keeper.GetTokenPendingActions(ctx, "abc123"))
+
+	err = keeper.TransferOwnership(ctx, council, "abc123", members[0])
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+}
+
+func TestSetTokenMetadata(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	_, _, stranger := types.KeyTestPubAddr()
+
+	err := keeper.SetTokenMetadata(ctx, stranger, "abc123", "Xyz", "XYZ")
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+
+	require.Nil(t, keeper.SetTokenMetadata(ctx, owner, "abc123", "Xyz", "XYZ"))
+	token, _ := keeper.GetToken(ctx, "abc123")
+	require.Equal(t, "Xyz", token.Name)
+	require.Equal(t, "XYZ", token.OriginalSymbol)
+	require.Equal(t, "abc123", token.Symbol)
+}
+
+func TestSetTokenPaused(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, holder := types.KeyTestPubAddr()
+	require.Nil(t, keeper.ScheduleEmission(ctx, owner,
+		types.NewEmissionSchedule("zap123", holder, 100, 6, 10, 0, sdk.ZeroDec(), 0)))
+
+	err := keeper.SetTokenPaused(ctx, holder, "zap123", true)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "zap123", true))
+
+	// nothing moves, not even to or from the owner, and the emission waits
+	err = keeper.CheckTransferMode(ctx, owner, holder, types.NewTestCoins("zap123", 10))
+	require.Equal(t, types.CodeTokenPaused, err.Code())
+	_, err = keeper.CreateVestingGrant(ctx, owner, holder, "zap123", 10, ctx.BlockTime(), ctx.BlockTime(),
+		ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, types.CodeTokenPaused, err.Code())
+	keeper.ProcessEmissions(ctx.WithBlockHeight(6))
+	require.True(t, keeper.CoinKeeper.GetCoins(ctx, holder).Empty())
+
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "zap123", false))
+	require.Nil(t, keeper.CheckTransferMode(ctx, owner, holder, types.NewTestCoins("zap123", 10)))
+	keeper.ProcessEmissions(ctx.WithBlockHeight(7))
+	require.Equal(t, sdk.NewInt(100), keeper.CoinKeeper.GetCoins(ctx, holder).AmountOf("zap123"))
+}
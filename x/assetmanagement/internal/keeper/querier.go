@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -15,8 +16,48 @@ import (
 
 // query endpoints supported by the assetmanagement Querier
 const (
-	QuerySymbols = "symbols"
-	QueryToken   = "token"
+	QuerySymbols   = "symbols"
+	QueryToken     = "token"
+	QueryAccount   = "account"
+	QueryCampaign  = "campaign"
+	QueryCampaigns = "campaigns"
+
+	QueryVestingGrant             = "vesting_grant"
+	QueryTokenVestingGrants       = "token_vesting_grants"
+	QueryBeneficiaryVestingGrants = "beneficiary_vesting_grants"
+
+	QueryEmission  = "emission"
+	QueryEmissions = "emissions"
+
+	QueryAllowance         = "allowance"
+	QueryOwnerAllowances   = "owner_allowances"
+	QuerySpenderAllowances = "spender_allowances"
+
+	QueryClawbacks = "clawbacks"
+
+	QueryTransferFee = "transfer_fee"
+
+	QueryHolders = "holders"
+	QueryHolding = "holding"
+
+	QueryRedenominations = "redenominations"
+
+	QueryConversion  = "conversion"
+	QueryConversions = "conversions"
+
+	QueryTokenStatus  = "token_status"
+	QueryRetiredToken = "retired_token"
+
+	QueryCouncil        = "council"
+	QueryPendingAction  = "pending_action"
+	QueryPendingActions = "pending_actions"
+
+	QueryQueuedAction  = "queued_action"
+	QueryQueuedActions = "queued_actions"
+
+	QueryGrant         = "grant"
+	QueryGranterGrants = "granter_grants"
+	QueryGranteeGrants = "grantee_grants"
 )
 
 // NewQuerier is the module level router for state queries
@@ -26,24 +67,75 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case QueryToken:
 			return queryToken(ctx, path[1:], req, keeper)
 		case QuerySymbols:
-			return querySymbols(ctx, req, keeper)
+			return querySymbols(ctx, path[1:], req, keeper)
+		case QueryAccount:
+			return queryAccount(ctx, path[1:], req, keeper)
+		case QueryCampaign:
+			return queryCampaign(ctx, path[1:], req, keeper)
+		case QueryCampaigns:
+			return queryCampaigns(ctx, req, keeper)
+		case QueryVestingGrant:
+			return queryVestingGrant(ctx, path[1:], req, keeper)
+		case QueryTokenVestingGrants:
+			return queryTokenVestingGrants(ctx, path[1:], req, keeper)
+		case QueryBeneficiaryVestingGrants:
+			return queryBeneficiaryVestingGrants(ctx, path[1:], req, keeper)
+		case QueryEmission:
+			return queryEmission(ctx, path[1:], req, keeper)
+		case QueryEmissions:
+			return queryEmissions(ctx, req, keeper)
+		case QueryAllowance:
+			return queryAllowance(ctx, path[1:], req, keeper)
+		case QueryOwnerAllowances:
+			return queryOwnerAllowances(ctx, path[1:], req, keeper)
+		case QuerySpenderAllowances:
+			return querySpenderAllowances(ctx, path[1:], req, keeper)
+		case QueryClawbacks:
+			return queryClawbacks(ctx, path[1:], req, keeper)
+		case QueryTransferFee:
+			return queryTransferFee(ctx, path[1:], req, keeper)
+		case QueryHolders:
+			return queryHolders(ctx, path[1:], req, keeper)
+		case QueryHolding:
+			return queryHolding(ctx, path[1:], req, keeper)
+		case QueryRedenominations:
+			return queryRedenominations(ctx, path[1:], req, keeper)
+		case QueryConversion:
+			return queryConversion(ctx, path[1:], req, keeper)
+		case QueryConversions:
+			return queryConversions(ctx, req, keeper)
+		case QueryTokenStatus:
+			return queryTokenStatus(ctx, path[1:], req, keeper)
+		case QueryRetiredToken:
+			return queryRetiredToken(ctx, path[1:], req, keeper)
+		case QueryCouncil:
+			return queryCouncil(ctx, path[1:], req, keeper)
+		case QueryPendingAction:
+			return queryPendingAction(ctx, path[1:], req, keeper)
+		case QueryPendingActions:
+			return queryPendingActions(ctx, path[1:], req, keeper)
+		case QueryQueuedAction:
+			return queryQueuedAction(ctx, path[1:], req, keeper)
+		case QueryQueuedActions:
+			return queryQueuedActions(ctx, path[1:], req, keeper)
+		case QueryGrant:
+			return queryGrant(ctx, path[1:], req, keeper)
+		case QueryGranterGrants:
+			return queryGranterGrants(ctx, path[1:], req, keeper)
+		case QueryGranteeGrants:
+			return queryGranteeGrants(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown assetmanagement query endpoint")
 		}
 	}
 }
 
-func unprettifySymbol(symbol string) string {
-	clean := strings.Replace(symbol, "-", "", -1)
-	return strings.ToLower(clean)
-}
-
 // nolint: unparam
 func queryToken(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	searchToken := unprettifySymbol(path[0])
-	token, err := keeper.GetToken(ctx, searchToken)
-	if err != nil {
-		panic(fmt.Sprintf("could not get token: %s", err))
+	searchToken := types.NormalizeSymbol(path[0])
+	token, sdkErr := keeper.GetToken(ctx, searchToken)
+	if sdkErr != nil {
+		return nil, sdkErr
 	}
 
 	res, err := codec.MarshalJSONIndent(keeper.cdc, token)
@@ -73,14 +165,33 @@ func prettifySymbol(symbol string) string {
 	return insertInto(upper, 3, '-')
 }
 
+// querySymbols lists the symbols of the live tokens, or only those with the status given as the optional path
+// element. Retired tokens are listed from their archived records
 // nolint: unparam
-func querySymbols(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+func querySymbols(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var symbolList types.QueryResultSymbol
 
-	iterator := keeper.GetTokensIterator(ctx)
+	var status types.TokenStatus
+	if len(path) > 0 && path[0] != "" {
+		var err error
+		if status, err = types.TokenStatusFromString(path[0]); err != nil {
+			return nil, types.ErrInvalidTokenStatus(keeper.codespace, err.Error())
+		}
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		symbolList = append(symbolList, prettifySymbol(string(iterator.Key())))
+	if status == types.TokenStatusRetired {
+		keeper.IterateArchivedTokens(ctx, func(archived types.ArchivedToken) bool {
+			symbolList = append(symbolList, prettifySymbol(archived.Token.Symbol))
+			return false
+		})
+	} else {
+		keeper.IterateTokens(ctx, func(token types.Token) bool {
+			current, _ := types.TokenStatusFromString(string(token.Status))
+			if status == "" || current == status {
+				symbolList = append(symbolList, prettifySymbol(token.Symbol))
+			}
+			return false
+		})
 	}
 
 	res, err := codec.MarshalJSONIndent(keeper.cdc, symbolList)
@@ -90,3 +201,619 @@ func querySymbols(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte
 
 	return res, nil
 }
+
+// nolint: unparam
+func queryAccount(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing account address")
+	}
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+
+	account := keeper.AccountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		return nil, types.ErrUnknownAccount(keeper.codespace, address)
+	}
+
+	free := account.GetCoins()
+	frozen := keeper.GetFrozenCoins(ctx, address)
+
+	result := types.QueryResultAccount{Address: address, Balances: []types.AccountTokenBalance{}}
+	for _, coin := range free.Add(frozen) {
+		denom := coin.Denom
+		balance := types.AccountTokenBalance{
+			Symbol: denom,
+			Free:   free.AmountOf(denom),
+			Frozen: frozen.AmountOf(denom),
+		}
+		if token, err := keeper.GetToken(ctx, denom); err == nil {
+			balance.Name = token.Name
+			balance.OriginalSymbol = token.OriginalSymbol
+		}
+		result.Balances = append(result.Balances, balance)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryCampaign(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing campaign id")
+	}
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid campaign id '%s'", path[0]))
+	}
+
+	campaign, sdkErr := keeper.GetClaimCampaign(ctx, id)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, campaign)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryCampaigns(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	campaigns := types.QueryResultCampaigns{}
+	keeper.IterateClaimCampaigns(ctx, func(campaign types.ClaimCampaign) bool {
+		campaigns = append(campaigns, campaign)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, campaigns)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryVestingGrant(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing vesting grant id")
+	}
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid vesting grant id '%s'", path[0]))
+	}
+
+	grant, sdkErr := keeper.GetVestingGrant(ctx, id)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, grant)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryTokenVestingGrants(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+	symbol := types.NormalizeSymbol(path[0])
+	if _, sdkErr := keeper.GetToken(ctx, symbol); sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc,
+		types.QueryResultVestingGrants(keeper.GetTokenVestingGrants(ctx, symbol)))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryBeneficiaryVestingGrants(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing beneficiary address")
+	}
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc,
+		types.QueryResultVestingGrants(keeper.GetBeneficiaryVestingGrants(ctx, address)))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryEmission(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+
+	emission, sdkErr := keeper.GetEmission(ctx, types.NormalizeSymbol(path[0]))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, emission)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryEmissions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	emissions := types.QueryResultEmissions{}
+	keeper.IterateEmissions(ctx, func(emission types.EmissionSchedule) bool {
+		emissions = append(emissions, emission)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, emissions)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryAllowance(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) < 3 {
+		return nil, sdk.ErrUnknownRequest("expected owner address, spender address and token symbol")
+	}
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+	spender, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[1])
+	}
+
+	allowance, sdkErr := keeper.GetAllowance(ctx, owner, spender, types.NormalizeSymbol(path[2]))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, allowance)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryOwnerAllowances(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte,
+	sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing owner address")
+	}
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResultAllowances(keeper.GetOwnerAllowances(ctx, owner)))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func querySpenderAllowances(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte,
+	sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing spender address")
+	}
+	spender, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc,
+		types.QueryResultAllowances(keeper.GetSpenderAllowances(ctx, spender)))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryClawbacks(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+	symbol := types.NormalizeSymbol(path[0])
+	if _, sdkErr := keeper.GetToken(ctx, symbol); sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResultClawbacks(keeper.GetTokenClawbacks(ctx, symbol)))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryTransferFee(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+
+	fee, sdkErr := keeper.GetTransferFee(ctx, types.NormalizeSymbol(path[0]))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, fee)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryHolders(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+
+	symbol := types.NormalizeSymbol(path[0])
+	token, sdkErr := keeper.GetToken(ctx, symbol)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	result := types.QueryResultHolders{
+		Symbol:               symbol,
+		Holders:              keeper.GetHolderCount(ctx, symbol),
+		MaxHolders:           token.MaxHolders,
+		MaxBalancePerAccount: token.MaxBalancePerAccount,
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryHolding(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) < 2 {
+		return nil, sdk.ErrUnknownRequest("expected token symbol and address")
+	}
+	address, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[1])
+	}
+
+	symbol := types.NormalizeSymbol(path[0])
+	token, sdkErr := keeper.GetToken(ctx, symbol)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	balance, module := keeper.holders().balance(ctx, symbol, address)
+	result := types.QueryResultHolding{
+		Symbol:               symbol,
+		Address:              address,
+		Balance:              balance,
+		MaxBalancePerAccount: token.MaxBalancePerAccount,
+		Limited:              token.MaxBalancePerAccount > 0 && !module && !address.Equals(token.Owner),
+		Remaining:            sdk.ZeroInt(),
+	}
+	if result.Limited {
+		result.Remaining = sdk.MaxInt(sdk.NewInt(token.MaxBalancePerAccount).Sub(balance), sdk.ZeroInt())
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryRedenominations(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+	symbol := types.NormalizeSymbol(path[0])
+	if _, sdkErr := keeper.GetToken(ctx, symbol); sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	records := types.QueryResultRedenominations(keeper.GetTokenRedenominations(ctx, symbol))
+	res, err := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryConversion(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+
+	window, sdkErr := keeper.GetConversionWindow(ctx, types.NormalizeSymbol(path[0]))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, window)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryConversions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	windows := types.QueryResultConversions{}
+	keeper.IterateConversionWindows(ctx, func(window types.ConversionWindow) bool {
+		windows = append(windows, window)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, windows)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryTokenStatus(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+
+	symbol := types.NormalizeSymbol(path[0])
+	status, sdkErr := keeper.GetTokenStatus(ctx, symbol)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResultTokenStatus{Symbol: symbol, Status: status})
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryRetiredToken(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+
+	archived, sdkErr := keeper.GetArchivedToken(ctx, types.NormalizeSymbol(path[0]))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, archived)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryCouncil(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token symbol")
+	}
+
+	council, sdkErr := keeper.GetCouncil(ctx, types.NormalizeSymbol(path[0]))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, council)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryPendingAction(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing action id")
+	}
+	id, parseErr := strconv.ParseUint(path[0], 10, 64)
+	if parseErr != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid action id '%s'", path[0]))
+	}
+
+	action, sdkErr := keeper.GetPendingAction(ctx, id)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, action)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// queryPendingActions lists the pending actions of every council, or of the council of one token when a symbol is
+// given
+// nolint: unparam
+func queryPendingActions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	actions := types.QueryResultPendingActions{}
+	if len(path) > 0 && path[0] != "" {
+		symbol := types.NormalizeSymbol(path[0])
+		if _, sdkErr := keeper.GetCouncil(ctx, symbol); sdkErr != nil {
+			return nil, sdkErr
+		}
+		actions = append(actions, keeper.GetTokenPendingActions(ctx, symbol)...)
+	} else {
+		keeper.IteratePendingActions(ctx, func(action types.PendingAction) bool {
+			actions = append(actions, action)
+			return false
+		})
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, actions)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryQueuedAction(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing action id")
+	}
+	id, parseErr := strconv.ParseUint(path[0], 10, 64)
+	if parseErr != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid action id '%s'", path[0]))
+	}
+
+	action, sdkErr := keeper.GetQueuedAction(ctx, id)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, action)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// queryQueuedActions lists the timelocked actions waiting for their ETA, of every token or of one token when a
+// symbol is given
+// nolint: unparam
+func queryQueuedActions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	actions := types.QueryResultQueuedActions{}
+	if len(path) > 0 && path[0] != "" {
+		symbol := types.NormalizeSymbol(path[0])
+		if _, sdkErr := keeper.GetToken(ctx, symbol); sdkErr != nil {
+			return nil, sdkErr
+		}
+		actions = append(actions, keeper.GetTokenQueuedActions(ctx, symbol)...)
+	} else {
+		keeper.IterateQueuedActions(ctx, func(action types.QueuedAction) bool {
+			actions = append(actions, action)
+			return false
+		})
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, actions)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryGrant(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) < 4 {
+		return nil, sdk.ErrUnknownRequest("expected granter address, grantee address, token symbol and message type")
+	}
+	granter, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+	grantee, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[1])
+	}
+
+	grant, sdkErr := keeper.GetGrant(ctx, granter, grantee, types.NormalizeSymbol(path[2]), path[3])
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, grant)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryGranterGrants(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing granter address")
+	}
+	granter, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResultGrants(keeper.GetGranterGrants(ctx, granter)))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// nolint: unparam
+func queryGranteeGrants(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing grantee address")
+	}
+	grantee, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResultGrants(keeper.GetGranteeGrants(ctx, grantee)))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,414 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestQueryAccount(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	_, pub, addr := types.KeyTestPubAddr()
+
+	token := types.NewToken("Zap", "zap123", "ZAP", 1000, addr, false)
+	require.Nil(t, keeper.SetToken(ctx, token.Symbol, token))
+
+	account := types.NewCustomAccount(addr, sdk.NewCoins(sdk.NewInt64Coin("zap123", 900), sdk.NewInt64Coin("stake", 5)),
+		types.NewTestCoins("zap123", 100), pub, 0, 0)
+	keeper.AccountKeeper.SetAccount(ctx, *account)
+
+	res, err := querier(ctx, []string{QueryAccount, addr.String()}, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var out types.QueryResultAccount
+	keeper.cdc.MustUnmarshalJSON(res, &out)
+	require.Equal(t, addr, out.Address)
+	require.Len(t, out.Balances, 2)
+
+	require.Equal(t, "stake", out.Balances[0].Symbol)
+	require.Equal(t, "", out.Balances[0].Name)
+	require.True(t, sdk.NewInt(5).Equal(out.Balances[0].Free))
+	require.True(t, out.Balances[0].Frozen.IsZero())
+
+	require.Equal(t, "zap123", out.Balances[1].Symbol)
+	require.Equal(t, "Zap", out.Balances[1].Name)
+	require.Equal(t, "ZAP", out.Balances[1].OriginalSymbol)
+	require.True(t, sdk.NewInt(900).Equal(out.Balances[1].Free))
+	require.True(t, sdk.NewInt(100).Equal(out.Balances[1].Frozen))
+}
+
+func TestQueryAccountUnknown(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	_, _, addr := types.KeyTestPubAddr()
+
+	_, err := querier(ctx, []string{QueryAccount, addr.String()}, abci.RequestQuery{})
+	require.NotNil(t, err)
+
+	_, err = querier(ctx, []string{QueryAccount, fmt.Sprintf("%s-invalid", addr)}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
+
+func TestQueryCampaigns(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	_, _, addr := types.KeyTestPubAddr()
+
+	campaign := types.NewClaimCampaign(1, addr, "zap123", strings.Repeat("ab", 32), 100,
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	keeper.SetClaimCampaign(ctx, campaign)
+
+	res, err := querier(ctx, []string{QueryCampaign, "1"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var out types.ClaimCampaign
+	keeper.cdc.MustUnmarshalJSON(res, &out)
+	require.Equal(t, campaign, out)
+
+	res, err = querier(ctx, []string{QueryCampaigns}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var list types.QueryResultCampaigns
+	keeper.cdc.MustUnmarshalJSON(res, &list)
+	require.Equal(t, types.QueryResultCampaigns{campaign}, list)
+
+	_, err = querier(ctx, []string{QueryCampaign, "2"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeCampaignDoesNotExist, err.Code())
+	_, err = querier(ctx, []string{QueryCampaign, "one"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
+
+func TestQueryVestingGrants(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, beneficiary := types.KeyTestPubAddr()
+
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	grant := types.NewVestingGrant(1, owner, beneficiary, "zap123", 100, start, start, start.Add(time.Hour))
+	keeper.SetVestingGrant(ctx, grant)
+
+	res, err := querier(ctx, []string{QueryVestingGrant, "1"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var out types.VestingGrant
+	keeper.cdc.MustUnmarshalJSON(res, &out)
+	require.Equal(t, grant, out)
+
+	res, err = querier(ctx, []string{QueryTokenVestingGrants, "ZAP-123"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var list types.QueryResultVestingGrants
+	keeper.cdc.MustUnmarshalJSON(res, &list)
+	require.Equal(t, types.QueryResultVestingGrants{grant}, list)
+
+	res, err = querier(ctx, []string{QueryBeneficiaryVestingGrants, beneficiary.String()}, abci.RequestQuery{})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(res, &list)
+	require.Equal(t, types.QueryResultVestingGrants{grant}, list)
+
+	res, err = querier(ctx, []string{QueryBeneficiaryVestingGrants, owner.String()}, abci.RequestQuery{})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(res, &list)
+	require.Empty(t, list)
+
+	_, err = querier(ctx, []string{QueryVestingGrant, "2"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeVestingGrantDoesNotExist, err.Code())
+	_, err = querier(ctx, []string{QueryTokenVestingGrants, "abc123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+}
+
+func TestQueryAllowances(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, spender := types.KeyTestPubAddr()
+
+	allowance := types.NewAllowance(owner, spender, "zap123", 100, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	keeper.SetAllowance(ctx, allowance)
+
+	res, err := querier(ctx, []string{QueryAllowance, owner.String(), spender.String(), "ZAP-123"},
+		abci.RequestQuery{})
+	require.Nil(t, err)
+	var out types.Allowance
+	keeper.cdc.MustUnmarshalJSON(res, &out)
+	require.Equal(t, allowance, out)
+
+	res, err = querier(ctx, []string{QueryOwnerAllowances, owner.String()}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var list types.QueryResultAllowances
+	keeper.cdc.MustUnmarshalJSON(res, &list)
+	require.Equal(t, types.QueryResultAllowances{allowance}, list)
+
+	res, err = querier(ctx, []string{QuerySpenderAllowances, spender.String()}, abci.RequestQuery{})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(res, &list)
+	require.Equal(t, types.QueryResultAllowances{allowance}, list)
+
+	_, err = querier(ctx, []string{QueryAllowance, spender.String(), owner.String(), "zap123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeAllowanceDoesNotExist, err.Code())
+	_, err = querier(ctx, []string{QueryOwnerAllowances, "cosmos1invalid"}, abci.RequestQuery{})
+	require.Equal(t, sdk.CodeInvalidAddress, err.Code())
+}
+
+func TestQueryClawbacks(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	owner := setupClawbackableToken(t, ctx, keeper, "zap123", 1000)
+	_, _, holder := types.KeyTestPubAddr()
+	require.Nil(t, keeper.CoinKeeper.SendCoins(ctx, owner, holder, types.NewTestCoins("zap123", 100)))
+
+	record, err := keeper.Clawback(ctx, owner, "zap123", holder, nil, 40, "court order")
+	require.Nil(t, err)
+
+	res, err := querier(ctx, []string{QueryClawbacks, "ZAP-123"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var list types.QueryResultClawbacks
+	keeper.cdc.MustUnmarshalJSON(res, &list)
+	require.Len(t, list, 1)
+	require.Equal(t, record.ID, list[0].ID)
+	require.Equal(t, record.Amount, list[0].Amount)
+	require.True(t, list[0].Burned())
+
+	_, err = querier(ctx, []string{QueryClawbacks, "abc123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+}
+
+func TestQueryTransferFee(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	require.Nil(t, keeper.ConfigureTransferFee(ctx, owner, "zap123", 25, 1, 100, owner))
+
+	res, err := querier(ctx, []string{QueryTransferFee, "ZAP-123"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var out types.TransferFee
+	keeper.cdc.MustUnmarshalJSON(res, &out)
+	require.Equal(t, uint64(25), out.Rate)
+	require.True(t, out.Collected.IsZero())
+
+	_, err = querier(ctx, []string{QueryTransferFee, "abc123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeTransferFeeDoesNotExist, err.Code())
+}
+
+func TestQueryHolders(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, holder := types.KeyTestPubAddr()
+	require.Nil(t, keeper.SetHoldingLimits(ctx, owner, "zap123", 100, 10))
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{types.NewRecipient(holder, 40)}))
+
+	res, err := querier(ctx, []string{QueryHolders, "zap123"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var holders types.QueryResultHolders
+	keeper.cdc.MustUnmarshalJSON(res, &holders)
+	require.Equal(t, uint64(2), holders.Holders)
+	require.Equal(t, uint64(10), holders.MaxHolders)
+
+	res, err = querier(ctx, []string{QueryHolding, "zap123", holder.String()}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var holding types.QueryResultHolding
+	keeper.cdc.MustUnmarshalJSON(res, &holding)
+	require.True(t, holding.Limited)
+	require.Equal(t, sdk.NewInt(40), holding.Balance)
+	require.Equal(t, sdk.NewInt(60), holding.Remaining)
+
+	// the owner isn't limited
+	res, err = querier(ctx, []string{QueryHolding, "zap123", owner.String()}, abci.RequestQuery{})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(res, &holding)
+	require.False(t, holding.Limited)
+
+	_, err = querier(ctx, []string{QueryHolders, "abc123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+}
+
+func TestQueryRedenominations(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "zap123", true))
+	_, err := keeper.Redenominate(ctx, owner, "zap123", 2, 1)
+	require.Nil(t, err)
+
+	res, err := querier(ctx, []string{QueryRedenominations, "ZAP-123"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var out types.QueryResultRedenominations
+	keeper.cdc.MustUnmarshalJSON(res, &out)
+	require.Len(t, out, 1)
+	require.Equal(t, sdk.NewInt(2000), out[0].SupplyAfter)
+
+	_, err = querier(ctx, []string{QueryRedenominations, "abc123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+}
+
+func TestQueryConversions(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	querier := NewQuerier(keeper)
+	owner := setupConversion(t, ctx, keeper)
+	require.Nil(t, keeper.OpenConversion(ctx, owner, "old123", "new456", 1, 2, ctx.BlockTime().Add(time.Hour)))
+	_, err := keeper.Convert(ctx, owner, "old123", 10)
+	require.Nil(t, err)
+
+	res, err := querier(ctx, []string{QueryConversion, "OLD-123"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var window types.ConversionWindow
+	keeper.cdc.MustUnmarshalJSON(res, &window)
+	require.Equal(t, "new456", window.Target)
+	require.Equal(t, sdk.NewInt(5), window.Issued)
+
+	res, err = querier(ctx, []string{QueryConversions}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var out types.QueryResultConversions
+	keeper.cdc.MustUnmarshalJSON(res, &out)
+	require.Len(t, out, 1)
+
+	_, err = querier(ctx, []string{QueryConversion, "new456"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeConversionDoesNotExist, err.Code())
+}
+
+func TestQuerySymbolsByStatus(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	setupToken(t, ctx, keeper, "zap123", 1000)
+	require.Nil(t, keeper.SetTokenStatus(ctx, owner, "abc123", types.TokenStatusDeprecated))
+
+	symbols := func(path ...string) types.QueryResultSymbol {
+		res, err := querier(ctx, append([]string{QuerySymbols}, path...), abci.RequestQuery{})
+		require.Nil(t, err)
+		var out types.QueryResultSymbol
+		keeper.cdc.MustUnmarshalJSON(res, &out)
+		return out
+	}
+	require.Equal(t, types.QueryResultSymbol{"ABC-123", "ZAP-123"}, symbols())
+	require.Equal(t, types.QueryResultSymbol{"ZAP-123"}, symbols("active"))
+	require.Equal(t, types.QueryResultSymbol{"ABC-123"}, symbols("deprecated"))
+	require.Len(t, symbols("retired"), 0)
+	_, err := querier(ctx, []string{QuerySymbols, "frozen"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeInvalidTokenStatus, err.Code())
+
+	res, err := querier(ctx, []string{QueryTokenStatus, "ABC-123"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var status types.QueryResultTokenStatus
+	keeper.cdc.MustUnmarshalJSON(res, &status)
+	require.Equal(t, types.QueryResultTokenStatus{Symbol: "abc123", Status: types.TokenStatusDeprecated}, status)
+	_, err = querier(ctx, []string{QueryRetiredToken, "abc123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+}
+
+func TestQueryCouncil(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	querier := NewQuerier(keeper)
+	members := setupCouncil(t, ctx, keeper, "abc123", 2)
+	setupCouncil(t, ctx, keeper, "zap123", 2)
+	_, err := keeper.ProposeAction(ctx, members[0], "abc123",
+		types.NewMsgSetTokenStatus(types.CouncilAddress("abc123"), "abc123", types.TokenStatusDeprecated),
+		ctx.BlockTime().Add(time.Hour))
+	require.Nil(t, err)
+
+	res, err := querier(ctx, []string{QueryCouncil, "ABC-123"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var council types.Council
+	keeper.cdc.MustUnmarshalJSON(res, &council)
+	require.Equal(t, members, council.Members)
+
+	res, err = querier(ctx, []string{QueryPendingAction, "1"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var action types.PendingAction
+	keeper.cdc.MustUnmarshalJSON(res, &action)
+	require.Equal(t, types.NewMsgSetTokenStatus(types.CouncilAddress("abc123"), "abc123",
+		types.TokenStatusDeprecated), action.Action)
+
+	actions := func(path ...string) types.QueryResultPendingActions {
+		res, err := querier(ctx, append([]string{QueryPendingActions}, path...), abci.RequestQuery{})
+		require.Nil(t, err)
+		var out types.QueryResultPendingActions
+		keeper.cdc.MustUnmarshalJSON(res, &out)
+		return out
+	}
+	require.Len(t, actions(), 1)
+	require.Len(t, actions("abc123"), 1)
+	require.Len(t, actions("zap123"), 0)
+
+	_, err = querier(ctx, []string{QueryPendingAction, "2"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeActionDoesNotExist, err.Code())
+	_, err = querier(ctx, []string{QueryPendingAction, "one"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+	_, err = querier(ctx, []string{QueryPendingActions, "xyz123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeCouncilDoesNotExist, err.Code())
+}
+
+func TestQueryQueuedActions(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	querier := NewQuerier(keeper)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	setupToken(t, ctx, keeper, "zap123", 1000)
+	require.Nil(t, keeper.SetTimelock(ctx, owner, "abc123", time.Hour))
+	_, err := keeper.QueueAction(ctx, types.NewMsgSetHoldingLimits(owner, "abc123", 10, 0))
+	require.Nil(t, err)
+
+	res, err := querier(ctx, []string{QueryQueuedAction, "1"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var action types.QueuedAction
+	keeper.cdc.MustUnmarshalJSON(res, &action)
+	require.Equal(t, types.NewMsgSetHoldingLimits(owner, "abc123", 10, 0), action.Action)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), action.ETA)
+
+	actions := func(path ...string) types.QueryResultQueuedActions {
+		res, err := querier(ctx, append([]string{QueryQueuedActions}, path...), abci.RequestQuery{})
+		require.Nil(t, err)
+		var out types.QueryResultQueuedActions
+		keeper.cdc.MustUnmarshalJSON(res, &out)
+		return out
+	}
+	require.Len(t, actions(), 1)
+	require.Len(t, actions("ABC-123"), 1)
+	require.Len(t, actions("zap123"), 0)
+
+	_, err = querier(ctx, []string{QueryQueuedAction, "2"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeQueuedActionDoesNotExist, err.Code())
+	_, err = querier(ctx, []string{QueryQueuedActions, "xyz123"}, abci.RequestQuery{})
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+}
+
+func TestQueryGrants(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	querier := NewQuerier(keeper)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	_, _, grantee := types.KeyTestPubAddr()
+	mint := types.MsgMintCoins{}.Type()
+	require.Nil(t, keeper.Grant(ctx, owner, grantee, "abc123", mint, 100, time.Time{}))
+
+	res, err := querier(ctx, []string{QueryGrant, owner.String(), grantee.String(), "ABC-123", mint},
+		abci.RequestQuery{})
+	require.Nil(t, err)
+	var grant types.Grant
+	keeper.cdc.MustUnmarshalJSON(res, &grant)
+	require.Equal(t, int64(100), grant.SpendLimit.Int64())
+
+	grants := func(path ...string) types.QueryResultGrants {
+		res, err := querier(ctx, path, abci.RequestQuery{})
+		require.Nil(t, err)
+		var out types.QueryResultGrants
+		keeper.cdc.MustUnmarshalJSON(res, &out)
+		return out
+	}
+	require.Len(t, grants(QueryGranterGrants, owner.String()), 1)
+	require.Len(t, grants(QueryGranterGrants, grantee.String()), 0)
+	require.Len(t, grants(QueryGranteeGrants, grantee.String()), 1)
+
+	_, err = querier(ctx, []string{QueryGrant, grantee.String(), owner.String(), "abc123", mint}, abci.RequestQuery{})
+	require.Equal(t, types.CodeGrantDoesNotExist, err.Code())
+	_, err = querier(ctx, []string{QueryGranteeGrants, "nonsense"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// AppendRedenomination - adds an entry at the end of the redenomination history of a token
+func (k Keeper) AppendRedenomination(ctx sdk.Context, record types.Redenomination) {
+	n := uint64(len(k.GetTokenRedenominations(ctx, record.Symbol)))
+	ctx.KVStore(k.storeKey).Set(types.RedenominationKey(record.Symbol, n), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// IterateRedenominations - iterates over the redenomination history of all tokens until the callback returns true
+func (k Keeper) IterateRedenominations(ctx sdk.Context, cb func(record types.Redenomination) (stop bool)) {
+	k.iterateRedenominations(ctx, types.RedenominationKeyPrefix, cb)
+}
+
+// GetTokenRedenominations - gets the redenomination history of a token, oldest first
+func (k Keeper) GetTokenRedenominations(ctx sdk.Context, symbol string) []types.Redenomination {
+	records := make([]types.Redenomination, 0)
+	k.iterateRedenominations(ctx, types.TokenRedenominationsPrefix(symbol), func(record types.Redenomination) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+func (k Keeper) iterateRedenominations(ctx sdk.Context, prefix []byte, cb func(record types.Redenomination) bool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.Redenomination
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// Redenominate - multiplies every amount of a token by numerator / denominator on behalf of its owner: the free and
+// frozen balances of all accounts, the supply, vesting grants, allowances, grants, the emission schedule, the transfer
+// fee and the balance limit. Amounts are rounded down one by one and the dust left over is paid to the owner. Coins
+// held by the module accounts of other modules, eg collected fees, are left as they are. The token must be paused, so
+// no transfer signed against the old amounts lands after them. Tokens with claim campaigns can't be redenominated, the
+// claims are committed to in the campaigns' merkle roots, nor can tokens of an open conversion window, whose ratio
+// would no longer hold, or with pending council actions or queued actions, whose amounts would no longer mean the same
+func (k Keeper) Redenominate(ctx sdk.Context, owner sdk.AccAddress, symbol string, numerator,
+	denominator uint64) (types.Redenomination, sdk.Error) {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return types.Redenomination{}, err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.Redenomination{}, types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	if err := types.ValidateRedenominationRatio(numerator, denominator); err != nil {
+		return types.Redenomination{}, err
+	}
+	if !token.Paused {
+		return types.Redenomination{}, types.ErrInvalidRedenomination(k.codespace,
+			fmt.Sprintf("token '%s' must be paused before it is redenominated", symbol))
+	}
+	if pending := k.GetTokenPendingActions(ctx, symbol); len(pending) > 0 {
+		return types.Redenomination{}, types.ErrInvalidRedenomination(k.codespace,
+			fmt.Sprintf("token '%s' has %d pending council actions, wait for them to run or expire", symbol,
+				len(pending)))
+	}
+	if queued := k.GetTokenQueuedActions(ctx, symbol); len(queued) > 0 {
+		return types.Redenomination{}, types.ErrInvalidRedenomination(k.codespace,
+			fmt.Sprintf("token '%s' has %d queued actions, wait for them to run or cancel them", symbol, len(queued)))
+	}
+	if campaigns := k.countClaimCampaigns(ctx, symbol); campaigns > 0 {
+		return types.Redenomination{}, types.ErrInvalidRedenomination(k.codespace,
+			fmt.Sprintf("token '%s' has %d claim campaigns, wait for them to end", symbol, campaigns))
+	}
+	if k.hasOpenConversion(ctx, symbol) {
+		return types.Redenomination{}, types.ErrInvalidRedenomination(k.codespace,
+			fmt.Sprintf("token '%s' is part of an open conversion window, wait for it to close", symbol))
+	}
+	scale := func(amount sdk.Int) sdk.Int {
+		return types.ScaleAmount(amount, numerator, denominator)
+	}
+
+	// the coins other modules hold in escrow keep their amount, those modules keep their own records of them
+	var holders []sdk.AccAddress
+	escrowed := sdk.ZeroInt()
+	k.AccountKeeper.IterateAccounts(ctx, func(account auth.Account) bool {
+		if module, ok := account.(exported.ModuleAccountI); ok && !isPoolName(module.GetName()) {
+			escrowed = escrowed.Add(account.GetCoins().AmountOf(symbol))
+			return false
+		}
+		if account.GetCoins().AmountOf(symbol).IsPositive() || frozenCoins(account).AmountOf(symbol).IsPositive() {
+			holders = append(holders, account.GetAddress())
+		}
+		return false
+	})
+
+	record := types.Redenomination{
+		Symbol:       symbol,
+		Owner:        owner,
+		Numerator:    numerator,
+		Denominator:  denominator,
+		SupplyBefore: token.TotalSupply.AmountOf(symbol),
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+	}
+	record.SupplyAfter = scale(record.SupplyBefore.Sub(escrowed)).Add(escrowed)
+	if !record.SupplyAfter.IsInt64() {
+		return types.Redenomination{}, types.ErrInvalidRedenomination(k.codespace,
+			fmt.Sprintf("the supply of %s%s would grow past %d", record.SupplyAfter, symbol, int64(math.MaxInt64)))
+	}
+
+	// the pools hold exactly what their records add up to, they are rebalanced from the rescaled records
+	vestingPool := supply.NewModuleAddress(types.VestingPoolName)
+	frozenPool := supply.NewModuleAddress(types.FrozenPoolName)
+	vested := k.redenominateVestingGrants(ctx, symbol, scale)
+
+	// the frozen pool mirrors the frozen balances, it isn't part of the supply
+	held, frozen := escrowed, sdk.ZeroInt()
+	for _, address := range holders {
+		if address.Equals(frozenPool) {
+			continue
+		}
+		account := k.AccountKeeper.GetAccount(ctx, address)
+		free := scale(account.GetCoins().AmountOf(symbol))
+		if address.Equals(vestingPool) {
+			free = vested
+		}
+		if err := account.SetCoins(withAmount(account.GetCoins(), symbol, free)); err != nil {
+			panic(fmt.Sprintf("failed to redenominate %s of %s: %s", symbol, address, err))
+		}
+		k.AccountKeeper.SetAccount(ctx, account)
+		held = held.Add(free)
+
+		if amount := frozenCoins(account).AmountOf(symbol); amount.IsPositive() {
+			amount = scale(amount)
+			if err := k.SetFrozenCoins(ctx, address, withAmount(frozenCoins(account), symbol, amount)); err != nil {
+				panic(fmt.Sprintf("failed to redenominate %s frozen by %s: %s", symbol, address, err))
+			}
+			frozen = frozen.Add(amount)
+		}
+	}
+	if pool := k.AccountKeeper.GetAccount(ctx, frozenPool); pool != nil {
+		if err := pool.SetCoins(withAmount(pool.GetCoins(), symbol, frozen)); err != nil {
+			panic(fmt.Sprintf("failed to redenominate %s in the frozen pool: %s", symbol, err))
+		}
+		k.AccountKeeper.SetAccount(ctx, pool)
+	}
+
+	record.Dust = record.SupplyAfter.Sub(held).Sub(frozen)
+	if record.Dust.IsPositive() {
+		if _, err := k.CoinKeeper.AddCoins(ctx, owner, sdk.NewCoins(sdk.NewCoin(symbol, record.Dust))); err != nil {
+			panic(fmt.Sprintf("failed to pay the redenomination dust of %s to %s: %s", symbol, owner, err))
+		}
+	}
+	k.UpdateHolders(ctx, sdk.NewCoins(sdk.NewInt64Coin(symbol, 1)), append(holders, owner)...)
+
+	total := k.SupplyKeeper.GetSupply(ctx)
+	total = total.Deflate(sdk.NewCoins(sdk.NewCoin(symbol, record.SupplyBefore)))
+	total = total.Inflate(sdk.NewCoins(sdk.NewCoin(symbol, record.SupplyAfter)))
+	k.SupplyKeeper.SetSupply(ctx, total)
+
+	token.TotalSupply = sdk.NewCoins(sdk.NewCoin(symbol, record.SupplyAfter))
+	if token.MaxBalancePerAccount > 0 {
+		token.MaxBalancePerAccount = scaleLimit(sdk.NewInt(token.MaxBalancePerAccount), scale).Int64()
+	}
+	if err := k.SetToken(ctx, symbol, token); err != nil {
+		return types.Redenomination{}, err
+	}
+	k.redenominateAllowances(ctx, symbol, scale)
+	k.redenominateGrants(ctx, symbol, scale)
+	k.redenominateEmission(ctx, symbol, scale)
+	k.redenominateTransferFee(ctx, symbol, scale)
+
+	k.AppendRedenomination(ctx, record)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRedenominate,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyRatio, fmt.Sprintf("%d/%d", numerator, denominator)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, record.SupplyAfter.String()),
+		sdk.NewAttribute(types.AttributeKeyDust, record.Dust.String()),
+	))
+	return record, nil
+}
+
+// isPoolName tells whether a module account holds coins on behalf of the records of this module
+func isPoolName(name string) bool {
+	return name == types.ModuleName || name == types.FrozenPoolName || name == types.ClaimsPoolName ||
+		name == types.VestingPoolName
+}
+
+// redenominateVestingGrants rescales the total and the locked coins of the grants of a token, what was released
+// follows from them. Grants left with nothing are removed. Returns the coins locked across the rescaled grants
+func (k Keeper) redenominateVestingGrants(ctx sdk.Context, symbol string, scale func(sdk.Int) sdk.Int) sdk.Int {
+	locked := sdk.ZeroInt()
+	for _, grant := range k.GetTokenVestingGrants(ctx, symbol) {
+		total := scale(grant.Total.AmountOf(symbol))
+		if !total.IsPositive() {
+			k.deleteVestingGrant(ctx, grant)
+			continue
+		}
+		remaining := scale(grant.Locked().AmountOf(symbol))
+		grant.Total = sdk.NewCoins(sdk.NewCoin(symbol, total))
+		grant.Released = sdk.NewCoins(sdk.NewCoin(symbol, total.Sub(remaining)))
+		k.SetVestingGrant(ctx, grant)
+		locked = locked.Add(remaining)
+	}
+	return locked
+}
+
+// redenominateAllowances rescales the allowances of a token, removing those left with nothing
+func (k Keeper) redenominateAllowances(ctx sdk.Context, symbol string, scale func(sdk.Int) sdk.Int) {
+	var allowances []types.Allowance
+	k.IterateAllowances(ctx, func(allowance types.Allowance) bool {
+		if allowance.Symbol == symbol {
+			allowances = append(allowances, allowance)
+		}
+		return false
+	})
+	for _, allowance := range allowances {
+		allowance.Amount = scale(allowance.Amount)
+		if !allowance.Amount.IsPositive() {
+			k.deleteAllowance(ctx, allowance.Owner, allowance.Spender, symbol)
+			continue
+		}
+		k.SetAllowance(ctx, allowance)
+	}
+}
+
+// redenominateGrants rescales the spend limits of the grants over a token, a limit rounded down to nothing is used up
+func (k Keeper) redenominateGrants(ctx sdk.Context, symbol string, scale func(sdk.Int) sdk.Int) {
+	var grants []types.Grant
+	k.IterateGrants(ctx, func(grant types.Grant) bool {
+		if grant.Symbol == symbol && grant.IsLimited() {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+	for _, grant := range grants {
+		grant.SpendLimit = scale(grant.SpendLimit)
+		if !grant.SpendLimit.IsPositive() {
+			k.deleteGrant(ctx, grant)
+			continue
+		}
+		k.SetGrant(ctx, grant)
+	}
+}
+
+// redenominateEmission rescales the emission schedule of a token, a rate rounded down to nothing ends it
+func (k Keeper) redenominateEmission(ctx sdk.Context, symbol string, scale func(sdk.Int) sdk.Int) {
+	emission, err := k.GetEmission(ctx, symbol)
+	if err != nil {
+		return
+	}
+	emission.Rate = scale(emission.Rate)
+	if !emission.Rate.IsPositive() {
+		k.deleteEmission(ctx, symbol)
+		return
+	}
+	if emission.MaxSupply.IsPositive() {
+		emission.MaxSupply = scaleLimit(emission.MaxSupply, scale)
+	}
+	emission.Emitted = scale(emission.Emitted)
+	k.SetEmission(ctx, emission)
+}
+
+// redenominateTransferFee rescales the fee bounds and the fees collected of a token
+func (k Keeper) redenominateTransferFee(ctx sdk.Context, symbol string, scale func(sdk.Int) sdk.Int) {
+	fee, err := k.GetTransferFee(ctx, symbol)
+	if err != nil {
+		return
+	}
+	fee.MinFee = scale(fee.MinFee)
+	if fee.MaxFee.IsPositive() {
+		fee.MaxFee = scaleLimit(fee.MaxFee, scale)
+	}
+	fee.Collected = scale(fee.Collected)
+	k.SetTransferFee(ctx, fee)
+}
+
+// scaleLimit rescales a positive limit, keeping it positive and within an int64 since zero would lift it
+func scaleLimit(limit sdk.Int, scale func(sdk.Int) sdk.Int) sdk.Int {
+	limit = sdk.MaxInt(scale(limit), sdk.OneInt())
+	if !limit.IsInt64() {
+		return sdk.NewInt(math.MaxInt64)
+	}
+	return limit
+}
+
+// withAmount returns the coins with the amount of one denomination replaced
+func withAmount(coins sdk.Coins, denom string, amount sdk.Int) sdk.Coins {
+	replaced := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	for _, coin := range coins {
+		if coin.Denom != denom {
+			replaced = replaced.Add(sdk.NewCoins(coin))
+		}
+	}
+	return replaced
+}
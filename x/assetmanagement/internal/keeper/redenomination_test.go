@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestRedenominate(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	_, _, carol := types.KeyTestPubAddr()
+	_, _, collector := types.KeyTestPubAddr()
+
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{
+		types.NewRecipient(alice, 333),
+		types.NewRecipient(bob, 207),
+	}))
+	require.Nil(t, keeper.FreezeCoins(ctx, bob, types.NewTestCoins("zap123", 107)))
+	id, err := keeper.CreateVestingGrant(ctx, owner, carol, "zap123", 155, start, start.Add(time.Hour),
+		start.Add(2*time.Hour))
+	require.Nil(t, err)
+	require.Nil(t, keeper.Approve(ctx, alice, bob, "zap123", 55, time.Time{}))
+	require.Nil(t, keeper.ConfigureTransferFee(ctx, owner, "zap123", 25, 15, 1000, collector))
+	require.Nil(t, keeper.SetHoldingLimits(ctx, owner, "zap123", 995, 0))
+	require.Nil(t, keeper.Grant(ctx, owner, alice, "zap123", types.MsgMintCoins{}.Type(), 155, time.Time{}))
+	require.Nil(t, keeper.Grant(ctx, bob, alice, "zap123", types.MsgBurnCoins{}.Type(), 5, time.Time{}))
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "zap123", true))
+
+	record, err := keeper.Redenominate(ctx, owner, "zap123", 1, 10)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(1000), record.SupplyBefore)
+	require.Equal(t, sdk.NewInt(100), record.SupplyAfter)
+	// 305, 333, 100 and 107 frozen and 155 vesting lose 5, 3, 0, 7 and 5 tenths
+	require.Equal(t, sdk.NewInt(2), record.Dust)
+
+	require.Equal(t, sdk.NewInt(32), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(33), keeper.CoinKeeper.GetCoins(ctx, alice).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(10), keeper.CoinKeeper.GetCoins(ctx, bob).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(10), keeper.GetFrozenCoins(ctx, bob).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(100), keeper.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf("zap123"))
+
+	grant, err := keeper.GetVestingGrant(ctx, id)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(15), grant.Total.AmountOf("zap123"))
+	allowance, err := keeper.GetAllowance(ctx, alice, bob, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(5), allowance.Amount)
+	mintGrant, err := keeper.GetGrant(ctx, owner, alice, "zap123", types.MsgMintCoins{}.Type())
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(15), mintGrant.SpendLimit)
+	_, err = keeper.GetGrant(ctx, bob, alice, "zap123", types.MsgBurnCoins{}.Type())
+	require.Equal(t, types.CodeGrantDoesNotExist, err.Code())
+	fee, err := keeper.GetTransferFee(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(1), fee.MinFee)
+	require.Equal(t, sdk.NewInt(100), fee.MaxFee)
+	token, err := keeper.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, int64(99), token.MaxBalancePerAccount)
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// the split back rounds nothing off
+	record, err = keeper.Redenominate(ctx, owner, "zap123", 3, 1)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(300), record.SupplyAfter)
+	require.True(t, record.Dust.IsZero())
+	require.Equal(t, sdk.NewInt(30), keeper.GetFrozenCoins(ctx, bob).AmountOf("zap123"))
+	_, broken = AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	history := keeper.GetTokenRedenominations(ctx, "zap123")
+	require.Len(t, history, 2)
+	require.Equal(t, uint64(10), history[0].Denominator)
+	require.Equal(t, uint64(3), history[1].Numerator)
+}
+
+func TestRedenominateRejected(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, stranger := types.KeyTestPubAddr()
+
+	_, err := keeper.Redenominate(ctx, stranger, "zap123", 2, 1)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	_, err = keeper.Redenominate(ctx, owner, "zap123", 2, 2)
+	require.Equal(t, types.CodeInvalidRedenomination, err.Code())
+	_, err = keeper.Redenominate(ctx, owner, "abc123", 2, 1)
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+	_, err = keeper.Redenominate(ctx, owner, "zap123", 2, 1)
+	require.Equal(t, types.CodeInvalidRedenomination, err.Code())
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "zap123", true))
+	_, err = keeper.Redenominate(ctx, owner, "zap123", types.MaxRedenominationFactor, 1)
+	require.Nil(t, err)
+	_, err = keeper.Redenominate(ctx, owner, "zap123", types.MaxRedenominationFactor, 1)
+	require.Equal(t, types.CodeInvalidRedenomination, err.Code())
+
+	// actions queued at the current denomination have to run or be cancelled first
+	require.Nil(t, keeper.SetTimelock(ctx, owner, "zap123", time.Hour))
+	queued, err := keeper.QueueAction(ctx, types.NewMsgSetHoldingLimits(owner, "zap123", 10, 0))
+	require.Nil(t, err)
+	_, err = keeper.Redenominate(ctx, owner, "zap123", 1, 2)
+	require.Equal(t, types.CodeInvalidRedenomination, err.Code())
+	require.Nil(t, keeper.CancelQueuedAction(ctx, owner, queued.ID))
+
+	// claims are committed to at the current denomination
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "zap123", false))
+	root, _ := types.BuildClaimTree([][]byte{types.ClaimLeaf(stranger, 100)})
+	_, err = keeper.CreateClaimCampaign(ctx, owner, "zap123", hex.EncodeToString(root), 100, start.Add(time.Hour))
+	require.Nil(t, err)
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "zap123", true))
+	_, err = keeper.Redenominate(ctx, owner, "zap123", 1, 2)
+	require.Equal(t, types.CodeInvalidRedenomination, err.Code())
+	require.Len(t, keeper.GetTokenRedenominations(ctx, "zap123"), 1)
+
+	// so are the actions pending before a council
+	members := setupCouncil(t, ctx, keeper, "abc123", 2)
+	council := types.CouncilAddress("abc123")
+	require.Nil(t, keeper.SetTokenPaused(ctx, council, "abc123", true))
+	_, err = keeper.ProposeAction(ctx, members[0], "abc123", types.NewMsgSetHoldingLimits(council, "abc123", 10, 0),
+		start.Add(time.Hour))
+	require.Nil(t, err)
+	_, err = keeper.Redenominate(ctx, council, "abc123", 1, 2)
+	require.Equal(t, types.CodeInvalidRedenomination, err.Code())
+}
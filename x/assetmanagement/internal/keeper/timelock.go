@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetNextQueuedActionID - gets the ID the next queued action will get
+func (k Keeper) GetNextQueuedActionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextQueuedActionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextQueuedActionID - sets the ID the next queued action will get
+func (k Keeper) SetNextQueuedActionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextQueuedActionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetQueuedAction - gets a queued action by ID
+func (k Keeper) GetQueuedAction(ctx sdk.Context, id uint64) (types.QueuedAction, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.QueuedActionKey(id))
+	if bz == nil {
+		return types.QueuedAction{}, types.ErrQueuedActionDoesNotExist(k.codespace, id)
+	}
+	var action types.QueuedAction
+	k.cdc.MustUnmarshalBinaryBare(bz, &action)
+	return action, nil
+}
+
+// SetQueuedAction - stores a queued action and queues it to run at its ETA
+func (k Keeper) SetQueuedAction(ctx sdk.Context, action types.QueuedAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.QueuedActionKey(action.ID), k.cdc.MustMarshalBinaryBare(action))
+	store.Set(types.TimelockQueueKey(action.ETA, action.ID), sdk.Uint64ToBigEndian(action.ID))
+}
+
+// DeleteQueuedAction - removes a queued action along with its queue entry
+func (k Keeper) DeleteQueuedAction(ctx sdk.Context, action types.QueuedAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.QueuedActionKey(action.ID))
+	store.Delete(types.TimelockQueueKey(action.ETA, action.ID))
+}
+
+// IterateQueuedActions - iterates over all queued actions in ID order until the callback returns true
+func (k Keeper) IterateQueuedActions(ctx sdk.Context, cb func(action types.QueuedAction) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedActionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.QueuedAction
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &action)
+		if cb(action) {
+			break
+		}
+	}
+}
+
+// GetTokenQueuedActions - gets the queued actions of a token
+func (k Keeper) GetTokenQueuedActions(ctx sdk.Context, symbol string) []types.QueuedAction {
+	var actions []types.QueuedAction
+	k.IterateQueuedActions(ctx, func(action types.QueuedAction) bool {
+		if action.Symbol == symbol {
+			actions = append(actions, action)
+		}
+		return false
+	})
+	return actions
+}
+
+// deleteTokenQueuedActions drops the queued actions of a token, eg once the owner who queued them no longer owns it
+func (k Keeper) deleteTokenQueuedActions(ctx sdk.Context, symbol string) {
+	for _, action := range k.GetTokenQueuedActions(ctx, symbol) {
+		k.DeleteQueuedAction(ctx, action)
+	}
+}
+
+// GetDueQueuedActions - gets the queued actions whose ETA has been reached, in ETA order
+func (k Keeper) GetDueQueuedActions(ctx sdk.Context) []types.QueuedAction {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.TimelockQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.TimelockQueueTimeKey(ctx.BlockTime())))
+	defer iterator.Close()
+	var actions []types.QueuedAction
+	for ; iterator.Valid(); iterator.Next() {
+		id := binary.BigEndian.Uint64(iterator.Value())
+		action, err := k.GetQueuedAction(ctx, id)
+		if err != nil {
+			panic(fmt.Sprintf("queued action %d is missing", id))
+		}
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// SetTimelock - sets how long the rule changes of a token are held back on behalf of its owner, 0 turns the timelock
+// off. The actions queued already keep their ETA
+func (k Keeper) SetTimelock(ctx sdk.Context, owner sdk.AccAddress, symbol string, delay time.Duration) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	if err := types.ValidateTimelockDelay(delay); err != nil {
+		return err
+	}
+
+	token.Timelock = delay
+	if err := k.SetToken(ctx, symbol, token); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetTimelock,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyDelay, delay.String()),
+	))
+	return nil
+}
+
+// IsTimelocked - tells whether a message is a rule change the timelock of its token holds back, which it is when the
+// owner of the token signs it. Turning the timelock on or lengthening it never is
+func (k Keeper) IsTimelocked(ctx sdk.Context, msg sdk.Msg) bool {
+	symbol, signer, ok := types.TimelockedSymbol(msg)
+	if !ok {
+		return false
+	}
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil || token.Timelock == 0 || !signer.Equals(token.Owner) {
+		return false
+	}
+	if msg, ok := msg.(types.MsgSetTimelock); ok {
+		return msg.Delay < token.Timelock
+	}
+	return true
+}
+
+// QueueAction - queues a rule change of a timelocked token on behalf of its owner, it runs once the delay of the
+// timelock has passed
+func (k Keeper) QueueAction(ctx sdk.Context, msg sdk.Msg) (types.QueuedAction, sdk.Error) {
+	symbol, owner, ok := types.TimelockedSymbol(msg)
+	if !ok {
+		return types.QueuedAction{}, types.ErrInvalidTimelock(k.codespace,
+			fmt.Sprintf("a %s message isn't held back by timelocks", msg.Type()))
+	}
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return types.QueuedAction{}, err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.QueuedAction{}, types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+
+	id := k.GetNextQueuedActionID(ctx)
+	k.SetNextQueuedActionID(ctx, id+1)
+	action := types.NewQueuedAction(id, symbol, owner, msg, ctx.BlockTime(), ctx.BlockTime().Add(token.Timelock))
+	k.SetQueuedAction(ctx, action)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeQueueAction,
+		sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyAction, msg.Type()),
+		sdk.NewAttribute(types.AttributeKeyETA, action.ETA.Format(time.RFC3339)),
+	))
+	return action, nil
+}
+
+// CancelQueuedAction - cancels a queued action before it runs on behalf of the owner of its token
+func (k Keeper) CancelQueuedAction(ctx sdk.Context, owner sdk.AccAddress, id uint64) sdk.Error {
+	action, err := k.GetQueuedAction(ctx, id)
+	if err != nil {
+		return err
+	}
+	token, err := k.GetToken(ctx, action.Symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, action.Symbol)
+	}
+
+	k.DeleteQueuedAction(ctx, action)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelAction,
+		sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeySymbol, action.Symbol),
+	))
+	return nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestSetTimelock(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	_, _, stranger := types.KeyTestPubAddr()
+
+	err := keeper.SetTimelock(ctx, stranger, "abc123", time.Hour)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	err = keeper.SetTimelock(ctx, owner, "abc123", types.MaxTimelockDelay+time.Second)
+	require.Equal(t, types.CodeInvalidTimelock, err.Code())
+	err = keeper.SetTimelock(ctx, owner, "xyz123", time.Hour)
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+
+	require.Nil(t, keeper.SetTimelock(ctx, owner, "abc123", 2*time.Hour))
+	token, _ := keeper.GetToken(ctx, "abc123")
+	require.Equal(t, 2*time.Hour, token.Timelock)
+
+	// rule changes are held back, lengthening the timelock or moving coins isn't
+	require.True(t, keeper.IsTimelocked(ctx, types.NewMsgSetHoldingLimits(owner, "abc123", 10, 0)))
+	require.True(t, keeper.IsTimelocked(ctx, types.NewMsgSetTimelock(owner, "abc123", time.Hour)))
+	require.False(t, keeper.IsTimelocked(ctx, types.NewMsgSetTimelock(owner, "abc123", 3*time.Hour)))
+	require.False(t, keeper.IsTimelocked(ctx, types.NewMsgMintCoins(100, "abc123", owner)))
+	require.False(t, keeper.IsTimelocked(ctx, types.NewMsgSetHoldingLimits(owner, "xyz123", 10, 0)))
+
+	// so are the owner's grants, handovers and renames, holders granting over their own coins aren't
+	_, _, holder := types.KeyTestPubAddr()
+	expiry := ctx.BlockTime().Add(time.Hour)
+	require.True(t, keeper.IsTimelocked(ctx, types.NewMsgGrant(owner, stranger, "abc123", "mint", 0, expiry)))
+	require.False(t, keeper.IsTimelocked(ctx, types.NewMsgGrant(holder, stranger, "abc123", "mint", 0, expiry)))
+	require.False(t, keeper.IsTimelocked(ctx, types.NewMsgRevokeGrant(owner, stranger, "abc123", "mint")))
+	require.True(t, keeper.IsTimelocked(ctx, types.NewMsgTransferOwnership(owner, "abc123", stranger)))
+	require.True(t, keeper.IsTimelocked(ctx, types.NewMsgSetTokenMetadata(owner, "abc123", "Xyz", "XYZ")))
+}
+
+func TestQueuedActions(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	_, _, stranger := types.KeyTestPubAddr()
+	require.Nil(t, keeper.SetTimelock(ctx, owner, "abc123", time.Hour))
+
+	_, err := keeper.QueueAction(ctx, types.NewMsgSetHoldingLimits(stranger, "abc123", 10, 0))
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	_, err = keeper.QueueAction(ctx, types.NewMsgMintCoins(100, "abc123", owner))
+	require.Equal(t, types.CodeInvalidTimelock, err.Code())
+
+	first, err := keeper.QueueAction(ctx, types.NewMsgSetHoldingLimits(owner, "abc123", 10, 0))
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), first.ID)
+	require.Equal(t, now.Add(time.Hour), first.ETA)
+	second, err := keeper.QueueAction(ctx.WithBlockTime(now.Add(time.Minute)),
+		types.NewMsgSetHoldingLimits(owner, "abc123", 20, 0))
+	require.Nil(t, err)
+	require.Len(t, keeper.GetTokenQueuedActions(ctx, "abc123"), 2)
+
+	require.Empty(t, keeper.GetDueQueuedActions(ctx.WithBlockTime(now.Add(59*time.Minute))))
+	due := keeper.GetDueQueuedActions(ctx.WithBlockTime(now.Add(time.Hour)))
+	require.Len(t, due, 1)
+	require.Equal(t, first.ID, due[0].ID)
+
+	err = keeper.CancelQueuedAction(ctx, stranger, second.ID)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	require.Nil(t, keeper.CancelQueuedAction(ctx, owner, second.ID))
+	_, err = keeper.GetQueuedAction(ctx, second.ID)
+	require.Equal(t, types.CodeQueuedActionDoesNotExist, err.Code())
+	require.Len(t, keeper.GetDueQueuedActions(ctx.WithBlockTime(now.Add(2*time.Hour))), 1)
+
+	// handing the token over to a council drops what its previous owner queued
+	require.Nil(t, keeper.SetTokenCouncil(ctx, owner, "abc123", []sdk.AccAddress{stranger}, 1))
+	require.Empty(t, keeper.GetTokenQueuedActions(ctx, "abc123"))
+	require.Empty(t, keeper.GetDueQueuedActions(ctx.WithBlockTime(now.Add(2*time.Hour))))
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetArchivedToken - gets the archived record of a retired token
+func (k Keeper) GetArchivedToken(ctx sdk.Context, symbol string) (types.ArchivedToken, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ArchivedTokenKey(symbol))
+	if bz == nil {
+		return types.ArchivedToken{}, types.ErrTokenSymbolDoesNotExist(k.codespace, symbol)
+	}
+	var archived types.ArchivedToken
+	k.cdc.MustUnmarshalBinaryBare(bz, &archived)
+	return archived, nil
+}
+
+// SetArchivedToken - stores the archived record of a retired token
+func (k Keeper) SetArchivedToken(ctx sdk.Context, archived types.ArchivedToken) {
+	ctx.KVStore(k.storeKey).Set(types.ArchivedTokenKey(archived.Token.Symbol), k.cdc.MustMarshalBinaryBare(archived))
+}
+
+// IsSymbolRetired - tells whether a symbol belonged to a token that was retired, such a symbol can't be issued again
+func (k Keeper) IsSymbolRetired(ctx sdk.Context, symbol string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ArchivedTokenKey(symbol))
+}
+
+// IterateArchivedTokens - iterates over the archived records of retired tokens in symbol order until the callback
+// returns true
+func (k Keeper) IterateArchivedTokens(ctx sdk.Context, cb func(archived types.ArchivedToken) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ArchivedTokenKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var archived types.ArchivedToken
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &archived)
+		if cb(archived) {
+			break
+		}
+	}
+}
+
+// GetTokenStatus - gets the lifecycle status of a token, live or retired
+func (k Keeper) GetTokenStatus(ctx sdk.Context, symbol string) (types.TokenStatus, sdk.Error) {
+	if token, err := k.GetToken(ctx, symbol); err == nil {
+		status, _ := types.TokenStatusFromString(string(token.Status))
+		return status, nil
+	}
+	if k.IsSymbolRetired(ctx, symbol) {
+		return types.TokenStatusRetired, nil
+	}
+	return "", types.ErrTokenSymbolDoesNotExist(k.codespace, symbol)
+}
+
+// SetTokenStatus - moves a token forward in its lifecycle on behalf of its owner. Deprecating a token, once its
+// vesting grants are released, ends its emission, retiring it removes it from the store and keeps an archived record
+// in its place
+func (k Keeper) SetTokenStatus(ctx sdk.Context, owner sdk.AccAddress, symbol string, status types.TokenStatus) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	if !token.Status.CanMoveTo(status) {
+		current, _ := types.TokenStatusFromString(string(token.Status))
+		return types.ErrInvalidTokenStatus(k.codespace,
+			fmt.Sprintf("token '%s' can't go from %s to %s", symbol, current, status))
+	}
+
+	switch status {
+	case types.TokenStatusDeprecated:
+		// a deprecated token gets no new holders, the beneficiaries of locked grants couldn't release them anymore
+		if grants := k.GetTokenVestingGrants(ctx, symbol); len(grants) > 0 {
+			return types.ErrInvalidTokenStatus(k.codespace,
+				fmt.Sprintf("token '%s' has %d vesting grants, wait for them to be released", symbol, len(grants)))
+		}
+		token.Status = status
+		if err := k.SetToken(ctx, symbol, token); err != nil {
+			return err
+		}
+		k.deleteEmission(ctx, symbol)
+	case types.TokenStatusRetired:
+		if err := k.retireToken(ctx, token); err != nil {
+			return err
+		}
+	default:
+		return types.ErrInvalidTokenStatus(k.codespace, fmt.Sprintf("unknown token status '%s'", status))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTokenStatus,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyStatus, string(status)),
+	))
+	return nil
+}
+
+// retireToken removes a token without supply along with the records that only make sense while it exists, its
+// council, queued actions and grants among them. The history records of the token are kept
+func (k Keeper) retireToken(ctx sdk.Context, token *types.Token) sdk.Error {
+	symbol := token.Symbol
+	if supply := token.TotalSupply.AmountOf(symbol); !supply.IsZero() {
+		return types.ErrInvalidTokenStatus(k.codespace,
+			fmt.Sprintf("token '%s' still has a supply of %s, it can only be retired once it is all burnt", symbol,
+				supply))
+	}
+	if k.hasOpenConversion(ctx, symbol) {
+		return types.ErrInvalidTokenStatus(k.codespace,
+			fmt.Sprintf("token '%s' is part of an open conversion window, wait for it to close", symbol))
+	}
+	if campaigns := k.countClaimCampaigns(ctx, symbol); campaigns > 0 {
+		return types.ErrInvalidTokenStatus(k.codespace,
+			fmt.Sprintf("token '%s' has %d claim campaigns, wait for them to end", symbol, campaigns))
+	}
+	if grants := k.GetTokenVestingGrants(ctx, symbol); len(grants) > 0 {
+		return types.ErrInvalidTokenStatus(k.codespace,
+			fmt.Sprintf("token '%s' has %d vesting grants", symbol, len(grants)))
+	}
+
+	var allowances []types.Allowance
+	k.IterateAllowances(ctx, func(allowance types.Allowance) bool {
+		if allowance.Symbol == symbol {
+			allowances = append(allowances, allowance)
+		}
+		return false
+	})
+	for _, allowance := range allowances {
+		k.deleteAllowance(ctx, allowance.Owner, allowance.Spender, symbol)
+	}
+	k.deleteEmission(ctx, symbol)
+	k.deleteTokenPendingActions(ctx, symbol)
+	k.deleteTokenQueuedActions(ctx, symbol)
+	k.deleteTokenGrants(ctx, symbol)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TransferFeeKey(symbol))
+	store.Delete(types.CouncilKey(symbol))
+	k.DeleteToken(ctx, symbol)
+
+	token.Status = types.TokenStatusRetired
+	k.SetArchivedToken(ctx, types.ArchivedToken{Token: *token, Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	return nil
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestDeprecateToken(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	owner := setupConversion(t, ctx, keeper)
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "new456", []types.Recipient{types.NewRecipient(alice, 5)}))
+	require.Nil(t, keeper.ScheduleEmission(ctx, owner, types.NewEmissionSchedule("new456", owner, 10, 1, 20, 0,
+		sdk.ZeroDec(), 0)))
+
+	err := keeper.SetTokenStatus(ctx, alice, "new456", types.TokenStatusDeprecated)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	require.Nil(t, keeper.SetTokenStatus(ctx, owner, "new456", types.TokenStatusDeprecated))
+	status, err := keeper.GetTokenStatus(ctx, "new456")
+	require.Nil(t, err)
+	require.Equal(t, types.TokenStatusDeprecated, status)
+	_, err = keeper.GetEmission(ctx, "new456")
+	require.Equal(t, types.CodeEmissionDoesNotExist, err.Code())
+
+	// existing holders and the owner can still receive it, new holders can't
+	require.Nil(t, keeper.CheckTransferMode(ctx, owner, alice, types.NewTestCoins("new456", 1)))
+	require.Nil(t, keeper.CheckTransferMode(ctx, alice, owner, types.NewTestCoins("new456", 1)))
+	err = keeper.CheckTransferMode(ctx, alice, bob, types.NewTestCoins("new456", 1))
+	require.Equal(t, types.CodeTokenDeprecated, err.Code())
+	err = keeper.DistributeCoins(ctx, owner, "new456", []types.Recipient{types.NewRecipient(bob, 1)})
+	require.Equal(t, types.CodeTokenDeprecated, err.Code())
+
+	err = keeper.ScheduleEmission(ctx, owner, types.NewEmissionSchedule("new456", owner, 10, 1, 20, 0,
+		sdk.ZeroDec(), 0))
+	require.Equal(t, types.CodeTokenDeprecated, err.Code())
+	_, err = keeper.CreateVestingGrant(ctx, owner, bob, "new456", 1, ctx.BlockTime(), ctx.BlockTime(),
+		ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, types.CodeTokenDeprecated, err.Code())
+	err = keeper.OpenConversion(ctx, owner, "old123", "new456", 1, 1, ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, types.CodeTokenDeprecated, err.Code())
+
+	// statuses never go back
+	err = keeper.SetTokenStatus(ctx, owner, "new456", types.TokenStatusDeprecated)
+	require.Equal(t, types.CodeInvalidTokenStatus, err.Code())
+	err = keeper.SetTokenStatus(ctx, owner, "new456", types.TokenStatusActive)
+	require.Equal(t, types.CodeInvalidTokenStatus, err.Code())
+}
+
+func TestRetireToken(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).WithBlockHeight(7)
+	owner := setupToken(t, ctx, keeper, "abc123", 1000)
+	_, _, spender := types.KeyTestPubAddr()
+	require.Nil(t, keeper.Approve(ctx, owner, spender, "abc123", 10, time.Time{}))
+
+	err := keeper.SetTokenStatus(ctx, owner, "abc123", types.TokenStatusRetired)
+	require.Equal(t, types.CodeInvalidTokenStatus, err.Code())
+
+	token, _ := keeper.GetToken(ctx, "abc123")
+	require.Nil(t, keeper.BurnCoins(ctx, owner, token.TotalSupply))
+	require.Nil(t, keeper.SetTotalSupply(ctx, "abc123", sdk.NewCoins()))
+	keeper.UpdateHolders(ctx, token.TotalSupply, owner)
+	require.Nil(t, keeper.SetTokenStatus(ctx, owner, "abc123", types.TokenStatusRetired))
+
+	require.False(t, keeper.IsSymbolPresent(ctx, "abc123"))
+	require.True(t, keeper.IsSymbolRetired(ctx, "abc123"))
+	require.Len(t, keeper.GetOwnerAllowances(ctx, owner), 0)
+	status, err := keeper.GetTokenStatus(ctx, "abc123")
+	require.Nil(t, err)
+	require.Equal(t, types.TokenStatusRetired, status)
+	archived, err := keeper.GetArchivedToken(ctx, "abc123")
+	require.Nil(t, err)
+	require.Equal(t, types.TokenStatusRetired, archived.Token.Status)
+	require.Equal(t, int64(7), archived.Height)
+	require.Equal(t, owner, archived.Token.Owner)
+
+	err = keeper.SetTokenStatus(ctx, owner, "abc123", types.TokenStatusRetired)
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+	_, err = keeper.GetTokenStatus(ctx, "xyz123")
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+}
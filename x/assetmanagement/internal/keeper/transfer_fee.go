@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetTransferFee - gets the transfer fee of a token
+func (k Keeper) GetTransferFee(ctx sdk.Context, symbol string) (types.TransferFee, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferFeeKey(symbol))
+	if bz == nil {
+		return types.TransferFee{}, types.ErrTransferFeeDoesNotExist(k.codespace, symbol)
+	}
+	var fee types.TransferFee
+	k.cdc.MustUnmarshalBinaryBare(bz, &fee)
+	return fee, nil
+}
+
+// SetTransferFee - stores the transfer fee of a token
+func (k Keeper) SetTransferFee(ctx sdk.Context, fee types.TransferFee) {
+	ctx.KVStore(k.storeKey).Set(types.TransferFeeKey(fee.Symbol), k.cdc.MustMarshalBinaryBare(fee))
+}
+
+// IterateTransferFees - iterates over all transfer fees in symbol order until the callback returns true
+func (k Keeper) IterateTransferFees(ctx sdk.Context, cb func(fee types.TransferFee) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TransferFeeKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee types.TransferFee
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fee)
+		if cb(fee) {
+			break
+		}
+	}
+}
+
+// ConfigureTransferFee - sets the fee taken out of every transfer of a token on behalf of its owner. The exemptions
+// and the total collected so far are kept when the fee is changed
+func (k Keeper) ConfigureTransferFee(ctx sdk.Context, owner sdk.AccAddress, symbol string, rate uint64, minFee,
+	maxFee int64, recipient sdk.AccAddress) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	// fees paid into a module account would not be accounted for by the pool it holds
+	if k.CoinKeeper.BlacklistedAddr(recipient) {
+		return types.ErrInvalidTransferFee(k.codespace,
+			fmt.Sprintf("%s is a module account, it can't receive transfer fees", recipient))
+	}
+
+	fee, err := k.GetTransferFee(ctx, symbol)
+	if err != nil {
+		fee = types.NewTransferFee(symbol, rate, minFee, maxFee, recipient)
+	} else {
+		fee.Rate = rate
+		fee.MinFee = sdk.NewInt(minFee)
+		fee.MaxFee = sdk.NewInt(maxFee)
+		fee.Recipient = recipient
+	}
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+	k.SetTransferFee(ctx, fee)
+	return nil
+}
+
+// SetTransferFeeExemption - adds an address to, or removes it from, the addresses whose transfers of a token pay no
+// fee, on behalf of the token's owner
+func (k Keeper) SetTransferFeeExemption(ctx sdk.Context, owner sdk.AccAddress, symbol string, address sdk.AccAddress,
+	exempt bool) sdk.Error {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(token.Owner) {
+		return types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	fee, err := k.GetTransferFee(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]sdk.AccAddress, 0, len(fee.Exempt)+1)
+	for _, other := range fee.Exempt {
+		if !other.Equals(address) {
+			kept = append(kept, other)
+		}
+	}
+	if exempt {
+		kept = append(kept, address)
+	}
+	fee.Exempt = kept
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+	k.SetTransferFee(ctx, fee)
+	return nil
+}
+
+// ChargeTransferFee - takes the transfer fees due on coins that were just sent from one account to another out of
+// what the recipient received and pays them to the fee recipients. A fee is never more than the coins received, and
+// it follows the transfer rules of the token like any other transfer from the recipient. Transfers from or to the
+// token owner, an exempt address or a module account pay no fee, an empty sender only checks the recipient. Returns
+// the fees charged
+func (k Keeper) ChargeTransferFee(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) (sdk.Coins, sdk.Error) {
+	charged := sdk.NewCoins()
+	if k.CoinKeeper.BlacklistedAddr(to) || (!from.Empty() && k.CoinKeeper.BlacklistedAddr(from)) {
+		return charged, nil
+	}
+
+	for _, coin := range coins {
+		fee, err := k.GetTransferFee(ctx, coin.Denom)
+		if err != nil {
+			continue
+		}
+		owner, err := k.GetOwner(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if to.Equals(owner) || fee.IsExempt(to) || (!from.Empty() && (from.Equals(owner) || fee.IsExempt(from))) {
+			continue
+		}
+		amount := fee.FeeFor(coin.Amount)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		feeCoins := sdk.NewCoins(sdk.NewCoin(coin.Denom, amount))
+		if err := k.CheckTransferMode(ctx, to, fee.Recipient, feeCoins); err != nil {
+			return nil, err
+		}
+		err = k.CheckHoldingLimits(ctx, []bank.Input{bank.NewInput(to, feeCoins)},
+			[]bank.Output{bank.NewOutput(fee.Recipient, feeCoins)})
+		if err != nil {
+			return nil, err
+		}
+		if err := k.CoinKeeper.SendCoins(ctx, to, fee.Recipient, feeCoins); err != nil {
+			return nil, err
+		}
+		fee.Collected = fee.Collected.Add(amount)
+		k.SetTransferFee(ctx, fee)
+		charged = charged.Add(feeCoins)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTransferFee,
+			sdk.NewAttribute(types.AttributeKeySender, to.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fee.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, feeCoins.String()),
+		))
+	}
+	return charged, nil
+}
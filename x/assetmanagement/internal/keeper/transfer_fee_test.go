@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestConfigureTransferFee(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, collector := types.KeyTestPubAddr()
+	_, _, exchange := types.KeyTestPubAddr()
+
+	require.Equal(t, types.CodeInvalidOwner,
+		keeper.ConfigureTransferFee(ctx, collector, "zap123", 100, 0, 0, collector).Code())
+	require.Equal(t, types.CodeTransferFeeDoesNotExist,
+		keeper.SetTransferFeeExemption(ctx, owner, "zap123", exchange, true).Code())
+
+	require.Nil(t, keeper.ConfigureTransferFee(ctx, owner, "zap123", 100, 0, 0, collector))
+	require.Nil(t, keeper.SetTransferFeeExemption(ctx, owner, "zap123", exchange, true))
+	require.Nil(t, keeper.SetTransferFeeExemption(ctx, owner, "zap123", exchange, true))
+	fee, err := keeper.GetTransferFee(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, []sdk.AccAddress{exchange}, fee.Exempt)
+
+	// changing the fee keeps the exemptions
+	require.Nil(t, keeper.ConfigureTransferFee(ctx, owner, "zap123", 50, 1, 10, collector))
+	fee, err = keeper.GetTransferFee(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, uint64(50), fee.Rate)
+	require.Len(t, fee.Exempt, 1)
+
+	require.Nil(t, keeper.SetTransferFeeExemption(ctx, owner, "zap123", exchange, false))
+	fee, err = keeper.GetTransferFee(ctx, "zap123")
+	require.Nil(t, err)
+	require.Empty(t, fee.Exempt)
+}
+
+func TestChargeTransferFee(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 100000)
+	_, _, collector := types.KeyTestPubAddr()
+	_, _, exchange := types.KeyTestPubAddr()
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+
+	require.Nil(t, keeper.ConfigureTransferFee(ctx, owner, "zap123", 100, 2, 0, collector))
+	require.Nil(t, keeper.SetTransferFeeExemption(ctx, owner, "zap123", exchange, true))
+
+	// the owner pays no fee
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{
+		types.NewRecipient(alice, 10000),
+		types.NewRecipient(exchange, 10000),
+	}))
+	require.Equal(t, sdk.NewInt(10000), keeper.CoinKeeper.GetCoins(ctx, alice).AmountOf("zap123"))
+
+	// the recipient of a transfer pays 1%
+	require.Nil(t, keeper.CoinKeeper.SendCoins(ctx, alice, bob, types.NewTestCoins("zap123", 1000)))
+	charged, err := keeper.ChargeTransferFee(ctx, alice, bob, types.NewTestCoins("zap123", 1000))
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 10), charged)
+	require.Equal(t, sdk.NewInt(990), keeper.CoinKeeper.GetCoins(ctx, bob).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(10), keeper.CoinKeeper.GetCoins(ctx, collector).AmountOf("zap123"))
+
+	// transfers from or to exempt addresses pay nothing, nor do other denominations
+	charged, err = keeper.ChargeTransferFee(ctx, exchange, bob, types.NewTestCoins("zap123", 1000))
+	require.Nil(t, err)
+	require.True(t, charged.Empty())
+	charged, err = keeper.ChargeTransferFee(ctx, bob, exchange, types.NewTestCoins("zap123", 100))
+	require.Nil(t, err)
+	require.True(t, charged.Empty())
+	charged, err = keeper.ChargeTransferFee(ctx, alice, bob, types.NewTestCoins("abc123", 1000))
+	require.Nil(t, err)
+	require.True(t, charged.Empty())
+
+	// transfers from an allowance pay too
+	require.Nil(t, keeper.Approve(ctx, alice, bob, "zap123", 100, time.Time{}))
+	_, err = keeper.TransferFrom(ctx, bob, alice, bob, "zap123", 100)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(990+98), keeper.CoinKeeper.GetCoins(ctx, bob).AmountOf("zap123"))
+
+	fee, err := keeper.GetTransferFee(ctx, "zap123")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(12), fee.Collected)
+
+	// the recipient never pays more than it received, not even the min fee
+	require.Nil(t, keeper.CoinKeeper.SendCoins(ctx, alice, bob, types.NewTestCoins("zap123", 1)))
+	charged, err = keeper.ChargeTransferFee(ctx, alice, bob, types.NewTestCoins("zap123", 1))
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 1), charged)
+	require.Equal(t, sdk.NewInt(990+98), keeper.CoinKeeper.GetCoins(ctx, bob).AmountOf("zap123"))
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestTransferFeeFollowsTransferRules(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 100000)
+	_, _, collector := types.KeyTestPubAddr()
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	require.Nil(t, keeper.ConfigureTransferFee(ctx, owner, "zap123", 100, 0, 0, collector))
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{
+		types.NewRecipient(alice, 10000),
+		types.NewRecipient(bob, 10000),
+	}))
+
+	// the fee is a transfer from the recipient to the fee recipient, which a deprecated token can't make to an
+	// account not holding it yet
+	require.Nil(t, keeper.SetTokenStatus(ctx, owner, "zap123", types.TokenStatusDeprecated))
+	require.Nil(t, keeper.CoinKeeper.SendCoins(ctx, alice, bob, types.NewTestCoins("zap123", 1000)))
+	_, err := keeper.ChargeTransferFee(ctx, alice, bob, types.NewTestCoins("zap123", 1000))
+	require.Equal(t, types.CodeTokenDeprecated, err.Code())
+	require.True(t, keeper.CoinKeeper.GetCoins(ctx, collector).Empty())
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// CheckTransferMode - checks that the tokens among the coins aren't disabled or paused, that deprecated ones don't
+// reach new holders and that their transfer modes let them move from one account to the other. Coins the module didn't
+// issue are not restricted
+func (k Keeper) CheckTransferMode(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	for _, coin := range coins {
+		token, err := k.GetToken(ctx, coin.Denom)
+		if err != nil {
+			continue
+		}
+		if token.Disabled {
+			return types.ErrTokenDisabled(k.codespace, coin.Denom)
+		}
+		if token.Paused {
+			return types.ErrTokenPaused(k.codespace, coin.Denom)
+		}
+		if token.IsDeprecated() && !k.canReceiveDeprecated(ctx, token, to) {
+			return types.ErrTokenDeprecated(k.codespace, coin.Denom)
+		}
+		if !token.CanTransfer(from, to) {
+			return types.ErrTransferNotAllowed(k.codespace, coin.Denom, from, to)
+		}
+	}
+	return nil
+}
+
+// canReceiveDeprecated tells whether an account may receive a deprecated token: its owner, module accounts and
+// accounts already holding it
+func (k Keeper) canReceiveDeprecated(ctx sdk.Context, token *types.Token, to sdk.AccAddress) bool {
+	if to.Equals(token.Owner) || k.IsHolder(ctx, token.Symbol, to) {
+		return true
+	}
+	_, module := k.holders().balance(ctx, token.Symbol, to)
+	return module
+}
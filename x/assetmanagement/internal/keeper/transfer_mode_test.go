@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestCheckTransferMode(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, alice := types.KeyTestPubAddr()
+	_, _, bob := types.KeyTestPubAddr()
+	token, err := keeper.GetToken(ctx, "zap123")
+	require.Nil(t, err)
+	token.TransferMode = types.TransferModeOwnerOnly
+	require.Nil(t, keeper.SetToken(ctx, "zap123", token))
+
+	// coins the module didn't issue aren't restricted
+	require.Nil(t, keeper.CheckTransferMode(ctx, alice, bob, types.NewTestCoins("stake", 10)))
+
+	require.Nil(t, keeper.DistributeCoins(ctx, owner, "zap123", []types.Recipient{
+		types.NewRecipient(alice, 100),
+		types.NewRecipient(bob, 100),
+	}))
+	err = keeper.DistributeCoins(ctx, alice, "zap123", []types.Recipient{types.NewRecipient(bob, 10)})
+	require.Equal(t, types.CodeTransferNotAllowed, err.Code())
+	require.Nil(t, keeper.DistributeCoins(ctx, alice, "zap123", []types.Recipient{types.NewRecipient(owner, 10)}))
+
+	require.Nil(t, keeper.Approve(ctx, alice, bob, "zap123", 50, time.Time{}))
+	_, err = keeper.TransferFrom(ctx, bob, alice, bob, "zap123", 20)
+	require.Equal(t, types.CodeTransferNotAllowed, err.Code())
+	_, err = keeper.TransferFrom(ctx, bob, alice, owner, "zap123", 20)
+	require.Nil(t, err)
+
+	require.Equal(t, sdk.NewInt(70), keeper.CoinKeeper.GetCoins(ctx, alice).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(100), keeper.CoinKeeper.GetCoins(ctx, bob).AmountOf("zap123"))
+	require.Equal(t, sdk.NewInt(830), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+// GetNextVestingGrantID - gets the ID the next vesting grant will be created with
+func (k Keeper) GetNextVestingGrantID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextVestingGrantIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextVestingGrantID - sets the ID the next vesting grant will be created with
+func (k Keeper) SetNextVestingGrantID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextVestingGrantIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetVestingGrant - gets a vesting grant by ID
+func (k Keeper) GetVestingGrant(ctx sdk.Context, id uint64) (types.VestingGrant, sdk.Error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.VestingGrantKey(id))
+	if bz == nil {
+		return types.VestingGrant{}, types.ErrVestingGrantDoesNotExist(k.codespace, id)
+	}
+	var grant types.VestingGrant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, nil
+}
+
+// SetVestingGrant - stores a vesting grant and indexes it by token and by beneficiary
+func (k Keeper) SetVestingGrant(ctx sdk.Context, grant types.VestingGrant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VestingGrantKey(grant.ID), k.cdc.MustMarshalBinaryBare(grant))
+	store.Set(types.TokenVestingKey(grant.Symbol, grant.ID), []byte{0x01})
+	store.Set(types.BeneficiaryKey(grant.Beneficiary, grant.ID), []byte{0x01})
+}
+
+// deleteVestingGrant - removes a vesting grant along with its index entries
+func (k Keeper) deleteVestingGrant(ctx sdk.Context, grant types.VestingGrant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VestingGrantKey(grant.ID))
+	store.Delete(types.TokenVestingKey(grant.Symbol, grant.ID))
+	store.Delete(types.BeneficiaryKey(grant.Beneficiary, grant.ID))
+}
+
+// IterateVestingGrants - iterates over all vesting grants in ID order until the callback returns true
+func (k Keeper) IterateVestingGrants(ctx sdk.Context, cb func(grant types.VestingGrant) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VestingGrantKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.VestingGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// GetTokenVestingGrants - gets the vesting grants of a token in ID order
+func (k Keeper) GetTokenVestingGrants(ctx sdk.Context, symbol string) []types.VestingGrant {
+	return k.getIndexedVestingGrants(ctx, types.TokenVestingKeysPrefix(symbol))
+}
+
+// GetBeneficiaryVestingGrants - gets the vesting grants of a beneficiary in ID order
+func (k Keeper) GetBeneficiaryVestingGrants(ctx sdk.Context, beneficiary sdk.AccAddress) []types.VestingGrant {
+	return k.getIndexedVestingGrants(ctx, types.BeneficiaryKeysPrefix(beneficiary))
+}
+
+// getIndexedVestingGrants - gets the vesting grants listed under an index prefix, the keys end in the grant ID
+func (k Keeper) getIndexedVestingGrants(ctx sdk.Context, prefix []byte) []types.VestingGrant {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	grants := make([]types.VestingGrant, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		grant, err := k.GetVestingGrant(ctx, binary.BigEndian.Uint64(iterator.Key()[len(prefix):]))
+		if err != nil {
+			panic(fmt.Sprintf("indexed vesting grant is missing: %s", err))
+		}
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// CreateVestingGrant - moves the total from the token owner into the vesting pool and registers the grant.
+// Returns the ID of the new grant
+func (k Keeper) CreateVestingGrant(ctx sdk.Context, owner, beneficiary sdk.AccAddress, symbol string, total int64,
+	startTime, cliffTime, endTime time.Time) (uint64, sdk.Error) {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return 0, err
+	}
+	if !owner.Equals(token.Owner) {
+		return 0, types.ErrInvalidOwner(k.codespace, owner, symbol)
+	}
+	if token.Disabled {
+		return 0, types.ErrTokenDisabled(k.codespace, symbol)
+	}
+	if token.IsDeprecated() {
+		return 0, types.ErrTokenDeprecated(k.codespace, symbol)
+	}
+	if token.Paused {
+		return 0, types.ErrTokenPaused(k.codespace, symbol)
+	}
+	if err := types.ValidateVestingSchedule(startTime, cliffTime, endTime); err != nil {
+		return 0, err
+	}
+	if k.CoinKeeper.BlacklistedAddr(beneficiary) {
+		return 0, sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", beneficiary))
+	}
+
+	grant := types.NewVestingGrant(k.GetNextVestingGrantID(ctx), owner, beneficiary, symbol, total,
+		startTime, cliffTime, endTime)
+	account := k.AccountKeeper.GetAccount(ctx, owner)
+	if account == nil {
+		return 0, types.ErrUnknownAccount(k.codespace, owner)
+	}
+	if !account.GetCoins().IsAllGTE(grant.Total) {
+		return 0, types.ErrInsufficientCoins(k.codespace,
+			fmt.Sprintf("%s holds %s, not enough to lock %s", owner, account.GetCoins(), grant.Total))
+	}
+	if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.VestingPoolName, grant.Total); err != nil {
+		return 0, err
+	}
+
+	k.SetVestingGrant(ctx, grant)
+	k.SetNextVestingGrantID(ctx, grant.ID+1)
+	return grant.ID, nil
+}
+
+// ClaimVested - releases the vested coins of a grant that haven't been released yet to its beneficiary.
+// A grant is removed once everything is released. The release follows the rules of a transfer from the token's owner,
+// so a disabled, paused or deprecated token only reaches beneficiaries a transfer would. Returns the released coins
+func (k Keeper) ClaimVested(ctx sdk.Context, beneficiary sdk.AccAddress, id uint64) (sdk.Coins, sdk.Error) {
+	grant, err := k.GetVestingGrant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !beneficiary.Equals(grant.Beneficiary) {
+		return nil, types.ErrNotBeneficiary(k.codespace, beneficiary, id)
+	}
+	releasable := grant.ReleasableCoins(ctx.BlockTime())
+	if releasable.IsZero() {
+		return nil, types.ErrNothingVested(k.codespace, id)
+	}
+	token, err := k.GetToken(ctx, grant.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.CheckTransferMode(ctx, token.Owner, beneficiary, releasable); err != nil {
+		return nil, err
+	}
+	if err := k.CheckHoldingLimits(ctx, nil, []bank.Output{bank.NewOutput(beneficiary, releasable)}); err != nil {
+		return nil, err
+	}
+	if err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.VestingPoolName, beneficiary, releasable); err != nil {
+		return nil, err
+	}
+
+	grant.Released = grant.Released.Add(releasable)
+	if grant.Locked().IsZero() {
+		k.deleteVestingGrant(ctx, grant)
+	} else {
+		k.SetVestingGrant(ctx, grant)
+	}
+	return releasable, nil
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement/internal/types"
+)
+
+func TestVestingGrant(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupToken(t, ctx, keeper, "zap123", 1000)
+	_, _, beneficiary := types.KeyTestPubAddr()
+	_, _, stranger := types.KeyTestPubAddr()
+	cliff, end := start.Add(10*time.Hour), start.Add(100*time.Hour)
+
+	_, err := keeper.CreateVestingGrant(ctx, stranger, beneficiary, "zap123", 400, start, cliff, end)
+	require.Equal(t, types.CodeInvalidOwner, err.Code())
+	_, err = keeper.CreateVestingGrant(ctx, owner, beneficiary, "zap123", 400, start, end, cliff)
+	require.Equal(t, types.CodeInvalidVestingGrant, err.Code())
+	_, err = keeper.CreateVestingGrant(ctx, owner, beneficiary, "zap123", 5000, start, cliff, end)
+	require.Equal(t, types.CodeInsufficientCoins, err.Code())
+	_, err = keeper.CreateVestingGrant(ctx, owner, beneficiary, "abc123", 400, start, cliff, end)
+	require.Equal(t, types.CodeTokenSymbolDoesNotExist, err.Code())
+
+	id, err := keeper.CreateVestingGrant(ctx, owner, beneficiary, "zap123", 400, start, cliff, end)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, uint64(2), keeper.GetNextVestingGrantID(ctx))
+	require.Equal(t, sdk.NewInt(600), keeper.CoinKeeper.GetCoins(ctx, owner).AmountOf("zap123"))
+	require.Len(t, keeper.GetTokenVestingGrants(ctx, "zap123"), 1)
+	require.Len(t, keeper.GetBeneficiaryVestingGrants(ctx, beneficiary), 1)
+	require.Empty(t, keeper.GetBeneficiaryVestingGrants(ctx, owner))
+
+	// grant records don't show up as tokens
+	var symbols []string
+	keeper.IterateTokens(ctx, func(token types.Token) bool {
+		symbols = append(symbols, token.Symbol)
+		return false
+	})
+	require.Equal(t, []string{"zap123"}, symbols)
+
+	_, broken := AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	_, err = keeper.ClaimVested(ctx.WithBlockTime(start.Add(9*time.Hour)), beneficiary, id)
+	require.Equal(t, types.CodeNothingVested, err.Code())
+	_, err = keeper.ClaimVested(ctx.WithBlockTime(cliff), stranger, id)
+	require.Equal(t, types.CodeNotBeneficiary, err.Code())
+	_, err = keeper.ClaimVested(ctx.WithBlockTime(cliff), beneficiary, 7)
+	require.Equal(t, types.CodeVestingGrantDoesNotExist, err.Code())
+
+	released, err := keeper.ClaimVested(ctx.WithBlockTime(start.Add(25*time.Hour)), beneficiary, id)
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 100), released)
+	_, err = keeper.ClaimVested(ctx.WithBlockTime(start.Add(25*time.Hour)), beneficiary, id)
+	require.Equal(t, types.CodeNothingVested, err.Code())
+
+	grant, err := keeper.GetVestingGrant(ctx, id)
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 100), grant.Released)
+	_, broken = AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// the last claim releases the rest and removes the grant with its indexes
+	released, err = keeper.ClaimVested(ctx.WithBlockTime(end.Add(time.Hour)), beneficiary, id)
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("zap123", 300), released)
+	require.Equal(t, sdk.NewInt(400), keeper.CoinKeeper.GetCoins(ctx, beneficiary).AmountOf("zap123"))
+	_, err = keeper.GetVestingGrant(ctx, id)
+	require.Equal(t, types.CodeVestingGrantDoesNotExist, err.Code())
+	require.Empty(t, keeper.GetTokenVestingGrants(ctx, "zap123"))
+	require.Empty(t, keeper.GetBeneficiaryVestingGrants(ctx, beneficiary))
+
+	_, broken = AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestVestingReleaseFollowsTransferRules(t *testing.T) {
+	ctx, keeper := CreateTestInput(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	owner := setupConversion(t, ctx, keeper)
+	_, _, beneficiary := types.KeyTestPubAddr()
+	id, err := keeper.CreateVestingGrant(ctx, owner, beneficiary, "old123", 100, start, start, start.Add(time.Hour))
+	require.Nil(t, err)
+	later := ctx.WithBlockTime(start.Add(30 * time.Minute))
+
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "old123", true))
+	_, err = keeper.ClaimVested(later, beneficiary, id)
+	require.Equal(t, types.CodeTokenPaused, err.Code())
+	require.Nil(t, keeper.SetTokenPaused(ctx, owner, "old123", false))
+
+	// the beneficiary doesn't hold the token yet, neither deprecating nor disabling it may strand the grant
+	err = keeper.SetTokenStatus(ctx, owner, "old123", types.TokenStatusDeprecated)
+	require.Equal(t, types.CodeInvalidTokenStatus, err.Code())
+	err = keeper.OpenConversion(ctx, owner, "old123", "new456", 1, 1, start.Add(2*time.Hour))
+	require.Equal(t, types.CodeInvalidConversion, err.Code())
+
+	released, err := keeper.ClaimVested(later, beneficiary, id)
+	require.Nil(t, err)
+	require.Equal(t, types.NewTestCoins("old123", 50), released)
+	_, err = keeper.ClaimVested(ctx.WithBlockTime(start.Add(time.Hour)), beneficiary, id)
+	require.Nil(t, err)
+	require.Nil(t, keeper.SetTokenStatus(ctx, owner, "old123", types.TokenStatusDeprecated))
+}
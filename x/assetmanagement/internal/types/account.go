@@ -12,7 +12,7 @@ import (
 // CustomAccount is customised to allow temporary freezing of coins to exclude them from transactions
 type CustomAccount struct {
 	*auth.BaseAccount
-	FrozenCoins sdk.Coins `json:"coins" yaml:"coins"`
+	FrozenCoins sdk.Coins `json:"frozen_coins" yaml:"frozen_coins"`
 }
 
 func NewCustomAccount(address sdk.AccAddress, coins sdk.Coins, frozenCoins sdk.Coins,
@@ -70,15 +70,15 @@ func AreAnyCoinsZero(coins *sdk.Coins) bool {
 }
 
 // FreezeCoins freezes unfrozen coins for account according to input
-func (acc *CustomAccount) FreezeCoins(coinsToFreeze sdk.Coins) error {
+func (acc *CustomAccount) FreezeCoins(coinsToFreeze sdk.Coins) sdk.Error {
 	// Have enough coins to freeze?
 	if coinsToFreeze == nil || coinsToFreeze.Empty() || coinsToFreeze.IsAnyNegative() || AreAnyCoinsZero(&coinsToFreeze) {
-		return sdk.ErrInvalidCoins("No coins chosen to freeze")
+		return ErrInvalidAmount(DefaultCodespace, "No coins chosen to freeze")
 	}
 
 	currentCoins := acc.GetCoins()
-	if currentCoins == nil || currentCoins.IsAllLT(coinsToFreeze) {
-		return sdk.ErrInvalidCoins("Not enough coins to freeze")
+	if currentCoins == nil || !currentCoins.IsAllGTE(coinsToFreeze) {
+		return ErrInsufficientCoins(DefaultCodespace, "Not enough coins to freeze")
 	}
 
 	// Freeze coins
@@ -105,15 +105,15 @@ func (acc *CustomAccount) FreezeCoins(coinsToFreeze sdk.Coins) error {
 }
 
 // UnfreezeCoins unfreezes frozen coins for account according to input
-func (acc *CustomAccount) UnfreezeCoins(coinsToUnfreeze sdk.Coins) error {
+func (acc *CustomAccount) UnfreezeCoins(coinsToUnfreeze sdk.Coins) sdk.Error {
 	// Have enough coins to unfreeze?
 	if coinsToUnfreeze == nil || coinsToUnfreeze.Empty() || coinsToUnfreeze.IsAnyNegative() {
-		return sdk.ErrInvalidCoins("No coins chosen to unfreeze")
+		return ErrInvalidAmount(DefaultCodespace, "No coins chosen to unfreeze")
 	}
 
 	currentlyFrozen := acc.GetFrozenCoins()
-	if currentlyFrozen == nil || currentlyFrozen.IsAllLT(coinsToUnfreeze) {
-		return sdk.ErrInvalidCoins("Not enough coins to unfreeze")
+	if currentlyFrozen == nil || !currentlyFrozen.IsAllGTE(coinsToUnfreeze) {
+		return ErrInsufficientFrozenCoins(DefaultCodespace, "Not enough coins to unfreeze")
 	}
 
 	// Unfreeze coins
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types"
@@ -39,3 +40,16 @@ func TestFreeze(t *testing.T) {
 	require.Equal(t, true, types.NewInt(99).Equal(account2.Coins.AmountOf(coinSymbol)))
 	require.Equal(t, true, types.NewInt(1).Equal(account2.FrozenCoins.AmountOf(coinSymbol)))
 }
+
+func TestCustomAccountJSON(t *testing.T) {
+	_, _, addr1 := KeyTestPubAddr()
+	account := NewCustomAccount(addr1, NewTestCoins("ab1", 99), NewTestCoins("ab1", 1), nil, 1, 2)
+
+	// the frozen coins must not shadow the spendable coins of the embedded account
+	bz, err := json.Marshal(account)
+	require.Nil(t, err)
+	var decoded CustomAccount
+	require.Nil(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, account.Coins, decoded.Coins)
+	require.Equal(t, account.FrozenCoins, decoded.FrozenCoins)
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Allowance lets a spender move coins of a token out of the owner's account, up to the amount and until the expiry.
+// A zero expiry never expires
+type Allowance struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Spender sdk.AccAddress `json:"spender"`
+	Symbol  string         `json:"symbol"`
+	Amount  sdk.Int        `json:"amount"`
+	Expiry  time.Time      `json:"expiry"`
+}
+
+// NewAllowance returns a new allowance
+func NewAllowance(owner, spender sdk.AccAddress, symbol string, amount int64, expiry time.Time) Allowance {
+	return Allowance{
+		Owner:   owner,
+		Spender: spender,
+		Symbol:  symbol,
+		Amount:  sdk.NewInt(amount),
+		Expiry:  expiry,
+	}
+}
+
+// IsExpired tells whether the allowance can no longer be spent at the given time
+func (a Allowance) IsExpired(blockTime time.Time) bool {
+	return !a.Expiry.IsZero() && !blockTime.Before(a.Expiry)
+}
+
+// Validate runs stateless checks on the allowance
+func (a Allowance) Validate() sdk.Error {
+	if a.Owner.Empty() || a.Spender.Empty() {
+		return ErrInvalidAllowance(DefaultCodespace, "Owner and Spender cannot be empty")
+	}
+	if a.Owner.Equals(a.Spender) {
+		return ErrInvalidAllowance(DefaultCodespace, "Owner cannot approve itself")
+	}
+	if a.Symbol == "" {
+		return ErrInvalidAllowance(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if isNilInt(a.Amount) || !a.Amount.IsPositive() {
+		return ErrInvalidAllowance(DefaultCodespace, fmt.Sprintf("amount %s must be positive", a.Amount))
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (a Allowance) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Owner: %s
+Spender: %s
+Symbol: %s
+Amount: %s
+Expiry: %s`, a.Owner, a.Spender, a.Symbol, a.Amount, a.Expiry))
+}
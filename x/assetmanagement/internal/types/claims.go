@@ -0,0 +1,129 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClaimCampaign is a claimable airdrop. The recipients and their amounts are the leaves of a Merkle tree, only its
+// root is stored. The deposit is held in the claims pool and whatever is left is returned to the owner at the end time
+type ClaimCampaign struct {
+	ID         uint64         `json:"id"`
+	Owner      sdk.AccAddress `json:"owner"`
+	Symbol     string         `json:"symbol"`
+	MerkleRoot string         `json:"merkle_root"` // hex encoded root of the claim tree
+	Deposit    sdk.Coins      `json:"deposit"`     // what is left to claim
+	EndTime    time.Time      `json:"end_time"`
+}
+
+// NewClaimCampaign returns a new claim campaign
+func NewClaimCampaign(id uint64, owner sdk.AccAddress, symbol, merkleRoot string, deposit int64,
+	endTime time.Time) ClaimCampaign {
+	return ClaimCampaign{
+		ID:         id,
+		Owner:      owner,
+		Symbol:     symbol,
+		MerkleRoot: merkleRoot,
+		Deposit:    sdk.NewCoins(sdk.NewInt64Coin(symbol, deposit)),
+		EndTime:    endTime,
+	}
+}
+
+// String implements fmt.Stringer
+func (c ClaimCampaign) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`ID: %d
+Owner: %s
+Symbol: %s
+Merkle Root: %s
+Deposit: %s
+End Time: %s`, c.ID, c.Owner, c.Symbol, c.MerkleRoot, c.Deposit, c.EndTime))
+}
+
+// ClaimRecord marks that an address claimed its share of a campaign
+type ClaimRecord struct {
+	CampaignID uint64         `json:"campaign_id"`
+	Address    sdk.AccAddress `json:"address"`
+}
+
+// ValidateMerkleRoot checks that a root is a hex encoded sha256 hash
+func ValidateMerkleRoot(root string) sdk.Error {
+	bz, err := hex.DecodeString(root)
+	if err != nil || len(bz) != sha256.Size {
+		return ErrInvalidCampaign(DefaultCodespace,
+			fmt.Sprintf("merkle root '%s' must be a hex encoded %d byte hash", root, sha256.Size))
+	}
+	return nil
+}
+
+// ClaimLeaf hashes a single (address, amount) entry of a claim tree
+func ClaimLeaf(address sdk.AccAddress, amount int64) []byte {
+	bz := make([]byte, 0, 1+len(address)+8)
+	bz = append(bz, 0x00)
+	bz = append(bz, address...)
+	bz = append(bz, sdk.Uint64ToBigEndian(uint64(amount))...)
+	sum := sha256.Sum256(bz)
+	return sum[:]
+}
+
+// hashClaimNodes hashes two sibling nodes of a claim tree. The pair is sorted first, so a proof is just the list of
+// siblings without their sides
+func hashClaimNodes(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	bz := make([]byte, 0, 1+len(a)+len(b))
+	bz = append(bz, 0x01)
+	bz = append(bz, a...)
+	bz = append(bz, b...)
+	sum := sha256.Sum256(bz)
+	return sum[:]
+}
+
+// BuildClaimTree returns the root of the claim tree over the given leaves and the proof of every leaf.
+// A node without a sibling is carried up to the next level unchanged
+func BuildClaimTree(leaves [][]byte) ([]byte, [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	proofs := make([][][]byte, len(leaves))
+	positions := make([]int, len(leaves))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashClaimNodes(level[i], level[i+1]))
+		}
+		for leaf, position := range positions {
+			if sibling := position ^ 1; sibling < len(level) {
+				proofs[leaf] = append(proofs[leaf], level[sibling])
+			}
+			positions[leaf] = position / 2
+		}
+		level = next
+	}
+	return level[0], proofs
+}
+
+// VerifyClaimProof checks that the leaf is part of the tree with the given root
+func VerifyClaimProof(root []byte, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		hash = hashClaimNodes(hash, sibling)
+	}
+	return bytes.Equal(hash, root)
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimTree(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		leaves := make([][]byte, n)
+		for i := range leaves {
+			_, _, address := KeyTestPubAddr()
+			leaves[i] = ClaimLeaf(address, int64(i+1))
+		}
+
+		root, proofs := BuildClaimTree(leaves)
+		require.Nil(t, ValidateMerkleRoot(hex.EncodeToString(root)))
+		require.Len(t, proofs, n)
+		for i, leaf := range leaves {
+			require.True(t, VerifyClaimProof(root, leaf, proofs[i]), "%d leaves, leaf %d", n, i)
+			if n > 1 {
+				require.False(t, VerifyClaimProof(root, leaves[(i+1)%n], proofs[i]), "%d leaves, leaf %d", n, i)
+			}
+		}
+	}
+
+	root, proofs := BuildClaimTree(nil)
+	require.Nil(t, root)
+	require.Nil(t, proofs)
+}
+
+func TestClaimLeafBindsAddressAndAmount(t *testing.T) {
+	_, _, address := KeyTestPubAddr()
+	_, _, other := KeyTestPubAddr()
+
+	require.Equal(t, ClaimLeaf(address, 10), ClaimLeaf(address, 10))
+	require.NotEqual(t, ClaimLeaf(address, 10), ClaimLeaf(address, 11))
+	require.NotEqual(t, ClaimLeaf(address, 10), ClaimLeaf(other, 10))
+}
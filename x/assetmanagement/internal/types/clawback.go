@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxClawbackReasonLength is the longest reason a clawback can be recorded with
+const MaxClawbackReasonLength = 256
+
+// ClawbackRecord is an entry in the clawback history of a token
+type ClawbackRecord struct {
+	ID        uint64         `json:"id"`
+	Symbol    string         `json:"symbol"`
+	Authority sdk.AccAddress `json:"authority"` // the owner or clawback admin who clawed back
+	From      sdk.AccAddress `json:"from"`
+	Recipient sdk.AccAddress `json:"recipient"` // empty when the coins were burned
+	Amount    sdk.Coins      `json:"amount"`
+	Reason    string         `json:"reason"`
+	Height    int64          `json:"height"`
+	Time      time.Time      `json:"time"`
+}
+
+// ValidateClawbackReason checks that a reason is given and isn't too long
+func ValidateClawbackReason(reason string) sdk.Error {
+	if strings.TrimSpace(reason) == "" {
+		return ErrInvalidClawback(DefaultCodespace, "Reason cannot be empty")
+	}
+	if len(reason) > MaxClawbackReasonLength {
+		return ErrInvalidClawback(DefaultCodespace,
+			fmt.Sprintf("Reason cannot be longer than %d bytes", MaxClawbackReasonLength))
+	}
+	return nil
+}
+
+// Burned tells whether the clawed back coins were burned rather than moved
+func (c ClawbackRecord) Burned() bool {
+	return c.Recipient.Empty()
+}
+
+// String implements fmt.Stringer
+func (c ClawbackRecord) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`ID: %d
+Symbol: %s
+Authority: %s
+From: %s
+Recipient: %s
+Amount: %s
+Burned: %v
+Reason: %s
+Height: %d
+Time: %s`, c.ID, c.Symbol, c.Authority, c.From, c.Recipient, c.Amount, c.Burned(), c.Reason, c.Height, c.Time))
+}
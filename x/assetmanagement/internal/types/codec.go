@@ -2,11 +2,14 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var ModuleCdc = codec.New()
 
 func init() {
+	// proposed actions carry a message, so the message interface must be known to sign them
+	sdk.RegisterCodec(ModuleCdc)
 	RegisterCodec(ModuleCdc)
 }
 
@@ -17,4 +20,36 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBurnCoins{}, "assetmanagement/BurnCoins", nil)
 	cdc.RegisterConcrete(MsgFreezeCoins{}, "assetmanagement/FreezeCoins", nil)
 	cdc.RegisterConcrete(MsgUnfreezeCoins{}, "assetmanagement/UnfreezeCoins", nil)
+	cdc.RegisterConcrete(MsgDistribute{}, "assetmanagement/Distribute", nil)
+	cdc.RegisterConcrete(MsgCreateClaimCampaign{}, "assetmanagement/CreateClaimCampaign", nil)
+	cdc.RegisterConcrete(MsgClaim{}, "assetmanagement/Claim", nil)
+	cdc.RegisterConcrete(MsgCreateVestingGrant{}, "assetmanagement/CreateVestingGrant", nil)
+	cdc.RegisterConcrete(MsgClaimVested{}, "assetmanagement/ClaimVested", nil)
+	cdc.RegisterConcrete(MsgSetEmission{}, "assetmanagement/SetEmission", nil)
+	cdc.RegisterConcrete(MsgSetEmissionPaused{}, "assetmanagement/SetEmissionPaused", nil)
+	cdc.RegisterConcrete(MsgApprove{}, "assetmanagement/Approve", nil)
+	cdc.RegisterConcrete(MsgTransferFrom{}, "assetmanagement/TransferFrom", nil)
+	cdc.RegisterConcrete(MsgClawback{}, "assetmanagement/Clawback", nil)
+	cdc.RegisterConcrete(MsgSetClawbackAdmin{}, "assetmanagement/SetClawbackAdmin", nil)
+	cdc.RegisterConcrete(MsgSetTransferFee{}, "assetmanagement/SetTransferFee", nil)
+	cdc.RegisterConcrete(MsgSetTransferFeeExemption{}, "assetmanagement/SetTransferFeeExemption", nil)
+	cdc.RegisterConcrete(MsgSetHoldingLimits{}, "assetmanagement/SetHoldingLimits", nil)
+	cdc.RegisterConcrete(MsgRedenominate{}, "assetmanagement/Redenominate", nil)
+	cdc.RegisterConcrete(MsgOpenConversion{}, "assetmanagement/OpenConversion", nil)
+	cdc.RegisterConcrete(MsgConvert{}, "assetmanagement/Convert", nil)
+	cdc.RegisterConcrete(MsgSetTokenStatus{}, "assetmanagement/SetTokenStatus", nil)
+	cdc.RegisterConcrete(MsgSetCouncil{}, "assetmanagement/SetCouncil", nil)
+	cdc.RegisterConcrete(MsgProposeAction{}, "assetmanagement/ProposeAction", nil)
+	cdc.RegisterConcrete(MsgApproveAction{}, "assetmanagement/ApproveAction", nil)
+	cdc.RegisterConcrete(MsgRevokeApproval{}, "assetmanagement/RevokeApproval", nil)
+	cdc.RegisterConcrete(MsgSetTimelock{}, "assetmanagement/SetTimelock", nil)
+	cdc.RegisterConcrete(MsgCancelQueuedAction{}, "assetmanagement/CancelQueuedAction", nil)
+	cdc.RegisterConcrete(MsgGrant{}, "assetmanagement/Grant", nil)
+	cdc.RegisterConcrete(MsgRevokeGrant{}, "assetmanagement/RevokeGrant", nil)
+	cdc.RegisterConcrete(MsgExec{}, "assetmanagement/Exec", nil)
+	cdc.RegisterConcrete(MsgTransferOwnership{}, "assetmanagement/TransferOwnership", nil)
+	cdc.RegisterConcrete(MsgSetTokenMetadata{}, "assetmanagement/SetTokenMetadata", nil)
+	cdc.RegisterConcrete(MsgSetTokenPaused{}, "assetmanagement/SetTokenPaused", nil)
+
+	cdc.RegisterConcrete(CustomAccount{}, "assetmanagement/CustomAccount", nil)
 }
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxConversionFactor bounds the numerator and denominator of a conversion ratio
+const MaxConversionFactor = 1000000000
+
+// ConversionWindow lets the holders of a source token swap it for a target token issued by the same owner until the
+// deadline. Converted coins of the source are burnt and Numerator / Denominator as many coins of the target, rounded
+// down, are minted in their place. The source token is disabled once the window closes
+type ConversionWindow struct {
+	Source      string         `json:"source"`
+	Target      string         `json:"target"`
+	Owner       sdk.AccAddress `json:"owner"`
+	Numerator   uint64         `json:"numerator"`
+	Denominator uint64         `json:"denominator"`
+	Deadline    time.Time      `json:"deadline"`
+	Converted   sdk.Int        `json:"converted"`   // coins of the source burnt so far
+	Issued      sdk.Int        `json:"issued"`      // coins of the target minted so far
+	Conversions uint64         `json:"conversions"` // number of conversions made
+	Closed      bool           `json:"closed"`
+}
+
+// NewConversionWindow returns a new open conversion window nothing was converted through yet
+func NewConversionWindow(source, target string, owner sdk.AccAddress, numerator, denominator uint64,
+	deadline time.Time) ConversionWindow {
+	return ConversionWindow{
+		Source:      source,
+		Target:      target,
+		Owner:       owner,
+		Numerator:   numerator,
+		Denominator: denominator,
+		Deadline:    deadline,
+		Converted:   sdk.ZeroInt(),
+		Issued:      sdk.ZeroInt(),
+	}
+}
+
+// ValidateConversionRatio checks that both sides of a ratio are positive and within MaxConversionFactor
+func ValidateConversionRatio(numerator, denominator uint64) sdk.Error {
+	if numerator == 0 || denominator == 0 {
+		return ErrInvalidConversion(DefaultCodespace, "Numerator and Denominator must be positive")
+	}
+	if numerator > MaxConversionFactor || denominator > MaxConversionFactor {
+		return ErrInvalidConversion(DefaultCodespace,
+			fmt.Sprintf("Numerator and Denominator cannot be larger than %d", MaxConversionFactor))
+	}
+	return nil
+}
+
+// Validate runs stateless checks on a conversion window
+func (w ConversionWindow) Validate() sdk.Error {
+	if w.Source == w.Target {
+		return ErrInvalidConversion(DefaultCodespace, "Source and Target must be different tokens")
+	}
+	if w.Owner.Empty() {
+		return ErrInvalidConversion(DefaultCodespace, "Owner cannot be empty")
+	}
+	if err := ValidateConversionRatio(w.Numerator, w.Denominator); err != nil {
+		return err
+	}
+	if w.Deadline.IsZero() {
+		return ErrInvalidConversion(DefaultCodespace, "Deadline cannot be empty")
+	}
+	if isNilInt(w.Converted) || w.Converted.IsNegative() || isNilInt(w.Issued) || w.Issued.IsNegative() {
+		return ErrInvalidConversion(DefaultCodespace, "Converted and Issued cannot be negative")
+	}
+	return nil
+}
+
+// TargetAmount returns how many coins of the target converting an amount of the source gives
+func (w ConversionWindow) TargetAmount(amount sdk.Int) sdk.Int {
+	return ScaleAmount(amount, w.Numerator, w.Denominator)
+}
+
+// IsOpen tells whether coins can still be converted at the given block time
+func (w ConversionWindow) IsOpen(blockTime time.Time) bool {
+	return !w.Closed && blockTime.Before(w.Deadline)
+}
+
+// String implements fmt.Stringer
+func (w ConversionWindow) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Source: %s
+Target: %s
+Owner: %s
+Ratio: %d/%d
+Deadline: %s
+Converted: %s
+Issued: %s
+Conversions: %d
+Closed: %v`, w.Source, w.Target, w.Owner, w.Numerator, w.Denominator, w.Deadline, w.Converted, w.Issued,
+		w.Conversions, w.Closed))
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	// MaxCouncilMembers limits the members of a council, their approvals are scanned on every approval
+	MaxCouncilMembers = 20
+	// MaxActionDuration is how long a proposed action may stay pending before it expires
+	MaxActionDuration = 30 * 24 * time.Hour
+)
+
+// CouncilAddress returns the address a council owns its token through. Nobody holds its key, the council only acts
+// through the actions its members approve
+func CouncilAddress(symbol string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/council/%s", ModuleName, symbol))))
+}
+
+// Council owns a token on behalf of its members: the privileged messages of the token are proposed as actions and
+// only run once Threshold members approved them
+type Council struct {
+	Symbol    string           `json:"symbol"`
+	Members   []sdk.AccAddress `json:"members"`
+	Threshold uint64           `json:"threshold"`
+}
+
+// NewCouncil returns a new council
+func NewCouncil(symbol string, members []sdk.AccAddress, threshold uint64) Council {
+	return Council{
+		Symbol:    symbol,
+		Members:   members,
+		Threshold: threshold,
+	}
+}
+
+// Validate runs stateless checks on a council
+func (c Council) Validate() sdk.Error {
+	if len(c.Members) == 0 || len(c.Members) > MaxCouncilMembers {
+		return ErrInvalidCouncil(DefaultCodespace,
+			fmt.Sprintf("a council must have between 1 and %d members", MaxCouncilMembers))
+	}
+	address := CouncilAddress(c.Symbol)
+	for i, member := range c.Members {
+		if member.Empty() {
+			return ErrInvalidCouncil(DefaultCodespace, "council members cannot be empty")
+		}
+		if member.Equals(address) {
+			return ErrInvalidCouncil(DefaultCodespace, "the council address cannot be one of its members")
+		}
+		for _, other := range c.Members[:i] {
+			if member.Equals(other) {
+				return ErrInvalidCouncil(DefaultCodespace, fmt.Sprintf("%s is a member more than once", member))
+			}
+		}
+	}
+	if c.Threshold == 0 || c.Threshold > uint64(len(c.Members)) {
+		return ErrInvalidCouncil(DefaultCodespace,
+			fmt.Sprintf("threshold must be between 1 and the %d members", len(c.Members)))
+	}
+	return nil
+}
+
+// Address returns the address the council owns its token through
+func (c Council) Address() sdk.AccAddress {
+	return CouncilAddress(c.Symbol)
+}
+
+// IsMember tells whether an address is a member of the council
+func (c Council) IsMember(address sdk.AccAddress) bool {
+	return containsAddress(c.Members, address)
+}
+
+// String implements fmt.Stringer
+func (c Council) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Symbol: %s
+Address: %s
+Members: %s
+Threshold: %d`, c.Symbol, c.Address(), c.Members, c.Threshold))
+}
+
+// PendingAction is a privileged message of a council owned token waiting for enough approvals of the council to run
+type PendingAction struct {
+	ID        uint64           `json:"id"`
+	Symbol    string           `json:"symbol"`
+	Proposer  sdk.AccAddress   `json:"proposer"`
+	Action    sdk.Msg          `json:"action"`
+	Approvals []sdk.AccAddress `json:"approvals"` // members who approved, the proposer first
+	Expiry    time.Time        `json:"expiry"`
+}
+
+// NewPendingAction returns a new pending action approved by its proposer
+func NewPendingAction(id uint64, symbol string, proposer sdk.AccAddress, action sdk.Msg,
+	expiry time.Time) PendingAction {
+	return PendingAction{
+		ID:        id,
+		Symbol:    symbol,
+		Proposer:  proposer,
+		Action:    action,
+		Approvals: []sdk.AccAddress{proposer},
+		Expiry:    expiry,
+	}
+}
+
+// HasApproved tells whether a member approved the action
+func (a PendingAction) HasApproved(member sdk.AccAddress) bool {
+	return containsAddress(a.Approvals, member)
+}
+
+// IsExpired tells whether the action can no longer be approved at the given block time
+func (a PendingAction) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(a.Expiry)
+}
+
+// String implements fmt.Stringer
+func (a PendingAction) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`ID: %d
+Symbol: %s
+Proposer: %s
+Action: %s
+Approvals: %s
+Expiry: %s`, a.ID, a.Symbol, a.Proposer, a.Action.Type(), a.Approvals, a.Expiry))
+}
+
+// ValidateAction checks that a message can be proposed to the council of a token: a message of this module, other
+// than the council messages themselves, signed by the council address alone
+func ValidateAction(symbol string, action sdk.Msg) sdk.Error {
+	if action == nil {
+		return ErrInvalidAction(DefaultCodespace, "Action cannot be empty")
+	}
+	if action.Route() != RouterKey {
+		return ErrInvalidAction(DefaultCodespace,
+			fmt.Sprintf("only %s messages can be proposed, not %s ones", RouterKey, action.Route()))
+	}
+	switch action.(type) {
+	case MsgProposeAction, MsgApproveAction, MsgRevokeApproval:
+		return ErrInvalidAction(DefaultCodespace, fmt.Sprintf("a %s message cannot be proposed", action.Type()))
+	}
+	signers := action.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(CouncilAddress(symbol)) {
+		return ErrInvalidAction(DefaultCodespace,
+			fmt.Sprintf("the action must be signed by the council address %s alone", CouncilAddress(symbol)))
+	}
+	return action.ValidateBasic()
+}
+
+func containsAddress(addresses []sdk.AccAddress, address sdk.AccAddress) bool {
+	for _, a := range addresses {
+		if a.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
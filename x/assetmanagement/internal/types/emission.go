@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmissionSchedule mints a mintable token to a recipient every block between the start and end height. The rate can
+// decay, shrinking by DecayRate every DecayInterval blocks, and emissions stop once the total supply reaches MaxSupply
+type EmissionSchedule struct {
+	Symbol        string         `json:"symbol"`
+	Recipient     sdk.AccAddress `json:"recipient"`
+	Rate          sdk.Int        `json:"rate"`           // coins minted per block before any decay
+	StartHeight   int64          `json:"start_height"`   // first height minting
+	EndHeight     int64          `json:"end_height"`     // last height minting, zero to mint until MaxSupply
+	DecayInterval int64          `json:"decay_interval"` // blocks between decays, zero for a constant rate
+	DecayRate     sdk.Dec        `json:"decay_rate"`     // fraction the rate shrinks by at every decay
+	MaxSupply     sdk.Int        `json:"max_supply"`     // zero for no cap
+	Paused        bool           `json:"paused"`
+	Emitted       sdk.Int        `json:"emitted"` // coins minted by the schedule so far
+}
+
+// NewEmissionSchedule returns a new running emission schedule that hasn't emitted anything yet
+func NewEmissionSchedule(symbol string, recipient sdk.AccAddress, rate int64, startHeight, endHeight,
+	decayInterval int64, decayRate sdk.Dec, maxSupply int64) EmissionSchedule {
+	return EmissionSchedule{
+		Symbol:        symbol,
+		Recipient:     recipient,
+		Rate:          sdk.NewInt(rate),
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+		DecayInterval: decayInterval,
+		DecayRate:     decayRate,
+		MaxSupply:     sdk.NewInt(maxSupply),
+		Emitted:       sdk.ZeroInt(),
+	}
+}
+
+// ValidateEmission runs stateless checks on the parameters of an emission schedule
+func ValidateEmission(rate sdk.Int, startHeight, endHeight, decayInterval int64, decayRate sdk.Dec,
+	maxSupply sdk.Int) sdk.Error {
+	if isNilInt(rate) || !rate.IsPositive() {
+		return ErrInvalidEmission(DefaultCodespace, "Rate cannot be less than 1")
+	}
+	if startHeight < 1 {
+		return ErrInvalidEmission(DefaultCodespace, "StartHeight cannot be less than 1")
+	}
+	if endHeight != 0 && endHeight < startHeight {
+		return ErrInvalidEmission(DefaultCodespace,
+			fmt.Sprintf("EndHeight %d is before StartHeight %d", endHeight, startHeight))
+	}
+	if decayInterval < 0 {
+		return ErrInvalidEmission(DefaultCodespace, "DecayInterval cannot be negative")
+	}
+	if decayRate.IsNil() || decayRate.IsNegative() || decayRate.GTE(sdk.OneDec()) {
+		return ErrInvalidEmission(DefaultCodespace, "DecayRate must be at least 0 and less than 1")
+	}
+	if decayInterval == 0 && decayRate.IsPositive() {
+		return ErrInvalidEmission(DefaultCodespace, "DecayRate requires a DecayInterval")
+	}
+	if isNilInt(maxSupply) || maxSupply.IsNegative() {
+		return ErrInvalidEmission(DefaultCodespace, "MaxSupply cannot be negative")
+	}
+	if endHeight == 0 && maxSupply.IsZero() && decayRate.IsZero() {
+		return ErrInvalidEmission(DefaultCodespace, "an emission without EndHeight needs a MaxSupply or a decay")
+	}
+	return nil
+}
+
+// isNilInt reports whether an Int was never set, as happens to fields missing from JSON
+func isNilInt(i sdk.Int) bool {
+	return i == sdk.Int{}
+}
+
+// Validate runs stateless checks on the schedule
+func (e EmissionSchedule) Validate() sdk.Error {
+	if len(e.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if e.Recipient.Empty() {
+		return sdk.ErrInvalidAddress(e.Recipient.String())
+	}
+	if isNilInt(e.Emitted) || e.Emitted.IsNegative() {
+		return ErrInvalidEmission(DefaultCodespace, "Emitted cannot be negative")
+	}
+	return ValidateEmission(e.Rate, e.StartHeight, e.EndHeight, e.DecayInterval, e.DecayRate, e.MaxSupply)
+}
+
+// RateAt returns the amount minted at the given height, ignoring the end height and max supply
+func (e EmissionSchedule) RateAt(height int64) sdk.Int {
+	if height < e.StartHeight {
+		return sdk.ZeroInt()
+	}
+	if e.DecayInterval == 0 || e.DecayRate.IsZero() {
+		return e.Rate
+	}
+
+	// exponentiation by squaring, a handful of steps however many decays have passed
+	periods := (height - e.StartHeight) / e.DecayInterval
+	factor, base := sdk.OneDec(), sdk.OneDec().Sub(e.DecayRate)
+	for ; periods > 0 && !base.IsZero(); periods /= 2 {
+		if periods%2 == 1 {
+			factor = factor.Mul(base)
+		}
+		base = base.Mul(base)
+	}
+	if periods > 0 {
+		return sdk.ZeroInt()
+	}
+	return e.Rate.ToDec().Mul(factor).TruncateInt()
+}
+
+// String implements fmt.Stringer
+func (e EmissionSchedule) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Symbol: %s
+Recipient: %s
+Rate: %s
+Start Height: %d
+End Height: %d
+Decay Interval: %d
+Decay Rate: %s
+Max Supply: %s
+Paused: %t
+Emitted: %s`, e.Symbol, e.Recipient, e.Rate, e.StartHeight, e.EndHeight, e.DecayInterval, e.DecayRate,
+		e.MaxSupply, e.Paused, e.Emitted))
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEmissionRateAt(t *testing.T) {
+	_, _, recipient := KeyTestPubAddr()
+	constant := NewEmissionSchedule("zap123", recipient, 100, 10, 20, 0, sdk.ZeroDec(), 0)
+	require.True(t, constant.RateAt(9).IsZero())
+	require.Equal(t, sdk.NewInt(100), constant.RateAt(10))
+	require.Equal(t, sdk.NewInt(100), constant.RateAt(1000))
+
+	// halves every 5 blocks
+	decaying := NewEmissionSchedule("zap123", recipient, 1000, 10, 0, 5, sdk.NewDecWithPrec(5, 1), 0)
+	require.Equal(t, sdk.NewInt(1000), decaying.RateAt(14))
+	require.Equal(t, sdk.NewInt(500), decaying.RateAt(15))
+	require.Equal(t, sdk.NewInt(250), decaying.RateAt(24))
+	require.Equal(t, sdk.NewInt(1), decaying.RateAt(10+5*9))
+	require.True(t, decaying.RateAt(10+5*10).IsZero())
+	require.True(t, decaying.RateAt(1<<62).IsZero())
+}
+
+func TestValidateEmission(t *testing.T) {
+	_, _, recipient := KeyTestPubAddr()
+	require.Nil(t, NewEmissionSchedule("zap123", recipient, 100, 10, 20, 0, sdk.ZeroDec(), 0).Validate())
+	require.Nil(t, NewEmissionSchedule("zap123", recipient, 100, 10, 0, 0, sdk.ZeroDec(), 5000).Validate())
+	require.Nil(t, NewEmissionSchedule("zap123", recipient, 100, 10, 0, 5, sdk.NewDecWithPrec(1, 1), 0).Validate())
+
+	for _, emission := range []EmissionSchedule{
+		NewEmissionSchedule("zap123", recipient, 0, 10, 20, 0, sdk.ZeroDec(), 0),
+		NewEmissionSchedule("zap123", recipient, 100, 0, 20, 0, sdk.ZeroDec(), 0),
+		NewEmissionSchedule("zap123", recipient, 100, 10, 9, 0, sdk.ZeroDec(), 0),
+		NewEmissionSchedule("zap123", recipient, 100, 10, 20, 0, sdk.NewDecWithPrec(1, 1), 0),
+		NewEmissionSchedule("zap123", recipient, 100, 10, 20, 5, sdk.OneDec(), 0),
+		NewEmissionSchedule("zap123", recipient, 100, 10, 20, 0, sdk.ZeroDec(), -1),
+		// would emit forever
+		NewEmissionSchedule("zap123", recipient, 100, 10, 0, 0, sdk.ZeroDec(), 0),
+	} {
+		err := emission.Validate()
+		require.NotNil(t, err, emission.String())
+		require.Equal(t, CodeInvalidEmission, err.Code())
+	}
+
+	msg := NewMsgSetEmission(recipient, "zap123", recipient, 100, 10, 20, 0, sdk.Dec{}, 0)
+	require.Equal(t, CodeInvalidEmission, msg.ValidateBasic().Code())
+}
@@ -4,12 +4,302 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Error codes are part of the module's API, clients branch on them. Never renumber or reuse a code, only append
 const (
 	DefaultCodespace sdk.CodespaceType = ModuleName
 
-	CodeTokenSymbolDoesNotExist sdk.CodeType = 101
+	CodeTokenSymbolDoesNotExist  sdk.CodeType = 101
+	CodeTokenSymbolAlreadyExists sdk.CodeType = 102
+	CodeInvalidSymbol            sdk.CodeType = 103
+	CodeInvalidTokenName         sdk.CodeType = 104
+	CodeInvalidAmount            sdk.CodeType = 105
+	CodeInvalidOwner             sdk.CodeType = 106
+	CodeTokenNotMintable         sdk.CodeType = 107
+	CodeInsufficientCoins        sdk.CodeType = 108
+	CodeInsufficientFrozenCoins  sdk.CodeType = 109
+	CodeUnknownAccount           sdk.CodeType = 110
+	CodeAccountCannotFreeze      sdk.CodeType = 111
+	CodeMissingOwner             sdk.CodeType = 112
+	CodeInvalidToken             sdk.CodeType = 113
+	CodeInvalidRecipients        sdk.CodeType = 114
+	CodeInvalidCampaign          sdk.CodeType = 115
+	CodeCampaignDoesNotExist     sdk.CodeType = 116
+	CodeCampaignExpired          sdk.CodeType = 117
+	CodeInvalidClaimProof        sdk.CodeType = 118
+	CodeAlreadyClaimed           sdk.CodeType = 119
+	CodeInvalidVestingGrant      sdk.CodeType = 120
+	CodeVestingGrantDoesNotExist sdk.CodeType = 121
+	CodeNotBeneficiary           sdk.CodeType = 122
+	CodeNothingVested            sdk.CodeType = 123
+	CodeInvalidEmission          sdk.CodeType = 124
+	CodeEmissionDoesNotExist     sdk.CodeType = 125
+	CodeInvalidAllowance         sdk.CodeType = 126
+	CodeAllowanceDoesNotExist    sdk.CodeType = 127
+	CodeAllowanceExpired         sdk.CodeType = 128
+	CodeInsufficientAllowance    sdk.CodeType = 129
+	CodeTokenNotClawbackable     sdk.CodeType = 130
+	CodeInvalidClawback          sdk.CodeType = 131
+	CodeNotClawbackAuthority     sdk.CodeType = 132
+	CodeInvalidTransferFee       sdk.CodeType = 133
+	CodeTransferFeeDoesNotExist  sdk.CodeType = 134
+	CodeTransferNotAllowed       sdk.CodeType = 135
+	CodeInvalidHoldingLimits     sdk.CodeType = 136
+	CodeMaxBalanceExceeded       sdk.CodeType = 137
+	CodeMaxHoldersReached        sdk.CodeType = 138
+	CodeInvalidRedenomination    sdk.CodeType = 139
+	CodeInvalidConversion        sdk.CodeType = 140
+	CodeConversionDoesNotExist   sdk.CodeType = 141
+	CodeTokenDisabled            sdk.CodeType = 142
+	CodeInvalidTokenStatus       sdk.CodeType = 143
+	CodeTokenDeprecated          sdk.CodeType = 144
+	CodeInvalidCouncil           sdk.CodeType = 145
+	CodeCouncilDoesNotExist      sdk.CodeType = 146
+	CodeNotCouncilMember         sdk.CodeType = 147
+	CodeInvalidAction            sdk.CodeType = 148
+	CodeActionDoesNotExist       sdk.CodeType = 149
+	CodeInvalidTimelock          sdk.CodeType = 150
+	CodeQueuedActionDoesNotExist sdk.CodeType = 151
+	CodeInvalidGrant             sdk.CodeType = 152
+	CodeGrantDoesNotExist        sdk.CodeType = 153
+	CodeGrantExpired             sdk.CodeType = 154
+	CodeInsufficientGrant        sdk.CodeType = 155
+	CodeTokenPaused              sdk.CodeType = 156
 )
 
-func ErrTokenSymbolDoesNotExist(codespace sdk.CodespaceType) sdk.Error {
-	return sdk.NewError(codespace, CodeTokenSymbolDoesNotExist, "Token symbol does not exist")
+func ErrTokenSymbolDoesNotExist(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenSymbolDoesNotExist, "token symbol '%s' does not exist", symbol)
+}
+
+func ErrTokenSymbolAlreadyExists(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenSymbolAlreadyExists, "token symbol '%s' already exists", symbol)
+}
+
+func ErrInvalidSymbol(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSymbol, "%s", msg)
+}
+
+func ErrInvalidTokenName(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTokenName, "%s", msg)
+}
+
+func ErrInvalidAmount(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAmount, "%s", msg)
+}
+
+func ErrInvalidOwner(codespace sdk.CodespaceType, address sdk.AccAddress, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidOwner, "%s is not the owner of token '%s'", address, symbol)
+}
+
+func ErrTokenNotMintable(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenNotMintable, "token '%s' is not mintable", symbol)
+}
+
+func ErrInsufficientCoins(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientCoins, "%s", msg)
+}
+
+func ErrInsufficientFrozenCoins(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientFrozenCoins, "%s", msg)
+}
+
+func ErrUnknownAccount(codespace sdk.CodespaceType, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownAccount, "account %s does not exist", address)
+}
+
+func ErrAccountCannotFreeze(codespace sdk.CodespaceType, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountCannotFreeze,
+		"account %s is of a type that cannot hold frozen coins", address)
+}
+
+func ErrMissingOwner(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeMissingOwner, "token '%s' has no owner", symbol)
+}
+
+func ErrInvalidToken(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidToken, "%s", msg)
+}
+
+func ErrInvalidRecipients(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRecipients, "%s", msg)
+}
+
+func ErrInvalidCampaign(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCampaign, "%s", msg)
+}
+
+func ErrCampaignDoesNotExist(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeCampaignDoesNotExist, "claim campaign %d does not exist", id)
+}
+
+func ErrCampaignExpired(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeCampaignExpired, "claim campaign %d has ended", id)
+}
+
+func ErrInvalidClaimProof(codespace sdk.CodespaceType, address sdk.AccAddress, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClaimProof,
+		"proof doesn't show that %s can claim the amount from campaign %d", address, id)
+}
+
+func ErrAlreadyClaimed(codespace sdk.CodespaceType, address sdk.AccAddress, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeAlreadyClaimed, "%s already claimed from campaign %d", address, id)
+}
+
+func ErrInvalidVestingGrant(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVestingGrant, "%s", msg)
+}
+
+func ErrVestingGrantDoesNotExist(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeVestingGrantDoesNotExist, "vesting grant %d does not exist", id)
+}
+
+func ErrNotBeneficiary(codespace sdk.CodespaceType, address sdk.AccAddress, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotBeneficiary, "%s is not the beneficiary of vesting grant %d", address, id)
+}
+
+func ErrNothingVested(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNothingVested, "vesting grant %d has no vested coins left to release", id)
+}
+
+func ErrInvalidEmission(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEmission, "%s", msg)
+}
+
+func ErrEmissionDoesNotExist(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeEmissionDoesNotExist, "token '%s' has no emission schedule", symbol)
+}
+
+func ErrInvalidAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAllowance, "%s", msg)
+}
+
+func ErrAllowanceDoesNotExist(codespace sdk.CodespaceType, owner, spender sdk.AccAddress, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeAllowanceDoesNotExist, "%s has no allowance to spend '%s' of %s",
+		spender, symbol, owner)
+}
+
+func ErrAllowanceExpired(codespace sdk.CodespaceType, owner, spender sdk.AccAddress, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeAllowanceExpired, "the allowance of %s to spend '%s' of %s has expired",
+		spender, symbol, owner)
+}
+
+func ErrInsufficientAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientAllowance, "%s", msg)
+}
+
+func ErrTokenNotClawbackable(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenNotClawbackable, "token '%s' was not issued with clawback enabled", symbol)
+}
+
+func ErrInvalidClawback(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClawback, "%s", msg)
+}
+
+func ErrNotClawbackAuthority(codespace sdk.CodespaceType, address sdk.AccAddress, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotClawbackAuthority,
+		"%s is neither the owner nor the clawback admin of token '%s'", address, symbol)
+}
+
+func ErrInvalidTransferFee(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTransferFee, "%s", msg)
+}
+
+func ErrTransferFeeDoesNotExist(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeTransferFeeDoesNotExist, "token '%s' has no transfer fee", symbol)
+}
+
+func ErrTransferNotAllowed(codespace sdk.CodespaceType, symbol string, from, to sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeTransferNotAllowed,
+		"the transfer mode of token '%s' doesn't allow transfers from %s to %s", symbol, from, to)
+}
+
+func ErrInvalidHoldingLimits(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHoldingLimits, "%s", msg)
+}
+
+func ErrMaxBalanceExceeded(codespace sdk.CodespaceType, symbol string, address sdk.AccAddress, balance sdk.Int,
+	max int64) sdk.Error {
+	return sdk.NewError(codespace, CodeMaxBalanceExceeded,
+		"%s would hold %s '%s', more than the %d an account may hold", address, balance, symbol, max)
+}
+
+func ErrMaxHoldersReached(codespace sdk.CodespaceType, symbol string, max uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeMaxHoldersReached,
+		"token '%s' already has the %d holders it may have, the transfer would add another", symbol, max)
+}
+
+// ErrInvalidRedenomination is an error
+func ErrInvalidRedenomination(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRedenomination, "%s", msg)
+}
+
+func ErrInvalidConversion(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidConversion, "%s", msg)
+}
+
+func ErrConversionDoesNotExist(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeConversionDoesNotExist, "token '%s' has no conversion window", symbol)
+}
+
+func ErrTokenDisabled(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenDisabled, "token '%s' is disabled", symbol)
+}
+
+func ErrInvalidTokenStatus(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTokenStatus, "%s", msg)
+}
+
+func ErrTokenDeprecated(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenDeprecated, "token '%s' is deprecated", symbol)
+}
+
+func ErrInvalidCouncil(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCouncil, "%s", msg)
+}
+
+func ErrCouncilDoesNotExist(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeCouncilDoesNotExist, "token '%s' is not owned by a council", symbol)
+}
+
+func ErrNotCouncilMember(codespace sdk.CodespaceType, address sdk.AccAddress, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotCouncilMember, "%s is not a member of the council of token '%s'", address,
+		symbol)
+}
+
+func ErrInvalidAction(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAction, "%s", msg)
+}
+
+func ErrActionDoesNotExist(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeActionDoesNotExist, "pending action %d does not exist", id)
+}
+
+func ErrInvalidTimelock(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTimelock, "%s", msg)
+}
+
+func ErrQueuedActionDoesNotExist(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeQueuedActionDoesNotExist, "queued action %d does not exist", id)
+}
+
+func ErrInvalidGrant(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGrant, "%s", msg)
+}
+
+func ErrGrantDoesNotExist(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, symbol,
+	msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeGrantDoesNotExist, "%s has no grant to sign '%s' messages of '%s' for %s",
+		grantee, msgType, symbol, granter)
+}
+
+func ErrGrantExpired(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, symbol, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeGrantExpired, "the grant of %s to sign '%s' messages of '%s' for %s has expired",
+		grantee, msgType, symbol, granter)
+}
+
+func ErrInsufficientGrant(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientGrant, "%s", msg)
+}
+
+func ErrTokenPaused(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenPaused, "token '%s' is paused", symbol)
 }
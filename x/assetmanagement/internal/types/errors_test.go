@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Clients depend on these values, a failure here means a code was renumbered
+func TestErrorCodesAreStable(t *testing.T) {
+	_, _, address := KeyTestPubAddr()
+	cases := []struct {
+		err  sdk.Error
+		code sdk.CodeType
+	}{
+		{ErrTokenSymbolDoesNotExist(DefaultCodespace, "abc123"), 101},
+		{ErrTokenSymbolAlreadyExists(DefaultCodespace, "abc123"), 102},
+		{ErrInvalidSymbol(DefaultCodespace, ""), 103},
+		{ErrInvalidTokenName(DefaultCodespace, ""), 104},
+		{ErrInvalidAmount(DefaultCodespace, ""), 105},
+		{ErrInvalidOwner(DefaultCodespace, address, "abc123"), 106},
+		{ErrTokenNotMintable(DefaultCodespace, "abc123"), 107},
+		{ErrInsufficientCoins(DefaultCodespace, ""), 108},
+		{ErrInsufficientFrozenCoins(DefaultCodespace, ""), 109},
+		{ErrUnknownAccount(DefaultCodespace, address), 110},
+		{ErrAccountCannotFreeze(DefaultCodespace, address), 111},
+		{ErrMissingOwner(DefaultCodespace, "abc123"), 112},
+		{ErrInvalidToken(DefaultCodespace, ""), 113},
+		{ErrInvalidRecipients(DefaultCodespace, ""), 114},
+		{ErrInvalidCampaign(DefaultCodespace, ""), 115},
+		{ErrCampaignDoesNotExist(DefaultCodespace, 1), 116},
+		{ErrCampaignExpired(DefaultCodespace, 1), 117},
+		{ErrInvalidClaimProof(DefaultCodespace, address, 1), 118},
+		{ErrAlreadyClaimed(DefaultCodespace, address, 1), 119},
+		{ErrInvalidVestingGrant(DefaultCodespace, ""), 120},
+		{ErrVestingGrantDoesNotExist(DefaultCodespace, 1), 121},
+		{ErrNotBeneficiary(DefaultCodespace, address, 1), 122},
+		{ErrNothingVested(DefaultCodespace, 1), 123},
+		{ErrInvalidEmission(DefaultCodespace, ""), 124},
+		{ErrEmissionDoesNotExist(DefaultCodespace, "abc123"), 125},
+		{ErrInvalidAllowance(DefaultCodespace, ""), 126},
+		{ErrAllowanceDoesNotExist(DefaultCodespace, address, address, "abc123"), 127},
+		{ErrAllowanceExpired(DefaultCodespace, address, address, "abc123"), 128},
+		{ErrInsufficientAllowance(DefaultCodespace, ""), 129},
+		{ErrTokenNotClawbackable(DefaultCodespace, "abc123"), 130},
+		{ErrInvalidClawback(DefaultCodespace, ""), 131},
+		{ErrNotClawbackAuthority(DefaultCodespace, address, "abc123"), 132},
+		{ErrInvalidTransferFee(DefaultCodespace, ""), 133},
+		{ErrTransferFeeDoesNotExist(DefaultCodespace, "abc123"), 134},
+		{ErrTransferNotAllowed(DefaultCodespace, "abc123", address, address), 135},
+		{ErrInvalidHoldingLimits(DefaultCodespace, ""), 136},
+		{ErrMaxBalanceExceeded(DefaultCodespace, "abc123", address, sdk.OneInt(), 0), 137},
+		{ErrMaxHoldersReached(DefaultCodespace, "abc123", 1), 138},
+		{ErrInvalidRedenomination(DefaultCodespace, ""), 139},
+		{ErrInvalidConversion(DefaultCodespace, ""), 140},
+		{ErrConversionDoesNotExist(DefaultCodespace, ""), 141},
+		{ErrTokenDisabled(DefaultCodespace, ""), 142},
+		{ErrInvalidTokenStatus(DefaultCodespace, ""), 143},
+		{ErrTokenDeprecated(DefaultCodespace, ""), 144},
+		{ErrInvalidCouncil(DefaultCodespace, ""), 145},
+		{ErrCouncilDoesNotExist(DefaultCodespace, ""), 146},
+		{ErrNotCouncilMember(DefaultCodespace, nil, ""), 147},
+		{ErrInvalidAction(DefaultCodespace, ""), 148},
+		{ErrActionDoesNotExist(DefaultCodespace, 0), 149},
+		{ErrInvalidTimelock(DefaultCodespace, ""), 150},
+		{ErrQueuedActionDoesNotExist(DefaultCodespace, 0), 151},
+		{ErrInvalidGrant(DefaultCodespace, ""), 152},
+		{ErrGrantDoesNotExist(DefaultCodespace, nil, nil, "", ""), 153},
+		{ErrGrantExpired(DefaultCodespace, nil, nil, "", ""), 154},
+		{ErrInsufficientGrant(DefaultCodespace, ""), 155},
+		{ErrTokenPaused(DefaultCodespace, "abc123"), 156},
+	}
+
+	require.Equal(t, sdk.CodespaceType("assetmanagement"), DefaultCodespace)
+	for _, tc := range cases {
+		require.Equal(t, tc.code, tc.err.Code(), tc.err.Error())
+		require.Equal(t, DefaultCodespace, tc.err.Codespace())
+	}
+}
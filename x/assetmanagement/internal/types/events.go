@@ -0,0 +1,66 @@
+package types
+
+// assetmanagement module event types and attribute keys
+const (
+	EventTypeApprove         = "approve"
+	EventTypeTransferFrom    = "transfer_from"
+	EventTypeClawback        = "clawback"
+	EventTypeTransferFee     = "transfer_fee"
+	EventTypeHoldingLimits   = "holding_limits"
+	EventTypeRedenominate    = "redenominate"
+	EventTypeOpenConversion  = "open_conversion"
+	EventTypeConvert         = "convert"
+	EventTypeCloseConversion = "close_conversion"
+	EventTypeTokenStatus     = "token_status"
+	EventTypeSetCouncil      = "set_council"
+	EventTypeProposeAction   = "propose_action"
+	EventTypeApproveAction   = "approve_action"
+	EventTypeRevokeApproval  = "revoke_approval"
+	EventTypeExecuteAction   = "execute_action"
+	EventTypeExpireAction    = "expire_action"
+	EventTypeSetTimelock     = "set_timelock"
+	EventTypeQueueAction     = "queue_action"
+	EventTypeCancelAction    = "cancel_queued_action"
+	EventTypeRunAction       = "run_queued_action"
+	EventTypeGrant           = "grant"
+	EventTypeRevokeGrant     = "revoke_grant"
+	EventTypeExec            = "exec"
+	EventTypeOwnership       = "transfer_ownership"
+	EventTypeTokenMetadata   = "token_metadata"
+	EventTypeTokenPaused     = "token_paused"
+
+	AttributeKeyOwner      = "owner"
+	AttributeKeySpender    = "spender"
+	AttributeKeySender     = "sender"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeySymbol     = "symbol"
+	AttributeKeyExpiry     = "expiry"
+	AttributeKeyRemaining  = "remaining"
+	AttributeKeyAuthority  = "authority"
+	AttributeKeyReason     = "reason"
+	AttributeKeyClawback   = "clawback_id"
+	AttributeKeyMaxBalance = "max_balance_per_account"
+	AttributeKeyMaxHolders = "max_holders"
+	AttributeKeyRatio      = "ratio"
+	AttributeKeyDust       = "dust"
+	AttributeKeyTarget     = "target"
+	AttributeKeyDeadline   = "deadline"
+	AttributeKeyReceived   = "received"
+	AttributeKeyStatus     = "status"
+	AttributeKeyThreshold  = "threshold"
+	AttributeKeyActionID   = "action_id"
+	AttributeKeyAction     = "action"
+	AttributeKeyApprovals  = "approvals"
+	AttributeKeyDelay      = "delay"
+	AttributeKeyETA        = "eta"
+	AttributeKeyResult     = "result"
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMsgType    = "msg_type"
+	AttributeKeySpendLimit = "spend_limit"
+	AttributeKeyNewOwner   = "new_owner"
+	AttributeKeyName       = "name"
+	AttributeKeyPaused     = "paused"
+
+	AttributeValueCategory = ModuleName
+)
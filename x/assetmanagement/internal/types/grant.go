@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxExecMsgs is the most messages a single MsgExec can run on behalf of their granters
+const MaxExecMsgs = 10
+
+// grantableMsgs are the types of the messages a grant can let another account sign, mapped to whether they spend an
+// amount of the token. Rule changes are left out, they stay with the owner's key and under its timelock
+var grantableMsgs = map[string]bool{
+	MsgMintCoins{}.Type():           true,
+	MsgBurnCoins{}.Type():           true,
+	MsgFreezeCoins{}.Type():         true,
+	MsgUnfreezeCoins{}.Type():       true,
+	MsgDistribute{}.Type():          true,
+	MsgCreateClaimCampaign{}.Type(): true,
+	MsgCreateVestingGrant{}.Type():  true,
+	MsgClawback{}.Type():            true,
+	MsgSetEmissionPaused{}.Type():   false,
+}
+
+// GrantableMsgTypes returns the types of the messages a grant can be issued for, in alphabetical order
+func GrantableMsgTypes() []string {
+	msgTypes := make([]string, 0, len(grantableMsgs))
+	for msgType := range grantableMsgs {
+		msgTypes = append(msgTypes, msgType)
+	}
+	sort.Strings(msgTypes)
+	return msgTypes
+}
+
+// GrantedMsg tells whether a grant can let another account sign a message, and returns the symbol of the token it
+// acts on, the granter signing it and the amount of the token it spends
+func GrantedMsg(msg sdk.Msg) (symbol string, granter sdk.AccAddress, spend sdk.Int, ok bool) {
+	switch msg := msg.(type) {
+	case MsgMintCoins:
+		return msg.Symbol, msg.Owner, sdk.NewInt(msg.Amount), true
+	case MsgBurnCoins:
+		return msg.Symbol, msg.Owner, sdk.NewInt(msg.Amount), true
+	case MsgFreezeCoins:
+		return msg.Symbol, msg.Owner, sdk.NewInt(msg.Amount), true
+	case MsgUnfreezeCoins:
+		return msg.Symbol, msg.Owner, sdk.NewInt(msg.Amount), true
+	case MsgDistribute:
+		total := sdk.ZeroInt()
+		for _, recipient := range msg.Recipients {
+			total = total.AddRaw(recipient.Amount)
+		}
+		return msg.Symbol, msg.Sender, total, true
+	case MsgCreateClaimCampaign:
+		return msg.Symbol, msg.Owner, sdk.NewInt(msg.Deposit), true
+	case MsgCreateVestingGrant:
+		return msg.Symbol, msg.Owner, sdk.NewInt(msg.Total), true
+	case MsgClawback:
+		return msg.Symbol, msg.Authority, sdk.NewInt(msg.Amount), true
+	case MsgSetEmissionPaused:
+		return msg.Symbol, msg.Owner, sdk.ZeroInt(), true
+	default:
+		return "", nil, sdk.ZeroInt(), false
+	}
+}
+
+// Grant lets the grantee sign the messages of one type over a token on behalf of the granter, through MsgExec, until
+// the expiry. The messages that spend an amount of the token take it off the spend limit and the grant is used up
+// once nothing is left. A zero spend limit is unlimited and a zero expiry never expires
+type Grant struct {
+	Granter    sdk.AccAddress `json:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee"`
+	Symbol     string         `json:"symbol"`
+	MsgType    string         `json:"msg_type"`
+	SpendLimit sdk.Int        `json:"spend_limit"`
+	Expiry     time.Time      `json:"expiry"`
+}
+
+// NewGrant returns a new grant
+func NewGrant(granter, grantee sdk.AccAddress, symbol, msgType string, spendLimit int64, expiry time.Time) Grant {
+	return Grant{
+		Granter:    granter,
+		Grantee:    grantee,
+		Symbol:     symbol,
+		MsgType:    msgType,
+		SpendLimit: sdk.NewInt(spendLimit),
+		Expiry:     expiry,
+	}
+}
+
+// IsExpired tells whether the grant can no longer be used at the given time
+func (g Grant) IsExpired(blockTime time.Time) bool {
+	return !g.Expiry.IsZero() && !blockTime.Before(g.Expiry)
+}
+
+// IsLimited tells whether the grant has a spend limit
+func (g Grant) IsLimited() bool {
+	return g.SpendLimit.IsPositive()
+}
+
+// Validate runs stateless checks on the grant
+func (g Grant) Validate() sdk.Error {
+	if g.Granter.Empty() || g.Grantee.Empty() {
+		return ErrInvalidGrant(DefaultCodespace, "Granter and Grantee cannot be empty")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return ErrInvalidGrant(DefaultCodespace, "Granter cannot grant itself")
+	}
+	if g.Symbol == "" {
+		return ErrInvalidGrant(DefaultCodespace, "Symbol cannot be empty")
+	}
+	spends, ok := grantableMsgs[g.MsgType]
+	if !ok {
+		return ErrInvalidGrant(DefaultCodespace, fmt.Sprintf("'%s' messages cannot be granted, only %s", g.MsgType,
+			strings.Join(GrantableMsgTypes(), ", ")))
+	}
+	if isNilInt(g.SpendLimit) || g.SpendLimit.IsNegative() {
+		return ErrInvalidGrant(DefaultCodespace, fmt.Sprintf("spend limit %s cannot be negative", g.SpendLimit))
+	}
+	if g.IsLimited() && !spends {
+		return ErrInvalidGrant(DefaultCodespace, fmt.Sprintf("'%s' messages spend nothing to limit", g.MsgType))
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (g Grant) String() string {
+	limit := g.SpendLimit.String()
+	if !g.IsLimited() {
+		limit = "none"
+	}
+	return strings.TrimSpace(fmt.Sprintf(`Granter: %s
+Grantee: %s
+Symbol: %s
+Msg Type: %s
+Spend Limit: %s
+Expiry: %s`, g.Granter, g.Grantee, g.Symbol, g.MsgType, limit, g.Expiry))
+}
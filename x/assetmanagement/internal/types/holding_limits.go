@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateHoldingLimits checks the holding limits of a token, 0 leaves a limit off
+func ValidateHoldingLimits(maxBalancePerAccount int64, maxHolders uint64) sdk.Error {
+	if maxBalancePerAccount < 0 {
+		return ErrInvalidHoldingLimits(DefaultCodespace, "MaxBalancePerAccount cannot be negative")
+	}
+	return nil
+}
+
+// HasHoldingLimits tells whether the token limits the balance of its holders or how many there can be
+func (t Token) HasHoldingLimits() bool {
+	return t.MaxBalancePerAccount > 0 || t.MaxHolders > 0
+}
+
+// QueryResultHolders is a payload for a holders query, reporting how many holders a token has against its limits
+type QueryResultHolders struct {
+	Symbol               string `json:"symbol"`
+	Holders              uint64 `json:"holders"`
+	MaxHolders           uint64 `json:"max_holders"`
+	MaxBalancePerAccount int64  `json:"max_balance_per_account"`
+}
+
+// String implements fmt.Stringer
+func (r QueryResultHolders) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Symbol: %s
+Holders: %d
+Max Holders: %d
+Max Balance Per Account: %d`, r.Symbol, r.Holders, r.MaxHolders, r.MaxBalancePerAccount))
+}
+
+// QueryResultHolding is a payload for a holding query, reporting the balance of an account against the token's
+// balance limit. Remaining is how much more the account may receive, it is only set when the limit applies
+type QueryResultHolding struct {
+	Symbol               string         `json:"symbol"`
+	Address              sdk.AccAddress `json:"address"`
+	Balance              sdk.Int        `json:"balance"` // free plus frozen
+	MaxBalancePerAccount int64          `json:"max_balance_per_account"`
+	Limited              bool           `json:"limited"` // the owner and module accounts aren't limited
+	Remaining            sdk.Int        `json:"remaining"`
+}
+
+// String implements fmt.Stringer
+func (r QueryResultHolding) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Symbol: %s
+Address: %s
+Balance: %s
+Max Balance Per Account: %d
+Limited: %v
+Remaining: %s`, r.Symbol, r.Address, r.Balance, r.MaxBalancePerAccount, r.Limited, r.Remaining))
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AssetHooks lets other modules react to token lifecycle events
+type AssetHooks interface {
+	AfterTokenIssued(ctx sdk.Context, token Token)                                            // Must be called when a token is issued
+	AfterMint(ctx sdk.Context, symbol string, recipient sdk.AccAddress, coins sdk.Coins)      // Must be called after coins are minted
+	AfterBurn(ctx sdk.Context, symbol string, holder sdk.AccAddress, coins sdk.Coins)         // Must be called after coins are burned
+	AfterFreeze(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins)                     // Must be called after coins are frozen
+	AfterUnfreeze(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins)                   // Must be called after coins are unfrozen
+	AfterOwnerChanged(ctx sdk.Context, symbol string, previousOwner, newOwner sdk.AccAddress) // Must be called when a token changes owner
+}
+
+// MultiAssetHooks combines multiple asset hooks, all hook functions are run in array sequence
+type MultiAssetHooks []AssetHooks
+
+func NewMultiAssetHooks(hooks ...AssetHooks) MultiAssetHooks {
+	return hooks
+}
+
+func (h MultiAssetHooks) AfterTokenIssued(ctx sdk.Context, token Token) {
+	for i := range h {
+		h[i].AfterTokenIssued(ctx, token)
+	}
+}
+
+func (h MultiAssetHooks) AfterMint(ctx sdk.Context, symbol string, recipient sdk.AccAddress, coins sdk.Coins) {
+	for i := range h {
+		h[i].AfterMint(ctx, symbol, recipient, coins)
+	}
+}
+
+func (h MultiAssetHooks) AfterBurn(ctx sdk.Context, symbol string, holder sdk.AccAddress, coins sdk.Coins) {
+	for i := range h {
+		h[i].AfterBurn(ctx, symbol, holder, coins)
+	}
+}
+
+func (h MultiAssetHooks) AfterFreeze(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
+	for i := range h {
+		h[i].AfterFreeze(ctx, address, coins)
+	}
+}
+
+func (h MultiAssetHooks) AfterUnfreeze(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
+	for i := range h {
+		h[i].AfterUnfreeze(ctx, address, coins)
+	}
+}
+
+func (h MultiAssetHooks) AfterOwnerChanged(ctx sdk.Context, symbol string, previousOwner, newOwner sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterOwnerChanged(ctx, symbol, previousOwner, newOwner)
+	}
+}
@@ -1,9 +1,265 @@
 package types
 
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// module name
 	ModuleName = "assetmanagement"
 
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
+
+	// FrozenPoolName is the module account holding all coins frozen by accounts
+	FrozenPoolName = "frozen_tokens_pool"
+
+	// ClaimsPoolName is the module account holding the deposits of claim campaigns
+	ClaimsPoolName = "claims_pool"
+
+	// VestingPoolName is the module account holding the coins of vesting grants until they are released
+	VestingPoolName = "vesting_pool"
 )
+
+// Tokens are stored under their bare symbol. Every other record is stored under a single byte prefix below
+// TokenKeysStart, which no symbol can start with
+var (
+	CampaignKeyPrefix        = []byte{0x01}
+	ClaimedKeyPrefix         = []byte{0x02}
+	NextCampaignIDKey        = []byte{0x03}
+	CampaignQueueKeyPrefix   = []byte{0x04}
+	VestingGrantKeyPrefix    = []byte{0x05}
+	TokenVestingKeyPrefix    = []byte{0x06}
+	BeneficiaryKeyPrefix     = []byte{0x07}
+	NextVestingGrantIDKey    = []byte{0x08}
+	EmissionKeyPrefix        = []byte{0x09}
+	AllowanceKeyPrefix       = []byte{0x0A}
+	SpenderKeyPrefix         = []byte{0x0B}
+	ClawbackKeyPrefix        = []byte{0x0C}
+	NextClawbackIDKey        = []byte{0x0D}
+	TransferFeeKeyPrefix     = []byte{0x0E}
+	HolderKeyPrefix          = []byte{0x0F}
+	HolderCountKeyPrefix     = []byte{0x10}
+	RedenominationKeyPrefix  = []byte{0x11}
+	ConversionKeyPrefix      = []byte{0x12}
+	ConversionQueueKeyPrefix = []byte{0x13}
+	ArchivedTokenKeyPrefix   = []byte{0x14}
+	CouncilKeyPrefix         = []byte{0x15}
+	ActionKeyPrefix          = []byte{0x16}
+	NextActionIDKey          = []byte{0x17}
+	ActionQueueKeyPrefix     = []byte{0x18}
+	QueuedActionKeyPrefix    = []byte{0x19}
+	NextQueuedActionIDKey    = []byte{0x1A}
+	TimelockQueueKeyPrefix   = []byte{0x1B}
+	GrantKeyPrefix           = []byte{0x1C}
+	GranteeKeyPrefix         = []byte{0x1D}
+
+	TokenKeysStart = []byte{0x20}
+)
+
+// NormalizeSymbol turns a prettified symbol, eg ABC-123, into the form it is stored under, eg abc123
+func NormalizeSymbol(symbol string) string {
+	clean := strings.Replace(symbol, "-", "", -1)
+	return strings.ToLower(clean)
+}
+
+// TokenKey returns the store key a token is saved under
+func TokenKey(symbol string) []byte {
+	return []byte(symbol)
+}
+
+// CampaignKey returns the store key a claim campaign is saved under
+func CampaignKey(id uint64) []byte {
+	return append(CampaignKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ClaimedKeysPrefix returns the prefix of the keys marking the addresses that claimed from a campaign
+func ClaimedKeysPrefix(id uint64) []byte {
+	return append(ClaimedKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ClaimedKey returns the store key marking that an address claimed from a campaign
+func ClaimedKey(id uint64, address sdk.AccAddress) []byte {
+	return append(ClaimedKeysPrefix(id), address...)
+}
+
+// CampaignQueueKey returns the key a campaign is queued under until it expires, ordered by end time
+func CampaignQueueKey(endTime time.Time, id uint64) []byte {
+	return append(CampaignQueueTimeKey(endTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// CampaignQueueTimeKey returns the prefix of the queue keys of campaigns ending at the given time
+func CampaignQueueTimeKey(endTime time.Time) []byte {
+	return append(CampaignQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// VestingGrantKey returns the store key a vesting grant is saved under
+func VestingGrantKey(id uint64) []byte {
+	return append(VestingGrantKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// TokenVestingKeysPrefix returns the prefix of the index keys of the vesting grants of a token
+func TokenVestingKeysPrefix(symbol string) []byte {
+	// the symbol is length prefixed so that one symbol can't be the prefix of another
+	return append(append(TokenVestingKeyPrefix, byte(len(symbol))), symbol...)
+}
+
+// TokenVestingKey returns the index key of a vesting grant of a token
+func TokenVestingKey(symbol string, id uint64) []byte {
+	return append(TokenVestingKeysPrefix(symbol), sdk.Uint64ToBigEndian(id)...)
+}
+
+// EmissionKey returns the store key the emission schedule of a token is saved under
+func EmissionKey(symbol string) []byte {
+	return append(EmissionKeyPrefix, symbol...)
+}
+
+// BeneficiaryKeysPrefix returns the prefix of the index keys of the vesting grants of a beneficiary
+func BeneficiaryKeysPrefix(beneficiary sdk.AccAddress) []byte {
+	return append(append(BeneficiaryKeyPrefix, byte(len(beneficiary))), beneficiary...)
+}
+
+// BeneficiaryKey returns the index key of a vesting grant of a beneficiary
+func BeneficiaryKey(beneficiary sdk.AccAddress, id uint64) []byte {
+	return append(BeneficiaryKeysPrefix(beneficiary), sdk.Uint64ToBigEndian(id)...)
+}
+
+// OwnerAllowancesPrefix returns the prefix of the keys of the allowances an owner granted
+func OwnerAllowancesPrefix(owner sdk.AccAddress) []byte {
+	return append(append(AllowanceKeyPrefix, byte(len(owner))), owner...)
+}
+
+// AllowanceKey returns the store key the allowance of a spender over a token of an owner is saved under
+func AllowanceKey(owner, spender sdk.AccAddress, symbol string) []byte {
+	return append(append(append(OwnerAllowancesPrefix(owner), byte(len(spender))), spender...), symbol...)
+}
+
+// SpenderAllowancesPrefix returns the prefix of the index keys of the allowances granted to a spender
+func SpenderAllowancesPrefix(spender sdk.AccAddress) []byte {
+	return append(append(SpenderKeyPrefix, byte(len(spender))), spender...)
+}
+
+// SpenderAllowanceKey returns the index key of an allowance granted to a spender, it ends in the allowance's key
+func SpenderAllowanceKey(owner, spender sdk.AccAddress, symbol string) []byte {
+	return append(SpenderAllowancesPrefix(spender), AllowanceKey(owner, spender, symbol)...)
+}
+
+// TokenClawbacksPrefix returns the prefix of the keys of the clawback history of a token
+func TokenClawbacksPrefix(symbol string) []byte {
+	return append(append(ClawbackKeyPrefix, byte(len(symbol))), symbol...)
+}
+
+// ClawbackKey returns the store key a clawback record is saved under, ordered by ID within its token
+func ClawbackKey(symbol string, id uint64) []byte {
+	return append(TokenClawbacksPrefix(symbol), sdk.Uint64ToBigEndian(id)...)
+}
+
+// TransferFeeKey returns the store key the transfer fee of a token is saved under
+func TransferFeeKey(symbol string) []byte {
+	return append(TransferFeeKeyPrefix, symbol...)
+}
+
+// TokenHoldersPrefix returns the prefix of the keys marking the accounts that hold a token
+func TokenHoldersPrefix(symbol string) []byte {
+	return append(append(HolderKeyPrefix, byte(len(symbol))), symbol...)
+}
+
+// HolderKey returns the store key marking that an account holds a token
+func HolderKey(symbol string, address sdk.AccAddress) []byte {
+	return append(TokenHoldersPrefix(symbol), address...)
+}
+
+// HolderCountKey returns the store key the number of holders of a token is saved under
+func HolderCountKey(symbol string) []byte {
+	return append(HolderCountKeyPrefix, symbol...)
+}
+
+// TokenRedenominationsPrefix returns the prefix of the keys of the redenomination history of a token
+func TokenRedenominationsPrefix(symbol string) []byte {
+	return append(append(RedenominationKeyPrefix, byte(len(symbol))), symbol...)
+}
+
+// RedenominationKey returns the store key the n-th redenomination of a token is saved under, counting from 0
+func RedenominationKey(symbol string, n uint64) []byte {
+	return append(TokenRedenominationsPrefix(symbol), sdk.Uint64ToBigEndian(n)...)
+}
+
+// ConversionKey returns the store key the conversion window of a source token is saved under
+func ConversionKey(source string) []byte {
+	return append(ConversionKeyPrefix, source...)
+}
+
+// ConversionQueueKey returns the key an open conversion window is queued under until it closes, ordered by deadline
+func ConversionQueueKey(deadline time.Time, source string) []byte {
+	return append(ConversionQueueTimeKey(deadline), source...)
+}
+
+// ConversionQueueTimeKey returns the prefix of the queue keys of conversion windows closing at the given time
+func ConversionQueueTimeKey(deadline time.Time) []byte {
+	return append(ConversionQueueKeyPrefix, sdk.FormatTimeBytes(deadline)...)
+}
+
+// ArchivedTokenKey returns the store key the archived record of a retired token is saved under
+func ArchivedTokenKey(symbol string) []byte {
+	return append(ArchivedTokenKeyPrefix, symbol...)
+}
+
+// CouncilKey returns the store key the council of a token is saved under
+func CouncilKey(symbol string) []byte {
+	return append(CouncilKeyPrefix, symbol...)
+}
+
+// ActionKey returns the store key a pending action is saved under
+func ActionKey(id uint64) []byte {
+	return append(ActionKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ActionQueueKey returns the key a pending action is queued under until it expires, ordered by expiry
+func ActionQueueKey(expiry time.Time, id uint64) []byte {
+	return append(ActionQueueTimeKey(expiry), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ActionQueueTimeKey returns the prefix of the queue keys of pending actions expiring at the given time
+func ActionQueueTimeKey(expiry time.Time) []byte {
+	return append(ActionQueueKeyPrefix, sdk.FormatTimeBytes(expiry)...)
+}
+
+// QueuedActionKey returns the store key a timelocked action is saved under
+func QueuedActionKey(id uint64) []byte {
+	return append(QueuedActionKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// TimelockQueueKey returns the key a timelocked action is queued under until it runs, ordered by ETA
+func TimelockQueueKey(eta time.Time, id uint64) []byte {
+	return append(TimelockQueueTimeKey(eta), sdk.Uint64ToBigEndian(id)...)
+}
+
+// TimelockQueueTimeKey returns the prefix of the queue keys of timelocked actions due at the given time
+func TimelockQueueTimeKey(eta time.Time) []byte {
+	return append(TimelockQueueKeyPrefix, sdk.FormatTimeBytes(eta)...)
+}
+
+// GranterGrantsPrefix returns the prefix of the keys of the grants a granter issued
+func GranterGrantsPrefix(granter sdk.AccAddress) []byte {
+	return append(append(GrantKeyPrefix, byte(len(granter))), granter...)
+}
+
+// GrantKey returns the store key the grant of a grantee to sign a message type over a token for a granter is saved
+// under
+func GrantKey(granter, grantee sdk.AccAddress, symbol, msgType string) []byte {
+	key := append(append(GranterGrantsPrefix(granter), byte(len(grantee))), grantee...)
+	return append(append(append(key, byte(len(symbol))), symbol...), msgType...)
+}
+
+// GranteeGrantsPrefix returns the prefix of the index keys of the grants issued to a grantee
+func GranteeGrantsPrefix(grantee sdk.AccAddress) []byte {
+	return append(append(GranteeKeyPrefix, byte(len(grantee))), grantee...)
+}
+
+// GranteeGrantKey returns the index key of a grant issued to a grantee, it ends in the grant's key
+func GranteeGrantKey(granter, grantee sdk.AccAddress, symbol, msgType string) []byte {
+	return append(GranteeGrantsPrefix(grantee), GrantKey(granter, grantee, symbol, msgType)...)
+}
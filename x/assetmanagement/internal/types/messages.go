@@ -1,6 +1,11 @@
 package types
 
 import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,6 +19,12 @@ type MsgIssueToken struct {
 	OriginalSymbol string         `json:"original_symbol"`
 	TotalSupply    int64          `json:"total_supply"`
 	Mintable       bool           `json:"mintable"`
+	Clawbackable   bool           `json:"clawbackable,omitempty"`   // omitted when off to keep older sign bytes
+	ClawbackAdmin  sdk.AccAddress `json:"clawback_admin,omitempty"` // requires Clawbackable
+	TransferMode   TransferMode   `json:"transfer_mode,omitempty"`  // free when empty
+	// holding limits, 0 for none
+	MaxBalancePerAccount int64  `json:"max_balance_per_account,omitempty"`
+	MaxHolders           uint64 `json:"max_holders,omitempty"`
 }
 
 // NewMsgIssueToken is a constructor function for MsgIssueToken
@@ -40,13 +51,25 @@ func (msg MsgIssueToken) ValidateBasic() sdk.Error {
 	if msg.SourceAddress.Empty() {
 		return sdk.ErrInvalidAddress(msg.SourceAddress.String())
 	}
-	if len(msg.Name) == 0 || len(msg.Symbol) == 0 || len(msg.OriginalSymbol) == 0 {
-		return sdk.ErrUnknownRequest("Name and/or Symbols cannot be empty")
+	if len(msg.Name) == 0 {
+		return ErrInvalidTokenName(DefaultCodespace, "Name cannot be empty")
+	}
+	if len(msg.Symbol) == 0 || len(msg.OriginalSymbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbols cannot be empty")
+	}
+	if err := ValidateSymbol(strings.ToLower(msg.Symbol)); err != nil {
+		return err
 	}
 	if msg.TotalSupply < 1 {
-		return sdk.ErrUnknownRequest("TotalSupply cannot be less than 1")
+		return ErrInvalidAmount(DefaultCodespace, "TotalSupply cannot be less than 1")
 	}
-	return nil
+	if !msg.ClawbackAdmin.Empty() && !msg.Clawbackable {
+		return ErrInvalidClawback(DefaultCodespace, "ClawbackAdmin requires Clawbackable")
+	}
+	if !msg.TransferMode.IsValid() {
+		return ErrInvalidToken(DefaultCodespace, fmt.Sprintf("TransferMode '%s' is not valid", msg.TransferMode))
+	}
+	return ValidateHoldingLimits(msg.MaxBalancePerAccount, msg.MaxHolders)
 }
 
 // GetSignBytes encodes the message for signing
@@ -87,10 +110,10 @@ func (msg MsgMintCoins) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Owner.String())
 	}
 	if len(msg.Symbol) == 0 {
-		return sdk.ErrUnknownRequest("Symbol cannot be empty")
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
 	}
 	if msg.Amount < 1 {
-		return sdk.ErrUnknownRequest("Amount cannot be less than 1")
+		return ErrInvalidAmount(DefaultCodespace, "Amount cannot be less than 1")
 	}
 	return nil
 }
@@ -133,10 +156,10 @@ func (msg MsgBurnCoins) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Owner.String())
 	}
 	if len(msg.Symbol) == 0 {
-		return sdk.ErrUnknownRequest("Symbol cannot be empty")
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
 	}
 	if msg.Amount < 1 {
-		return sdk.ErrUnknownRequest("Amount cannot be less than 1")
+		return ErrInvalidAmount(DefaultCodespace, "Amount cannot be less than 1")
 	}
 	return nil
 }
@@ -179,10 +202,10 @@ func (msg MsgFreezeCoins) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Owner.String())
 	}
 	if len(msg.Symbol) == 0 {
-		return sdk.ErrUnknownRequest("Symbol cannot be empty")
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
 	}
 	if msg.Amount < 1 {
-		return sdk.ErrUnknownRequest("Amount cannot be less than 1")
+		return ErrInvalidAmount(DefaultCodespace, "Amount cannot be less than 1")
 	}
 	return nil
 }
@@ -225,10 +248,10 @@ func (msg MsgUnfreezeCoins) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Owner.String())
 	}
 	if len(msg.Symbol) == 0 {
-		return sdk.ErrUnknownRequest("Symbol cannot be empty")
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
 	}
 	if msg.Amount < 1 {
-		return sdk.ErrUnknownRequest("Amount cannot be less than 1")
+		return ErrInvalidAmount(DefaultCodespace, "Amount cannot be less than 1")
 	}
 	return nil
 }
@@ -242,3 +265,1514 @@ func (msg MsgUnfreezeCoins) GetSignBytes() []byte {
 func (msg MsgUnfreezeCoins) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
+
+// MaxDistributeRecipients caps the recipients of a single MsgDistribute, larger lists have to be split over several txs
+const MaxDistributeRecipients = 100
+
+// Recipient is a single payout of a MsgDistribute
+type Recipient struct {
+	Address sdk.AccAddress `json:"address"`
+	Amount  int64          `json:"amount"`
+}
+
+// NewRecipient is the constructor function for Recipient
+func NewRecipient(address sdk.AccAddress, amount int64) Recipient {
+	return Recipient{
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+// MsgDistribute defines the Distribute message, sending coins of a token from any holder to a list of recipients
+type MsgDistribute struct {
+	Sender     sdk.AccAddress `json:"sender"`
+	Symbol     string         `json:"symbol"`
+	Recipients []Recipient    `json:"recipients"`
+}
+
+// NewMsgDistribute is the constructor function for MsgDistribute
+func NewMsgDistribute(sender sdk.AccAddress, symbol string, recipients []Recipient) MsgDistribute {
+	return MsgDistribute{
+		Sender:     sender,
+		Symbol:     symbol,
+		Recipients: recipients,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDistribute) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDistribute) Type() string { return "distribute" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDistribute) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress(msg.Sender.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if len(msg.Recipients) == 0 {
+		return ErrInvalidRecipients(DefaultCodespace, "Recipients cannot be empty")
+	}
+	if len(msg.Recipients) > MaxDistributeRecipients {
+		return ErrInvalidRecipients(DefaultCodespace,
+			fmt.Sprintf("%d recipients exceed the limit of %d", len(msg.Recipients), MaxDistributeRecipients))
+	}
+	for i, recipient := range msg.Recipients {
+		if recipient.Address.Empty() {
+			return ErrInvalidRecipients(DefaultCodespace, fmt.Sprintf("Recipient %d has no address", i))
+		}
+		if recipient.Amount < 1 {
+			return ErrInvalidAmount(DefaultCodespace, fmt.Sprintf("Amount of recipient %d cannot be less than 1", i))
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDistribute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDistribute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgCreateClaimCampaign defines the CreateClaimCampaign message, funding a claimable airdrop of a token
+type MsgCreateClaimCampaign struct {
+	Owner      sdk.AccAddress `json:"owner"`
+	Symbol     string         `json:"symbol"`
+	MerkleRoot string         `json:"merkle_root"`
+	Deposit    int64          `json:"deposit"`
+	EndTime    time.Time      `json:"end_time"`
+}
+
+// NewMsgCreateClaimCampaign is the constructor function for MsgCreateClaimCampaign
+func NewMsgCreateClaimCampaign(owner sdk.AccAddress, symbol, merkleRoot string, deposit int64,
+	endTime time.Time) MsgCreateClaimCampaign {
+	return MsgCreateClaimCampaign{
+		Owner:      owner,
+		Symbol:     symbol,
+		MerkleRoot: merkleRoot,
+		Deposit:    deposit,
+		EndTime:    endTime,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateClaimCampaign) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateClaimCampaign) Type() string { return "create_claim_campaign" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateClaimCampaign) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if err := ValidateMerkleRoot(msg.MerkleRoot); err != nil {
+		return err
+	}
+	if msg.Deposit < 1 {
+		return ErrInvalidAmount(DefaultCodespace, "Deposit cannot be less than 1")
+	}
+	if msg.EndTime.IsZero() {
+		return ErrInvalidCampaign(DefaultCodespace, "EndTime cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateClaimCampaign) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateClaimCampaign) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgClaim defines the Claim message, collecting the amount a claim tree assigns to the claimant
+type MsgClaim struct {
+	Claimant   sdk.AccAddress `json:"claimant"`
+	CampaignID uint64         `json:"campaign_id"`
+	Amount     int64          `json:"amount"`
+	Proof      []string       `json:"proof"` // hex encoded sibling hashes, from the leaf up
+}
+
+// NewMsgClaim is the constructor function for MsgClaim
+func NewMsgClaim(claimant sdk.AccAddress, campaignID uint64, amount int64, proof []string) MsgClaim {
+	return MsgClaim{
+		Claimant:   claimant,
+		CampaignID: campaignID,
+		Amount:     amount,
+		Proof:      proof,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgClaim) Type() string { return "claim" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaim) ValidateBasic() sdk.Error {
+	if msg.Claimant.Empty() {
+		return sdk.ErrInvalidAddress(msg.Claimant.String())
+	}
+	if msg.Amount < 1 {
+		return ErrInvalidAmount(DefaultCodespace, "Amount cannot be less than 1")
+	}
+	if _, err := msg.DecodeProof(); err != nil {
+		return ErrInvalidClaimProof(DefaultCodespace, msg.Claimant, msg.CampaignID)
+	}
+	return nil
+}
+
+// DecodeProof returns the proof as raw hashes
+func (msg MsgClaim) DecodeProof() ([][]byte, error) {
+	proof := make([][]byte, len(msg.Proof))
+	for i, node := range msg.Proof {
+		bz, err := hex.DecodeString(node)
+		if err != nil {
+			return nil, err
+		}
+		proof[i] = bz
+	}
+	return proof, nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Claimant}
+}
+
+// MsgCreateVestingGrant defines the CreateVestingGrant message, locking coins of a token until they vest to the
+// beneficiary
+type MsgCreateVestingGrant struct {
+	Owner       sdk.AccAddress `json:"owner"`
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Symbol      string         `json:"symbol"`
+	Total       int64          `json:"total"`
+	StartTime   time.Time      `json:"start_time"`
+	CliffTime   time.Time      `json:"cliff_time"`
+	EndTime     time.Time      `json:"end_time"`
+}
+
+// NewMsgCreateVestingGrant is the constructor function for MsgCreateVestingGrant
+func NewMsgCreateVestingGrant(owner, beneficiary sdk.AccAddress, symbol string, total int64,
+	startTime, cliffTime, endTime time.Time) MsgCreateVestingGrant {
+	return MsgCreateVestingGrant{
+		Owner:       owner,
+		Beneficiary: beneficiary,
+		Symbol:      symbol,
+		Total:       total,
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		EndTime:     endTime,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateVestingGrant) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateVestingGrant) Type() string { return "create_vesting_grant" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateVestingGrant) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if msg.Beneficiary.Empty() {
+		return sdk.ErrInvalidAddress(msg.Beneficiary.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if msg.Total < 1 {
+		return ErrInvalidAmount(DefaultCodespace, "Total cannot be less than 1")
+	}
+	return ValidateVestingSchedule(msg.StartTime, msg.CliffTime, msg.EndTime)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateVestingGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateVestingGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgClaimVested defines the ClaimVested message, releasing the vested coins of a grant to its beneficiary
+type MsgClaimVested struct {
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	GrantID     uint64         `json:"grant_id"`
+}
+
+// NewMsgClaimVested is the constructor function for MsgClaimVested
+func NewMsgClaimVested(beneficiary sdk.AccAddress, grantID uint64) MsgClaimVested {
+	return MsgClaimVested{
+		Beneficiary: beneficiary,
+		GrantID:     grantID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgClaimVested) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgClaimVested) Type() string { return "claim_vested" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimVested) ValidateBasic() sdk.Error {
+	if msg.Beneficiary.Empty() {
+		return sdk.ErrInvalidAddress(msg.Beneficiary.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgClaimVested) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClaimVested) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Beneficiary}
+}
+
+// MsgSetEmission defines the SetEmission message, creating or replacing the emission schedule of a mintable token
+type MsgSetEmission struct {
+	Owner         sdk.AccAddress `json:"owner"`
+	Symbol        string         `json:"symbol"`
+	Recipient     sdk.AccAddress `json:"recipient"`
+	Rate          int64          `json:"rate"`
+	StartHeight   int64          `json:"start_height"`
+	EndHeight     int64          `json:"end_height"`
+	DecayInterval int64          `json:"decay_interval"`
+	DecayRate     sdk.Dec        `json:"decay_rate"`
+	MaxSupply     int64          `json:"max_supply"`
+}
+
+// NewMsgSetEmission is the constructor function for MsgSetEmission
+func NewMsgSetEmission(owner sdk.AccAddress, symbol string, recipient sdk.AccAddress, rate int64,
+	startHeight, endHeight, decayInterval int64, decayRate sdk.Dec, maxSupply int64) MsgSetEmission {
+	return MsgSetEmission{
+		Owner:         owner,
+		Symbol:        symbol,
+		Recipient:     recipient,
+		Rate:          rate,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+		DecayInterval: decayInterval,
+		DecayRate:     decayRate,
+		MaxSupply:     maxSupply,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetEmission) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetEmission) Type() string { return "set_emission" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetEmission) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress(msg.Recipient.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return ValidateEmission(sdk.NewInt(msg.Rate), msg.StartHeight, msg.EndHeight, msg.DecayInterval, msg.DecayRate,
+		sdk.NewInt(msg.MaxSupply))
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetEmission) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetEmission) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetEmissionPaused defines the SetEmissionPaused message, pausing or resuming the emission schedule of a token
+type MsgSetEmissionPaused struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+	Paused bool           `json:"paused"`
+}
+
+// NewMsgSetEmissionPaused is the constructor function for MsgSetEmissionPaused
+func NewMsgSetEmissionPaused(owner sdk.AccAddress, symbol string, paused bool) MsgSetEmissionPaused {
+	return MsgSetEmissionPaused{
+		Owner:  owner,
+		Symbol: symbol,
+		Paused: paused,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetEmissionPaused) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetEmissionPaused) Type() string { return "set_emission_paused" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetEmissionPaused) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetEmissionPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetEmissionPaused) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgApprove defines the Approve message, allowing a spender to move coins of a token out of the owner's account.
+// It replaces any earlier allowance of the spender over the token, an amount of zero revokes it
+type MsgApprove struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Spender sdk.AccAddress `json:"spender"`
+	Symbol  string         `json:"symbol"`
+	Amount  int64          `json:"amount"`
+	Expiry  time.Time      `json:"expiry"`
+}
+
+// NewMsgApprove is the constructor function for MsgApprove
+func NewMsgApprove(owner, spender sdk.AccAddress, symbol string, amount int64, expiry time.Time) MsgApprove {
+	return MsgApprove{
+		Owner:   owner,
+		Spender: spender,
+		Symbol:  symbol,
+		Amount:  amount,
+		Expiry:  expiry,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgApprove) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgApprove) Type() string { return "approve" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgApprove) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if msg.Spender.Empty() {
+		return sdk.ErrInvalidAddress(msg.Spender.String())
+	}
+	if msg.Owner.Equals(msg.Spender) {
+		return ErrInvalidAllowance(DefaultCodespace, "Owner cannot approve itself")
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if msg.Amount < 0 {
+		return ErrInvalidAmount(DefaultCodespace, "Amount cannot be negative")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgApprove) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgTransferFrom defines the TransferFrom message, moving coins of a token on behalf of their holder out of the
+// allowance it granted the spender
+type MsgTransferFrom struct {
+	Spender sdk.AccAddress `json:"spender"`
+	From    sdk.AccAddress `json:"from"`
+	To      sdk.AccAddress `json:"to"`
+	Symbol  string         `json:"symbol"`
+	Amount  int64          `json:"amount"`
+}
+
+// NewMsgTransferFrom is the constructor function for MsgTransferFrom
+func NewMsgTransferFrom(spender, from, to sdk.AccAddress, symbol string, amount int64) MsgTransferFrom {
+	return MsgTransferFrom{
+		Spender: spender,
+		From:    from,
+		To:      to,
+		Symbol:  symbol,
+		Amount:  amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferFrom) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferFrom) Type() string { return "transfer_from" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferFrom) ValidateBasic() sdk.Error {
+	if msg.Spender.Empty() {
+		return sdk.ErrInvalidAddress(msg.Spender.String())
+	}
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+	if msg.To.Empty() {
+		return sdk.ErrInvalidAddress(msg.To.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if msg.Amount <= 0 {
+		return ErrInvalidAmount(DefaultCodespace, "Amount must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTransferFrom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransferFrom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Spender}
+}
+
+// MsgClawback defines the Clawback message, taking coins of a clawbackable token from a holder's free and then
+// frozen balance. The coins are moved to the recipient, or burned when there is none
+type MsgClawback struct {
+	Authority sdk.AccAddress `json:"authority"`
+	Symbol    string         `json:"symbol"`
+	From      sdk.AccAddress `json:"from"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    int64          `json:"amount"`
+	Reason    string         `json:"reason"`
+}
+
+// NewMsgClawback is the constructor function for MsgClawback
+func NewMsgClawback(authority sdk.AccAddress, symbol string, from, recipient sdk.AccAddress, amount int64,
+	reason string) MsgClawback {
+	return MsgClawback{
+		Authority: authority,
+		Symbol:    symbol,
+		From:      from,
+		Recipient: recipient,
+		Amount:    amount,
+		Reason:    reason,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgClawback) Type() string { return "clawback" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClawback) ValidateBasic() sdk.Error {
+	if msg.Authority.Empty() {
+		return sdk.ErrInvalidAddress(msg.Authority.String())
+	}
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+	if msg.From.Equals(msg.Recipient) {
+		return ErrInvalidClawback(DefaultCodespace, "Recipient cannot be the holder clawed back from")
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if msg.Amount <= 0 {
+		return ErrInvalidAmount(DefaultCodespace, "Amount must be positive")
+	}
+	return ValidateClawbackReason(msg.Reason)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// MsgSetClawbackAdmin defines the SetClawbackAdmin message, changing who besides the owner may claw back coins of a
+// token. An empty admin leaves it to the owner alone
+type MsgSetClawbackAdmin struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+	Admin  sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgSetClawbackAdmin is the constructor function for MsgSetClawbackAdmin
+func NewMsgSetClawbackAdmin(owner sdk.AccAddress, symbol string, admin sdk.AccAddress) MsgSetClawbackAdmin {
+	return MsgSetClawbackAdmin{
+		Owner:  owner,
+		Symbol: symbol,
+		Admin:  admin,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetClawbackAdmin) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetClawbackAdmin) Type() string { return "set_clawback_admin" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetClawbackAdmin) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetClawbackAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetClawbackAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetTransferFee defines the SetTransferFee message, setting the fee taken out of every transfer of a token. A
+// zero Rate and MinFee stop the fee without losing the total collected
+type MsgSetTransferFee struct {
+	Owner     sdk.AccAddress `json:"owner"`
+	Symbol    string         `json:"symbol"`
+	Rate      uint64         `json:"rate"`
+	MinFee    int64          `json:"min_fee"`
+	MaxFee    int64          `json:"max_fee"`
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+// NewMsgSetTransferFee is the constructor function for MsgSetTransferFee
+func NewMsgSetTransferFee(owner sdk.AccAddress, symbol string, rate uint64, minFee, maxFee int64,
+	recipient sdk.AccAddress) MsgSetTransferFee {
+	return MsgSetTransferFee{
+		Owner:     owner,
+		Symbol:    symbol,
+		Rate:      rate,
+		MinFee:    minFee,
+		MaxFee:    maxFee,
+		Recipient: recipient,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetTransferFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetTransferFee) Type() string { return "set_transfer_fee" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetTransferFee) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return ValidateTransferFee(msg.Rate, sdk.NewInt(msg.MinFee), sdk.NewInt(msg.MaxFee), msg.Recipient)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetTransferFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetTransferFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetTransferFeeExemption defines the SetTransferFeeExemption message, adding an address to or removing it from
+// the addresses whose transfers of a token pay no fee, eg exchanges
+type MsgSetTransferFeeExemption struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Symbol  string         `json:"symbol"`
+	Address sdk.AccAddress `json:"address"`
+	Exempt  bool           `json:"exempt"`
+}
+
+// NewMsgSetTransferFeeExemption is the constructor function for MsgSetTransferFeeExemption
+func NewMsgSetTransferFeeExemption(owner sdk.AccAddress, symbol string, address sdk.AccAddress,
+	exempt bool) MsgSetTransferFeeExemption {
+	return MsgSetTransferFeeExemption{
+		Owner:   owner,
+		Symbol:  symbol,
+		Address: address,
+		Exempt:  exempt,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetTransferFeeExemption) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetTransferFeeExemption) Type() string { return "set_transfer_fee_exemption" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetTransferFeeExemption) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress(msg.Address.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetTransferFeeExemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetTransferFeeExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetHoldingLimits defines the SetHoldingLimits message, changing how much any account but the owner may hold of
+// a token and how many accounts may hold it. 0 removes a limit
+type MsgSetHoldingLimits struct {
+	Owner                sdk.AccAddress `json:"owner"`
+	Symbol               string         `json:"symbol"`
+	MaxBalancePerAccount int64          `json:"max_balance_per_account"`
+	MaxHolders           uint64         `json:"max_holders"`
+}
+
+// NewMsgSetHoldingLimits is the constructor function for MsgSetHoldingLimits
+func NewMsgSetHoldingLimits(owner sdk.AccAddress, symbol string, maxBalancePerAccount int64,
+	maxHolders uint64) MsgSetHoldingLimits {
+	return MsgSetHoldingLimits{
+		Owner:                owner,
+		Symbol:               symbol,
+		MaxBalancePerAccount: maxBalancePerAccount,
+		MaxHolders:           maxHolders,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetHoldingLimits) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetHoldingLimits) Type() string { return "set_holding_limits" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetHoldingLimits) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return ValidateHoldingLimits(msg.MaxBalancePerAccount, msg.MaxHolders)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetHoldingLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetHoldingLimits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRedenominate defines the Redenominate message, multiplying every balance and the supply of a token by
+// Numerator / Denominator, eg 2/1 for a split or 1/10 for a merge
+type MsgRedenominate struct {
+	Owner       sdk.AccAddress `json:"owner"`
+	Symbol      string         `json:"symbol"`
+	Numerator   uint64         `json:"numerator"`
+	Denominator uint64         `json:"denominator"`
+}
+
+// NewMsgRedenominate is the constructor function for MsgRedenominate
+func NewMsgRedenominate(owner sdk.AccAddress, symbol string, numerator, denominator uint64) MsgRedenominate {
+	return MsgRedenominate{
+		Owner:       owner,
+		Symbol:      symbol,
+		Numerator:   numerator,
+		Denominator: denominator,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRedenominate) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRedenominate) Type() string { return "redenominate" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRedenominate) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return ValidateRedenominationRatio(msg.Numerator, msg.Denominator)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRedenominate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRedenominate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgOpenConversion defines the OpenConversion message, letting the holders of the source token convert it to the
+// target token at Numerator / Denominator until the deadline. The sender must own both tokens
+type MsgOpenConversion struct {
+	Owner       sdk.AccAddress `json:"owner"`
+	Source      string         `json:"source"`
+	Target      string         `json:"target"`
+	Numerator   uint64         `json:"numerator"`
+	Denominator uint64         `json:"denominator"`
+	Deadline    time.Time      `json:"deadline"`
+}
+
+// NewMsgOpenConversion is the constructor function for MsgOpenConversion
+func NewMsgOpenConversion(owner sdk.AccAddress, source, target string, numerator, denominator uint64,
+	deadline time.Time) MsgOpenConversion {
+	return MsgOpenConversion{
+		Owner:       owner,
+		Source:      source,
+		Target:      target,
+		Numerator:   numerator,
+		Denominator: denominator,
+		Deadline:    deadline,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgOpenConversion) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgOpenConversion) Type() string { return "open_conversion" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgOpenConversion) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Source) == 0 || len(msg.Target) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Source and Target cannot be empty")
+	}
+	return NewConversionWindow(msg.Source, msg.Target, msg.Owner, msg.Numerator, msg.Denominator,
+		msg.Deadline).Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgOpenConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgOpenConversion) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgConvert defines the Convert message, burning an amount of the source token of an open conversion window and
+// minting the target token in its place
+type MsgConvert struct {
+	Holder sdk.AccAddress `json:"holder"`
+	Source string         `json:"source"`
+	Amount int64          `json:"amount"`
+}
+
+// NewMsgConvert is the constructor function for MsgConvert
+func NewMsgConvert(holder sdk.AccAddress, source string, amount int64) MsgConvert {
+	return MsgConvert{
+		Holder: holder,
+		Source: source,
+		Amount: amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvert) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvert) Type() string { return "convert" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvert) ValidateBasic() sdk.Error {
+	if msg.Holder.Empty() {
+		return sdk.ErrInvalidAddress(msg.Holder.String())
+	}
+	if len(msg.Source) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Source cannot be empty")
+	}
+	if msg.Amount < 1 {
+		return ErrInvalidAmount(DefaultCodespace, "Amount cannot be less than 1")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvert) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvert) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Holder}
+}
+
+// MsgSetTokenStatus defines the SetTokenStatus message, moving a token forward in its lifecycle: deprecating it or
+// retiring it once its supply is zero
+type MsgSetTokenStatus struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+	Status TokenStatus    `json:"status"`
+}
+
+// NewMsgSetTokenStatus is the constructor function for MsgSetTokenStatus
+func NewMsgSetTokenStatus(owner sdk.AccAddress, symbol string, status TokenStatus) MsgSetTokenStatus {
+	return MsgSetTokenStatus{
+		Owner:  owner,
+		Symbol: symbol,
+		Status: status,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetTokenStatus) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetTokenStatus) Type() string { return "set_token_status" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetTokenStatus) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if msg.Status != TokenStatusDeprecated && msg.Status != TokenStatusRetired {
+		return ErrInvalidTokenStatus(DefaultCodespace,
+			fmt.Sprintf("Status must be %s or %s", TokenStatusDeprecated, TokenStatusRetired))
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetTokenStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetTokenStatus) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetTokenPaused defines the SetTokenPaused message, pausing or resuming every movement of a token's coins
+type MsgSetTokenPaused struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+	Paused bool           `json:"paused"`
+}
+
+// NewMsgSetTokenPaused is the constructor function for MsgSetTokenPaused
+func NewMsgSetTokenPaused(owner sdk.AccAddress, symbol string, paused bool) MsgSetTokenPaused {
+	return MsgSetTokenPaused{
+		Owner:  owner,
+		Symbol: symbol,
+		Paused: paused,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetTokenPaused) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetTokenPaused) Type() string { return "set_token_paused" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetTokenPaused) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetTokenPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetTokenPaused) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetCouncil defines the SetCouncil message, handing a token over to a council or changing its council. Once a
+// council owns the token, this message too is proposed to it
+type MsgSetCouncil struct {
+	Owner     sdk.AccAddress   `json:"owner"`
+	Symbol    string           `json:"symbol"`
+	Members   []sdk.AccAddress `json:"members"`
+	Threshold uint64           `json:"threshold"`
+}
+
+// NewMsgSetCouncil is the constructor function for MsgSetCouncil
+func NewMsgSetCouncil(owner sdk.AccAddress, symbol string, members []sdk.AccAddress, threshold uint64) MsgSetCouncil {
+	return MsgSetCouncil{
+		Owner:     owner,
+		Symbol:    symbol,
+		Members:   members,
+		Threshold: threshold,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetCouncil) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetCouncil) Type() string { return "set_council" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetCouncil) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return NewCouncil(msg.Symbol, msg.Members, msg.Threshold).Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetCouncil) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetCouncil) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgProposeAction defines the ProposeAction message, proposing a privileged message of a council owned token to its
+// council. The message must be signed by the council address, it runs once enough members approved it
+type MsgProposeAction struct {
+	Proposer sdk.AccAddress `json:"proposer"`
+	Symbol   string         `json:"symbol"`
+	Action   sdk.Msg        `json:"action"`
+	Expiry   time.Time      `json:"expiry"`
+}
+
+// NewMsgProposeAction is the constructor function for MsgProposeAction
+func NewMsgProposeAction(proposer sdk.AccAddress, symbol string, action sdk.Msg,
+	expiry time.Time) MsgProposeAction {
+	return MsgProposeAction{
+		Proposer: proposer,
+		Symbol:   symbol,
+		Action:   action,
+		Expiry:   expiry,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgProposeAction) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgProposeAction) Type() string { return "propose_action" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgProposeAction) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if msg.Expiry.IsZero() {
+		return ErrInvalidAction(DefaultCodespace, "Expiry cannot be empty")
+	}
+	return ValidateAction(msg.Symbol, msg.Action)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgProposeAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgProposeAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// MsgApproveAction defines the ApproveAction message, a council member approving a pending action. The approval
+// reaching the threshold runs the action, and fails if the action fails
+type MsgApproveAction struct {
+	Member   sdk.AccAddress `json:"member"`
+	ActionID uint64         `json:"action_id"`
+}
+
+// NewMsgApproveAction is the constructor function for MsgApproveAction
+func NewMsgApproveAction(member sdk.AccAddress, actionID uint64) MsgApproveAction {
+	return MsgApproveAction{
+		Member:   member,
+		ActionID: actionID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgApproveAction) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgApproveAction) Type() string { return "approve_action" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgApproveAction) ValidateBasic() sdk.Error {
+	if msg.Member.Empty() {
+		return sdk.ErrInvalidAddress(msg.Member.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgApproveAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgApproveAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Member}
+}
+
+// MsgRevokeApproval defines the RevokeApproval message, a council member taking back their approval of a pending
+// action. An action nobody approves anymore is dropped
+type MsgRevokeApproval struct {
+	Member   sdk.AccAddress `json:"member"`
+	ActionID uint64         `json:"action_id"`
+}
+
+// NewMsgRevokeApproval is the constructor function for MsgRevokeApproval
+func NewMsgRevokeApproval(member sdk.AccAddress, actionID uint64) MsgRevokeApproval {
+	return MsgRevokeApproval{
+		Member:   member,
+		ActionID: actionID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeApproval) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeApproval) Type() string { return "revoke_approval" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeApproval) ValidateBasic() sdk.Error {
+	if msg.Member.Empty() {
+		return sdk.ErrInvalidAddress(msg.Member.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeApproval) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeApproval) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Member}
+}
+
+// MsgSetTimelock defines the SetTimelock message, holding the rule changes of a token back for a delay. Turning the
+// timelock on or lengthening it takes effect right away, shortening or turning it off is itself held back
+type MsgSetTimelock struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+	Delay  time.Duration  `json:"delay"`
+}
+
+// NewMsgSetTimelock is the constructor function for MsgSetTimelock
+func NewMsgSetTimelock(owner sdk.AccAddress, symbol string, delay time.Duration) MsgSetTimelock {
+	return MsgSetTimelock{
+		Owner:  owner,
+		Symbol: symbol,
+		Delay:  delay,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetTimelock) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetTimelock) Type() string { return "set_timelock" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetTimelock) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return ValidateTimelockDelay(msg.Delay)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetTimelock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetTimelock) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgCancelQueuedAction defines the CancelQueuedAction message, the owner of a timelocked token cancelling one of its
+// queued rule changes before it runs
+type MsgCancelQueuedAction struct {
+	Owner sdk.AccAddress `json:"owner"`
+	ID    uint64         `json:"id"`
+}
+
+// NewMsgCancelQueuedAction is the constructor function for MsgCancelQueuedAction
+func NewMsgCancelQueuedAction(owner sdk.AccAddress, id uint64) MsgCancelQueuedAction {
+	return MsgCancelQueuedAction{
+		Owner: owner,
+		ID:    id,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelQueuedAction) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelQueuedAction) Type() string { return "cancel_queued_action" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelQueuedAction) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelQueuedAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelQueuedAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgGrant defines the Grant message, letting a grantee sign the messages of one type over a token on behalf of the
+// granter through MsgExec. It replaces any earlier grant of the grantee for that type and token
+type MsgGrant struct {
+	Granter    sdk.AccAddress `json:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee"`
+	Symbol     string         `json:"symbol"`
+	MsgType    string         `json:"msg_type"`
+	SpendLimit int64          `json:"spend_limit"` // zero for no limit
+	Expiry     time.Time      `json:"expiry"`      // zero never expires
+}
+
+// NewMsgGrant is the constructor function for MsgGrant
+func NewMsgGrant(granter, grantee sdk.AccAddress, symbol, msgType string, spendLimit int64,
+	expiry time.Time) MsgGrant {
+	return MsgGrant{
+		Granter:    granter,
+		Grantee:    grantee,
+		Symbol:     symbol,
+		MsgType:    msgType,
+		SpendLimit: spendLimit,
+		Expiry:     expiry,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgGrant) Type() string { return "grant" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgGrant) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Granter.String())
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress(msg.Grantee.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	return NewGrant(msg.Granter, msg.Grantee, msg.Symbol, msg.MsgType, msg.SpendLimit, msg.Expiry).Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeGrant defines the RevokeGrant message, the granter taking back a grant before it is used up or expires
+type MsgRevokeGrant struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	Symbol  string         `json:"symbol"`
+	MsgType string         `json:"msg_type"`
+}
+
+// NewMsgRevokeGrant is the constructor function for MsgRevokeGrant
+func NewMsgRevokeGrant(granter, grantee sdk.AccAddress, symbol, msgType string) MsgRevokeGrant {
+	return MsgRevokeGrant{
+		Granter: granter,
+		Grantee: grantee,
+		Symbol:  symbol,
+		MsgType: msgType,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeGrant) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeGrant) Type() string { return "revoke_grant" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeGrant) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Granter.String())
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress(msg.Grantee.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if len(msg.MsgType) == 0 {
+		return ErrInvalidGrant(DefaultCodespace, "MsgType cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec defines the Exec message, a grantee running messages signed by their granters under the grants it holds.
+// Each message spends from its own grant, and either all of them run or none does
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs"`
+}
+
+// NewMsgExec is the constructor function for MsgExec
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgExec) Type() string { return "exec" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress(msg.Grantee.String())
+	}
+	if len(msg.Msgs) == 0 || len(msg.Msgs) > MaxExecMsgs {
+		return ErrInvalidGrant(DefaultCodespace,
+			fmt.Sprintf("%d messages, must be between 1 and %d", len(msg.Msgs), MaxExecMsgs))
+	}
+	for i, inner := range msg.Msgs {
+		if inner == nil {
+			return ErrInvalidGrant(DefaultCodespace, fmt.Sprintf("Message %d cannot be empty", i))
+		}
+		if _, _, _, ok := GrantedMsg(inner); !ok {
+			return ErrInvalidGrant(DefaultCodespace,
+				fmt.Sprintf("a %s message of %s cannot be run through a grant", inner.Type(), inner.Route()))
+		}
+		if err := inner.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required, the grantee alone as the grants stand in for the granters
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// MsgTransferOwnership defines the TransferOwnership message, the owner of a token handing it over to a new owner. A
+// council handing its token back to a single owner is dissolved
+type MsgTransferOwnership struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	Symbol   string         `json:"symbol"`
+	NewOwner sdk.AccAddress `json:"new_owner"`
+}
+
+// NewMsgTransferOwnership is the constructor function for MsgTransferOwnership
+func NewMsgTransferOwnership(owner sdk.AccAddress, symbol string, newOwner sdk.AccAddress) MsgTransferOwnership {
+	return MsgTransferOwnership{
+		Owner:    owner,
+		Symbol:   symbol,
+		NewOwner: newOwner,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferOwnership) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferOwnership) Type() string { return "transfer_ownership" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferOwnership) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if msg.NewOwner.Empty() {
+		return sdk.ErrInvalidAddress(msg.NewOwner.String())
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if msg.NewOwner.Equals(CouncilAddress(msg.Symbol)) {
+		return ErrInvalidCouncil(DefaultCodespace, "a token is handed to a council with a set council message")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTransferOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransferOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetTokenMetadata defines the SetTokenMetadata message, the owner of a token changing the name and original
+// symbol wallets display it with. The symbol its coins are denominated in never changes
+type MsgSetTokenMetadata struct {
+	Owner          sdk.AccAddress `json:"owner"`
+	Symbol         string         `json:"symbol"`
+	Name           string         `json:"name"`
+	OriginalSymbol string         `json:"original_symbol"`
+}
+
+// NewMsgSetTokenMetadata is the constructor function for MsgSetTokenMetadata
+func NewMsgSetTokenMetadata(owner sdk.AccAddress, symbol, name, originalSymbol string) MsgSetTokenMetadata {
+	return MsgSetTokenMetadata{
+		Owner:          owner,
+		Symbol:         symbol,
+		Name:           name,
+		OriginalSymbol: originalSymbol,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetTokenMetadata) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetTokenMetadata) Type() string { return "set_token_metadata" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetTokenMetadata) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Name) == 0 {
+		return ErrInvalidTokenName(DefaultCodespace, "Name cannot be empty")
+	}
+	if len(msg.Symbol) == 0 || len(msg.OriginalSymbol) == 0 {
+		return ErrInvalidSymbol(DefaultCodespace, "Symbols cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetTokenMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetTokenMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dev10/fantom-asset-management/x/assetmanagement/rand"
@@ -68,8 +69,41 @@ func TestMsgIssueTokenValidation(t *testing.T) {
 		{false, NewMsgIssueToken(nil, name, symbol, originalSymbol, total2, false)},
 		{false, NewMsgIssueToken(acc2, "", symbol, originalSymbol, total2, false)},
 		{false, NewMsgIssueToken(acc2, name, symbol, originalSymbol, totalInvalid, false)},
+		{false, NewMsgIssueToken(acc, name, "zap-123", originalSymbol, total, false)},
+		{false, NewMsgIssueToken(acc, name, "1zap", originalSymbol, total, false)},
 	}
 
+	clawbackable := NewMsgIssueToken(acc, name, symbol, originalSymbol, total, false)
+	clawbackable.Clawbackable = true
+	clawbackable.ClawbackAdmin = acc2
+	cases = append(cases, struct {
+		valid bool
+		tx    MsgInterface
+	}{true, clawbackable})
+	adminOnly := clawbackable
+	adminOnly.Clawbackable = false
+	cases = append(cases, struct {
+		valid bool
+		tx    MsgInterface
+	}{false, adminOnly})
+	ownerOnly := NewMsgIssueToken(acc, name, symbol, originalSymbol, total, false)
+	ownerOnly.TransferMode = TransferModeOwnerOnly
+	unknownMode := ownerOnly
+	unknownMode.TransferMode = "soulbound"
+	cases = append(cases, []struct {
+		valid bool
+		tx    MsgInterface
+	}{{true, ownerOnly}, {false, unknownMode}}...)
+	limited := NewMsgIssueToken(acc, name, symbol, originalSymbol, total, false)
+	limited.MaxBalancePerAccount = 1000
+	limited.MaxHolders = 10
+	negativeBalance := limited
+	negativeBalance.MaxBalancePerAccount = -1
+	cases = append(cases, []struct {
+		valid bool
+		tx    MsgInterface
+	}{{true, limited}, {false, negativeBalance}}...)
+
 	validateError(cases, t)
 }
 
@@ -311,3 +345,444 @@ func TestMsgUnfreezeCoinsGetSignBytes(t *testing.T) {
 
 	require.Equal(t, expected, string(actual))
 }
+
+func TestMsgDistribute(t *testing.T) {
+	var (
+		sender = sdk.AccAddress([]byte("me"))
+		msg    = NewMsgDistribute(sender, "zap001", []Recipient{NewRecipient(sdk.AccAddress([]byte("you")), 10)})
+	)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "distribute")
+}
+
+func TestMsgDistributeValidation(t *testing.T) {
+	var (
+		symbol    = "zap001"
+		sender    = sdk.AccAddress([]byte("me"))
+		recipient = NewRecipient(sdk.AccAddress([]byte("you")), 10)
+		tooMany   = make([]Recipient, MaxDistributeRecipients+1)
+	)
+	for i := range tooMany {
+		tooMany[i] = recipient
+	}
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgDistribute(sender, symbol, []Recipient{recipient})},
+		{true, NewMsgDistribute(sender, symbol, tooMany[:MaxDistributeRecipients])},
+		{false, NewMsgDistribute(sender, symbol, tooMany)},
+		{false, NewMsgDistribute(sender, symbol, nil)},
+		{false, NewMsgDistribute(nil, symbol, []Recipient{recipient})},
+		{false, NewMsgDistribute(sender, "", []Recipient{recipient})},
+		{false, NewMsgDistribute(sender, symbol, []Recipient{NewRecipient(nil, 10)})},
+		{false, NewMsgDistribute(sender, symbol, []Recipient{recipient, NewRecipient(recipient.Address, 0)})},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgDistributeGetSignBytes(t *testing.T) {
+	msg := NewMsgDistribute(sdk.AccAddress([]byte("me")), "zap001",
+		[]Recipient{NewRecipient(sdk.AccAddress([]byte("me")), 10)})
+	actual := msg.GetSignBytes()
+
+	expected := `{"type":"assetmanagement/Distribute","value":{` +
+		`"recipients":[{"address":"cosmos1d4js690r9j","amount":"10"}],` +
+		`"sender":"cosmos1d4js690r9j",` +
+		`"symbol":"zap001"}}`
+
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgCreateClaimCampaignValidation(t *testing.T) {
+	var (
+		owner   = sdk.AccAddress([]byte("me"))
+		root    = strings.Repeat("ab", 32)
+		endTime = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgCreateClaimCampaign(owner, "zap001", root, 100, endTime)},
+		{false, NewMsgCreateClaimCampaign(nil, "zap001", root, 100, endTime)},
+		{false, NewMsgCreateClaimCampaign(owner, "", root, 100, endTime)},
+		{false, NewMsgCreateClaimCampaign(owner, "zap001", "abcd", 100, endTime)},
+		{false, NewMsgCreateClaimCampaign(owner, "zap001", strings.Repeat("zz", 32), 100, endTime)},
+		{false, NewMsgCreateClaimCampaign(owner, "zap001", root, 0, endTime)},
+		{false, NewMsgCreateClaimCampaign(owner, "zap001", root, 100, time.Time{})},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgClaimValidation(t *testing.T) {
+	var (
+		claimant = sdk.AccAddress([]byte("me"))
+		proof    = []string{strings.Repeat("ab", 32)}
+	)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgClaim(claimant, 1, 10, proof)},
+		{true, NewMsgClaim(claimant, 1, 10, nil)},
+		{false, NewMsgClaim(nil, 1, 10, proof)},
+		{false, NewMsgClaim(claimant, 1, 0, proof)},
+		{false, NewMsgClaim(claimant, 1, 10, []string{"not hex"})},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgApproveValidation(t *testing.T) {
+	var (
+		owner   = sdk.AccAddress([]byte("me"))
+		spender = sdk.AccAddress([]byte("you"))
+		expiry  = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgApprove(owner, spender, "zap001", 100, expiry)},
+		{true, NewMsgApprove(owner, spender, "zap001", 100, time.Time{})},
+		{true, NewMsgApprove(owner, spender, "zap001", 0, expiry)},
+		{false, NewMsgApprove(nil, spender, "zap001", 100, expiry)},
+		{false, NewMsgApprove(owner, nil, "zap001", 100, expiry)},
+		{false, NewMsgApprove(owner, owner, "zap001", 100, expiry)},
+		{false, NewMsgApprove(owner, spender, "", 100, expiry)},
+		{false, NewMsgApprove(owner, spender, "zap001", -1, expiry)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgTransferFromValidation(t *testing.T) {
+	var (
+		spender = sdk.AccAddress([]byte("me"))
+		from    = sdk.AccAddress([]byte("you"))
+		to      = sdk.AccAddress([]byte("them"))
+	)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgTransferFrom(spender, from, to, "zap001", 10)},
+		{true, NewMsgTransferFrom(spender, from, spender, "zap001", 10)},
+		{false, NewMsgTransferFrom(nil, from, to, "zap001", 10)},
+		{false, NewMsgTransferFrom(spender, nil, to, "zap001", 10)},
+		{false, NewMsgTransferFrom(spender, from, nil, "zap001", 10)},
+		{false, NewMsgTransferFrom(spender, from, to, "", 10)},
+		{false, NewMsgTransferFrom(spender, from, to, "zap001", 0)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgClawbackValidation(t *testing.T) {
+	var (
+		authority = sdk.AccAddress([]byte("me"))
+		holder    = sdk.AccAddress([]byte("you"))
+	)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgClawback(authority, "zap001", holder, authority, 10, "court order")},
+		{true, NewMsgClawback(authority, "zap001", holder, nil, 10, "court order")},
+		{false, NewMsgClawback(nil, "zap001", holder, nil, 10, "court order")},
+		{false, NewMsgClawback(authority, "", holder, nil, 10, "court order")},
+		{false, NewMsgClawback(authority, "zap001", nil, nil, 10, "court order")},
+		{false, NewMsgClawback(authority, "zap001", holder, holder, 10, "court order")},
+		{false, NewMsgClawback(authority, "zap001", holder, nil, 0, "court order")},
+		{false, NewMsgClawback(authority, "zap001", holder, nil, 10, "")},
+		{false, NewMsgClawback(authority, "zap001", holder, nil, 10, strings.Repeat("a", MaxClawbackReasonLength+1))},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgSetClawbackAdminValidation(t *testing.T) {
+	var (
+		owner = sdk.AccAddress([]byte("me"))
+		admin = sdk.AccAddress([]byte("you"))
+	)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgSetClawbackAdmin(owner, "zap001", admin)},
+		{true, NewMsgSetClawbackAdmin(owner, "zap001", nil)},
+		{false, NewMsgSetClawbackAdmin(nil, "zap001", admin)},
+		{false, NewMsgSetClawbackAdmin(owner, "", admin)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgSetTransferFeeValidation(t *testing.T) {
+	var (
+		owner     = sdk.AccAddress([]byte("me"))
+		recipient = sdk.AccAddress([]byte("you"))
+	)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgSetTransferFee(owner, "zap001", 25, 1, 100, recipient)},
+		{true, NewMsgSetTransferFee(owner, "zap001", 0, 0, 0, recipient)},
+		{false, NewMsgSetTransferFee(nil, "zap001", 25, 1, 100, recipient)},
+		{false, NewMsgSetTransferFee(owner, "", 25, 1, 100, recipient)},
+		{false, NewMsgSetTransferFee(owner, "zap001", MaxTransferFeeRate+1, 0, 0, recipient)},
+		{false, NewMsgSetTransferFee(owner, "zap001", 25, 100, 1, recipient)},
+		{false, NewMsgSetTransferFee(owner, "zap001", 25, 1, 100, nil)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgSetTransferFeeExemptionValidation(t *testing.T) {
+	var (
+		owner    = sdk.AccAddress([]byte("me"))
+		exchange = sdk.AccAddress([]byte("you"))
+	)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgSetTransferFeeExemption(owner, "zap001", exchange, true)},
+		{true, NewMsgSetTransferFeeExemption(owner, "zap001", exchange, false)},
+		{false, NewMsgSetTransferFeeExemption(nil, "zap001", exchange, true)},
+		{false, NewMsgSetTransferFeeExemption(owner, "", exchange, true)},
+		{false, NewMsgSetTransferFeeExemption(owner, "zap001", nil, true)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgSetHoldingLimitsValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("me"))
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgSetHoldingLimits(owner, "zap001", 1000, 10)},
+		{true, NewMsgSetHoldingLimits(owner, "zap001", 0, 0)},
+		{false, NewMsgSetHoldingLimits(nil, "zap001", 1000, 10)},
+		{false, NewMsgSetHoldingLimits(owner, "", 1000, 10)},
+		{false, NewMsgSetHoldingLimits(owner, "zap001", -1, 10)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgRedenominateValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("me"))
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgRedenominate(owner, "zap001", 2, 1)},
+		{true, NewMsgRedenominate(owner, "zap001", 1, MaxRedenominationFactor)},
+		{false, NewMsgRedenominate(nil, "zap001", 2, 1)},
+		{false, NewMsgRedenominate(owner, "", 2, 1)},
+		{false, NewMsgRedenominate(owner, "zap001", 0, 1)},
+		{false, NewMsgRedenominate(owner, "zap001", 1, 0)},
+		{false, NewMsgRedenominate(owner, "zap001", 3, 3)},
+		{false, NewMsgRedenominate(owner, "zap001", MaxRedenominationFactor+1, 1)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgOpenConversionValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("me"))
+	deadline := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgOpenConversion(owner, "old001", "new001", 1, 1, deadline)},
+		{true, NewMsgOpenConversion(owner, "old001", "new001", 1, MaxConversionFactor, deadline)},
+		{false, NewMsgOpenConversion(nil, "old001", "new001", 1, 1, deadline)},
+		{false, NewMsgOpenConversion(owner, "", "new001", 1, 1, deadline)},
+		{false, NewMsgOpenConversion(owner, "old001", "", 1, 1, deadline)},
+		{false, NewMsgOpenConversion(owner, "old001", "old001", 1, 1, deadline)},
+		{false, NewMsgOpenConversion(owner, "old001", "new001", 0, 1, deadline)},
+		{false, NewMsgOpenConversion(owner, "old001", "new001", MaxConversionFactor+1, 1, deadline)},
+		{false, NewMsgOpenConversion(owner, "old001", "new001", 1, 1, time.Time{})},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgConvertValidation(t *testing.T) {
+	holder := sdk.AccAddress([]byte("me"))
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgConvert(holder, "old001", 1)},
+		{false, NewMsgConvert(nil, "old001", 1)},
+		{false, NewMsgConvert(holder, "", 1)},
+		{false, NewMsgConvert(holder, "old001", 0)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgSetTokenStatusValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("me"))
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgSetTokenStatus(owner, "abc001", TokenStatusDeprecated)},
+		{true, NewMsgSetTokenStatus(owner, "abc001", TokenStatusRetired)},
+		{false, NewMsgSetTokenStatus(owner, "abc001", TokenStatusActive)},
+		{false, NewMsgSetTokenStatus(owner, "abc001", "frozen")},
+		{false, NewMsgSetTokenStatus(nil, "abc001", TokenStatusDeprecated)},
+		{false, NewMsgSetTokenStatus(owner, "", TokenStatusDeprecated)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgCouncilValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("me"))
+	member := sdk.AccAddress([]byte("you"))
+	council := CouncilAddress("abc001")
+	mint := NewMsgMintCoins(10, "abc001", council)
+	expiry := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgSetCouncil(owner, "abc001", []sdk.AccAddress{owner, member}, 2)},
+		{false, NewMsgSetCouncil(owner, "abc001", []sdk.AccAddress{owner, member}, 0)},
+		{false, NewMsgSetCouncil(owner, "abc001", []sdk.AccAddress{owner, member}, 3)},
+		{false, NewMsgSetCouncil(owner, "abc001", []sdk.AccAddress{owner, owner}, 1)},
+		{false, NewMsgSetCouncil(owner, "abc001", []sdk.AccAddress{council}, 1)},
+		{false, NewMsgSetCouncil(owner, "abc001", nil, 1)},
+		{false, NewMsgSetCouncil(nil, "abc001", []sdk.AccAddress{owner}, 1)},
+		{true, NewMsgProposeAction(member, "abc001", mint, expiry)},
+		{false, NewMsgProposeAction(member, "abc001", mint, time.Time{})},
+		{false, NewMsgProposeAction(member, "abc002", mint, expiry)},
+		{false, NewMsgProposeAction(member, "abc001", NewMsgMintCoins(10, "abc001", owner), expiry)},
+		{false, NewMsgProposeAction(member, "abc001", NewMsgMintCoins(0, "abc001", council), expiry)},
+		{false, NewMsgProposeAction(member, "abc001", NewMsgApproveAction(council, 1), expiry)},
+		{false, NewMsgProposeAction(member, "abc001", nil, expiry)},
+		{false, NewMsgProposeAction(nil, "abc001", mint, expiry)},
+		{true, NewMsgApproveAction(member, 1)},
+		{false, NewMsgApproveAction(nil, 1)},
+		{true, NewMsgRevokeApproval(member, 1)},
+		{false, NewMsgRevokeApproval(nil, 1)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgTimelockValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("me"))
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgSetTimelock(owner, "abc001", 48*time.Hour)},
+		{true, NewMsgSetTimelock(owner, "abc001", 0)},
+		{true, NewMsgSetTimelock(owner, "abc001", MaxTimelockDelay)},
+		{false, NewMsgSetTimelock(owner, "abc001", MaxTimelockDelay+time.Second)},
+		{false, NewMsgSetTimelock(owner, "abc001", -time.Hour)},
+		{false, NewMsgSetTimelock(owner, "", time.Hour)},
+		{false, NewMsgSetTimelock(nil, "abc001", time.Hour)},
+		{true, NewMsgCancelQueuedAction(owner, 1)},
+		{false, NewMsgCancelQueuedAction(nil, 1)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgOwnershipValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("me"))
+	newOwner := sdk.AccAddress([]byte("you"))
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgTransferOwnership(owner, "abc001", newOwner)},
+		{false, NewMsgTransferOwnership(owner, "abc001", CouncilAddress("abc001"))},
+		{false, NewMsgTransferOwnership(owner, "abc001", nil)},
+		{false, NewMsgTransferOwnership(owner, "", newOwner)},
+		{false, NewMsgTransferOwnership(nil, "abc001", newOwner)},
+		{true, NewMsgSetTokenMetadata(owner, "abc001", "Abc", "ABC")},
+		{false, NewMsgSetTokenMetadata(owner, "abc001", "", "ABC")},
+		{false, NewMsgSetTokenMetadata(owner, "abc001", "Abc", "")},
+		{false, NewMsgSetTokenMetadata(owner, "", "Abc", "ABC")},
+		{false, NewMsgSetTokenMetadata(nil, "abc001", "Abc", "ABC")},
+		{true, NewMsgSetTokenPaused(owner, "abc001", true)},
+		{true, NewMsgSetTokenPaused(owner, "abc001", false)},
+		{false, NewMsgSetTokenPaused(owner, "", true)},
+		{false, NewMsgSetTokenPaused(nil, "abc001", true)},
+	}
+
+	validateError(cases, t)
+}
+
+func TestMsgGrantValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("me"))
+	grantee := sdk.AccAddress([]byte("you"))
+	mint := MsgMintCoins{}.Type()
+	expiry := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tooMany := make([]sdk.Msg, MaxExecMsgs+1)
+	for i := range tooMany {
+		tooMany[i] = NewMsgMintCoins(10, "abc001", owner)
+	}
+
+	cases := []struct {
+		valid bool
+		tx    MsgInterface
+	}{
+		{true, NewMsgGrant(owner, grantee, "abc001", mint, 0, time.Time{})},
+		{true, NewMsgGrant(owner, grantee, "abc001", mint, 1000, expiry)},
+		{true, NewMsgGrant(owner, grantee, "abc001", MsgSetEmissionPaused{}.Type(), 0, expiry)},
+		{false, NewMsgGrant(owner, grantee, "abc001", MsgSetEmissionPaused{}.Type(), 10, expiry)},
+		{false, NewMsgGrant(owner, grantee, "abc001", MsgSetTimelock{}.Type(), 0, expiry)},
+		{false, NewMsgGrant(owner, grantee, "abc001", mint, -1, expiry)},
+		{false, NewMsgGrant(owner, owner, "abc001", mint, 0, expiry)},
+		{false, NewMsgGrant(owner, grantee, "", mint, 0, expiry)},
+		{false, NewMsgGrant(nil, grantee, "abc001", mint, 0, expiry)},
+		{false, NewMsgGrant(owner, nil, "abc001", mint, 0, expiry)},
+		{true, NewMsgRevokeGrant(owner, grantee, "abc001", mint)},
+		{false, NewMsgRevokeGrant(owner, nil, "abc001", mint)},
+		{false, NewMsgRevokeGrant(owner, grantee, "", mint)},
+		{true, NewMsgExec(grantee, []sdk.Msg{NewMsgMintCoins(10, "abc001", owner)})},
+		{false, NewMsgExec(grantee, []sdk.Msg{NewMsgMintCoins(0, "abc001", owner)})},
+		{false, NewMsgExec(grantee, []sdk.Msg{NewMsgSetHoldingLimits(owner, "abc001", 10, 0)})},
+		{false, NewMsgExec(grantee, []sdk.Msg{nil})},
+		{false, NewMsgExec(grantee, nil)},
+		{false, NewMsgExec(grantee, tooMany)},
+		{false, NewMsgExec(nil, []sdk.Msg{NewMsgMintCoins(10, "abc001", owner)})},
+	}
+
+	validateError(cases, t)
+}
@@ -1,6 +1,11 @@
 package types
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // QueryResultSymbol is a payload for a symbols query
 type QueryResultSymbol []string
@@ -9,3 +14,163 @@ type QueryResultSymbol []string
 func (r QueryResultSymbol) String() string {
 	return strings.Join(r[:], "\n")
 }
+
+// AccountTokenBalance is the free and frozen balance an account holds of a single denomination
+type AccountTokenBalance struct {
+	Symbol         string  `json:"symbol"`
+	Name           string  `json:"name"`            // empty if the denom was not issued by this module
+	OriginalSymbol string  `json:"original_symbol"` // empty if the denom was not issued by this module
+	Free           sdk.Int `json:"free"`
+	Frozen         sdk.Int `json:"frozen"`
+}
+
+// String implements fmt.Stringer
+func (b AccountTokenBalance) String() string {
+	return fmt.Sprintf("%s (%s): free %s, frozen %s", b.Symbol, b.Name, b.Free, b.Frozen)
+}
+
+// QueryResultAccount is a payload for an account query
+type QueryResultAccount struct {
+	Address  sdk.AccAddress        `json:"address"`
+	Balances []AccountTokenBalance `json:"balances"`
+}
+
+// String implements fmt.Stringer
+func (r QueryResultAccount) String() string {
+	lines := []string{fmt.Sprintf("Address: %s", r.Address)}
+	for _, balance := range r.Balances {
+		lines = append(lines, "  "+balance.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// QueryResultCampaigns is a payload for a campaigns query
+type QueryResultCampaigns []ClaimCampaign
+
+// String implements fmt.Stringer
+func (r QueryResultCampaigns) String() string {
+	campaigns := make([]string, len(r))
+	for i, campaign := range r {
+		campaigns[i] = campaign.String()
+	}
+	return strings.Join(campaigns, "\n\n")
+}
+
+// QueryResultVestingGrants is a payload for the vesting grants queries
+type QueryResultVestingGrants []VestingGrant
+
+// String implements fmt.Stringer
+func (r QueryResultVestingGrants) String() string {
+	grants := make([]string, len(r))
+	for i, grant := range r {
+		grants[i] = grant.String()
+	}
+	return strings.Join(grants, "\n\n")
+}
+
+// QueryResultEmissions is a payload for an emissions query
+type QueryResultEmissions []EmissionSchedule
+
+// String implements fmt.Stringer
+func (r QueryResultEmissions) String() string {
+	emissions := make([]string, len(r))
+	for i, emission := range r {
+		emissions[i] = emission.String()
+	}
+	return strings.Join(emissions, "\n\n")
+}
+
+// QueryResultAllowances is a payload for the allowances queries
+type QueryResultAllowances []Allowance
+
+// String implements fmt.Stringer
+func (r QueryResultAllowances) String() string {
+	allowances := make([]string, len(r))
+	for i, allowance := range r {
+		allowances[i] = allowance.String()
+	}
+	return strings.Join(allowances, "\n\n")
+}
+
+// QueryResultClawbacks is a payload for a clawback history query
+type QueryResultClawbacks []ClawbackRecord
+
+// String implements fmt.Stringer
+func (r QueryResultClawbacks) String() string {
+	clawbacks := make([]string, len(r))
+	for i, clawback := range r {
+		clawbacks[i] = clawback.String()
+	}
+	return strings.Join(clawbacks, "\n\n")
+}
+
+// QueryResultRedenominations is a payload for a redenomination history query
+type QueryResultRedenominations []Redenomination
+
+// String implements fmt.Stringer
+func (r QueryResultRedenominations) String() string {
+	redenominations := make([]string, len(r))
+	for i, redenomination := range r {
+		redenominations[i] = redenomination.String()
+	}
+	return strings.Join(redenominations, "\n\n")
+}
+
+// QueryResultConversions is a payload for a conversion windows query
+type QueryResultConversions []ConversionWindow
+
+// String implements fmt.Stringer
+func (r QueryResultConversions) String() string {
+	windows := make([]string, len(r))
+	for i, window := range r {
+		windows[i] = window.String()
+	}
+	return strings.Join(windows, "\n\n")
+}
+
+// QueryResultTokenStatus is a payload for a token status query, it also answers for retired tokens
+type QueryResultTokenStatus struct {
+	Symbol string      `json:"symbol"`
+	Status TokenStatus `json:"status"`
+}
+
+// String implements fmt.Stringer
+func (r QueryResultTokenStatus) String() string {
+	return fmt.Sprintf("%s: %s", r.Symbol, r.Status)
+}
+
+// QueryResultPendingActions is a payload for a pending actions query
+type QueryResultPendingActions []PendingAction
+
+// String implements fmt.Stringer
+func (r QueryResultPendingActions) String() string {
+	actions := make([]string, len(r))
+	for i, action := range r {
+		actions[i] = action.String()
+	}
+	return strings.Join(actions, "\n\n")
+}
+
+// QueryResultQueuedActions is a payload for a queued actions query
+type QueryResultQueuedActions []QueuedAction
+
+// String implements fmt.Stringer
+func (r QueryResultQueuedActions) String() string {
+	actions := make([]string, len(r))
+	for i, action := range r {
+		actions[i] = action.String()
+	}
+	return strings.Join(actions, "\n\n")
+}
+
+// QueryResultGrants is a payload for the grants queries
+type QueryResultGrants []Grant
+
+// String implements fmt.Stringer
+func (r QueryResultGrants) String() string {
+	grants := make([]string, len(r))
+	for i, grant := range r {
+		grants[i] = grant.String()
+	}
+	return strings.Join(grants, "\n\n")
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxRedenominationFactor bounds the numerator and denominator of a redenomination
+const MaxRedenominationFactor = 1000000000
+
+// Redenomination is an entry in the redenomination history of a token. Every amount of the token was multiplied by
+// Numerator / Denominator and rounded down, the rounding dust of all the balances went to the owner
+type Redenomination struct {
+	Symbol       string         `json:"symbol"`
+	Owner        sdk.AccAddress `json:"owner"`
+	Numerator    uint64         `json:"numerator"`
+	Denominator  uint64         `json:"denominator"`
+	SupplyBefore sdk.Int        `json:"supply_before"`
+	SupplyAfter  sdk.Int        `json:"supply_after"`
+	Dust         sdk.Int        `json:"dust"` // paid to the owner on top of its own rescaled balance
+	Height       int64          `json:"height"`
+	Time         time.Time      `json:"time"`
+}
+
+// ValidateRedenominationRatio checks that a ratio changes the amounts and stays within MaxRedenominationFactor
+func ValidateRedenominationRatio(numerator, denominator uint64) sdk.Error {
+	if numerator == 0 || denominator == 0 {
+		return ErrInvalidRedenomination(DefaultCodespace, "Numerator and Denominator must be positive")
+	}
+	if numerator > MaxRedenominationFactor || denominator > MaxRedenominationFactor {
+		return ErrInvalidRedenomination(DefaultCodespace,
+			fmt.Sprintf("Numerator and Denominator cannot be larger than %d", MaxRedenominationFactor))
+	}
+	if numerator == denominator {
+		return ErrInvalidRedenomination(DefaultCodespace, "Numerator and Denominator cannot be equal")
+	}
+	return nil
+}
+
+// ScaleAmount multiplies an amount by the ratio of a redenomination, rounding down
+func ScaleAmount(amount sdk.Int, numerator, denominator uint64) sdk.Int {
+	return amount.Mul(sdk.NewInt(int64(numerator))).Quo(sdk.NewInt(int64(denominator)))
+}
+
+// String implements fmt.Stringer
+func (r Redenomination) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Symbol: %s
+Owner: %s
+Ratio: %d/%d
+Supply Before: %s
+Supply After: %s
+Dust: %s
+Height: %d
+Time: %s`, r.Symbol, r.Owner, r.Numerator, r.Denominator, r.SupplyBefore, r.SupplyAfter, r.Dust, r.Height, r.Time))
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTimelockDelay is the longest delay a token's rule changes can be held back for
+const MaxTimelockDelay = 30 * 24 * time.Hour
+
+// ValidateTimelockDelay checks the delay of a token's timelock, 0 turns the timelock off
+func ValidateTimelockDelay(delay time.Duration) sdk.Error {
+	if delay < 0 || delay > MaxTimelockDelay {
+		return ErrInvalidTimelock(DefaultCodespace,
+			fmt.Sprintf("delay %s must be between 0 and %s", delay, MaxTimelockDelay))
+	}
+	return nil
+}
+
+// TimelockedSymbol tells whether a message changes the rules of a token, which a timelock holds back, and returns
+// the symbol of that token and the account signing the change. Only the changes signed by the token's owner are held
+// back, eg a holder granting its own messages isn't
+func TimelockedSymbol(msg sdk.Msg) (symbol string, owner sdk.AccAddress, ok bool) {
+	switch msg := msg.(type) {
+	case MsgSetEmission:
+		return msg.Symbol, msg.Owner, true
+	case MsgSetClawbackAdmin:
+		return msg.Symbol, msg.Owner, true
+	case MsgSetTransferFee:
+		return msg.Symbol, msg.Owner, true
+	case MsgSetTransferFeeExemption:
+		return msg.Symbol, msg.Owner, true
+	case MsgSetHoldingLimits:
+		return msg.Symbol, msg.Owner, true
+	case MsgRedenominate:
+		return msg.Symbol, msg.Owner, true
+	case MsgOpenConversion:
+		return msg.Source, msg.Owner, true
+	case MsgSetTokenStatus:
+		return msg.Symbol, msg.Owner, true
+	case MsgSetCouncil:
+		return msg.Symbol, msg.Owner, true
+	case MsgSetTimelock:
+		return msg.Symbol, msg.Owner, true
+	case MsgTransferOwnership:
+		return msg.Symbol, msg.Owner, true
+	case MsgSetTokenMetadata:
+		return msg.Symbol, msg.Owner, true
+	case MsgGrant:
+		// granting the owner's messages, eg minting, to another key changes who can act on the token
+		return msg.Symbol, msg.Granter, true
+	default:
+		return "", nil, false
+	}
+}
+
+// QueuedAction is a rule change of a timelocked token waiting for its ETA, when it runs unless its owner cancelled it
+type QueuedAction struct {
+	ID       uint64         `json:"id"`
+	Symbol   string         `json:"symbol"`
+	Owner    sdk.AccAddress `json:"owner"`
+	Action   sdk.Msg        `json:"action"`
+	QueuedAt time.Time      `json:"queued_at"`
+	ETA      time.Time      `json:"eta"`
+}
+
+// NewQueuedAction returns a new queued action
+func NewQueuedAction(id uint64, symbol string, owner sdk.AccAddress, action sdk.Msg, queuedAt,
+	eta time.Time) QueuedAction {
+	return QueuedAction{
+		ID:       id,
+		Symbol:   symbol,
+		Owner:    owner,
+		Action:   action,
+		QueuedAt: queuedAt,
+		ETA:      eta,
+	}
+}
+
+// IsDue tells whether the action runs at the given block time
+func (a QueuedAction) IsDue(blockTime time.Time) bool {
+	return !blockTime.Before(a.ETA)
+}
+
+// String implements fmt.Stringer
+func (a QueuedAction) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`ID: %d
+Symbol: %s
+Owner: %s
+Action: %s
+Queued At: %s
+ETA: %s`, a.ID, a.Symbol, a.Owner, a.Action.Type(), a.QueuedAt, a.ETA))
+}
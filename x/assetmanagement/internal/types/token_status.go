@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TokenStatus is the stage of a token's lifecycle, it only moves forward: active, deprecated, retired
+type TokenStatus string
+
+const (
+	// TokenStatusActive is the status of every issued token, tokens issued before lifecycle statuses have an empty
+	// status, which means the same
+	TokenStatusActive TokenStatus = "active"
+	// TokenStatusDeprecated tokens can't be minted and can't reach new holders, the existing holders can still move
+	// them between themselves and back to the owner
+	TokenStatusDeprecated TokenStatus = "deprecated"
+	// TokenStatusRetired tokens had no supply left and were removed, only an archived record is kept
+	TokenStatusRetired TokenStatus = "retired"
+)
+
+// TokenStatusFromString parses a token status, an empty string is the active status
+func TokenStatusFromString(status string) (TokenStatus, error) {
+	switch TokenStatus(status) {
+	case "", TokenStatusActive:
+		return TokenStatusActive, nil
+	case TokenStatusDeprecated, TokenStatusRetired:
+		return TokenStatus(status), nil
+	default:
+		return "", fmt.Errorf("'%s' is not a valid token status, expected %s, %s or %s", status, TokenStatusActive,
+			TokenStatusDeprecated, TokenStatusRetired)
+	}
+}
+
+// IsValid tells whether the status is known
+func (s TokenStatus) IsValid() bool {
+	_, err := TokenStatusFromString(string(s))
+	return err == nil
+}
+
+// CanMoveTo tells whether a token may go from this status to the next one, statuses never go back
+func (s TokenStatus) CanMoveTo(next TokenStatus) bool {
+	current, _ := TokenStatusFromString(string(s))
+	switch current {
+	case TokenStatusActive:
+		return next == TokenStatusDeprecated || next == TokenStatusRetired
+	case TokenStatusDeprecated:
+		return next == TokenStatusRetired
+	default:
+		return false
+	}
+}
+
+// ArchivedToken is what is left of a retired token: its metadata as it was when it was retired
+type ArchivedToken struct {
+	Token  Token     `json:"token"`
+	Height int64     `json:"height"` // block height the token was retired at
+	Time   time.Time `json:"time"`
+}
+
+// String implements fmt.Stringer
+func (a ArchivedToken) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`%s
+Retired Height: %d
+Retired Time: %s`, a.Token, a.Height, a.Time))
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenStatusFromString(t *testing.T) {
+	for input, expected := range map[string]TokenStatus{
+		"":           TokenStatusActive,
+		"active":     TokenStatusActive,
+		"deprecated": TokenStatusDeprecated,
+		"retired":    TokenStatusRetired,
+	} {
+		status, err := TokenStatusFromString(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, status)
+		require.True(t, TokenStatus(input).IsValid())
+	}
+
+	_, err := TokenStatusFromString("frozen")
+	require.Error(t, err)
+	require.False(t, TokenStatus("ACTIVE").IsValid())
+}
+
+func TestTokenStatusCanMoveTo(t *testing.T) {
+	for _, status := range []TokenStatus{"", TokenStatusActive} {
+		require.True(t, status.CanMoveTo(TokenStatusDeprecated))
+		require.True(t, status.CanMoveTo(TokenStatusRetired))
+		require.False(t, status.CanMoveTo(TokenStatusActive))
+	}
+	require.True(t, TokenStatusDeprecated.CanMoveTo(TokenStatusRetired))
+	require.False(t, TokenStatusDeprecated.CanMoveTo(TokenStatusActive))
+	require.False(t, TokenStatusDeprecated.CanMoveTo(TokenStatusDeprecated))
+	require.False(t, TokenStatusRetired.CanMoveTo(TokenStatusActive))
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxTransferFeeRate is the highest transfer fee, in basis points of the transferred amount
+	MaxTransferFeeRate = 10000
+	// MaxTransferFeeExemptions limits the exemption list, it is scanned on every transfer of the token
+	MaxTransferFeeExemptions = 100
+)
+
+// TransferFee is taken out of every transfer of a token between accounts and paid to the fee recipient. The fee is
+// Rate basis points of the transferred amount, raised to MinFee and capped at MaxFee, a zero MaxFee leaves it
+// uncapped. The recipient of a transfer receives the amount less the fee. Collected counts all fees paid so far
+type TransferFee struct {
+	Symbol    string           `json:"symbol"`
+	Rate      uint64           `json:"rate"`
+	MinFee    sdk.Int          `json:"min_fee"`
+	MaxFee    sdk.Int          `json:"max_fee"`
+	Recipient sdk.AccAddress   `json:"recipient"`
+	Exempt    []sdk.AccAddress `json:"exempt"`
+	Collected sdk.Int          `json:"collected"`
+}
+
+// NewTransferFee returns a new transfer fee that has collected nothing yet
+func NewTransferFee(symbol string, rate uint64, minFee, maxFee int64, recipient sdk.AccAddress) TransferFee {
+	return TransferFee{
+		Symbol:    symbol,
+		Rate:      rate,
+		MinFee:    sdk.NewInt(minFee),
+		MaxFee:    sdk.NewInt(maxFee),
+		Recipient: recipient,
+		Exempt:    []sdk.AccAddress{},
+		Collected: sdk.ZeroInt(),
+	}
+}
+
+// FeeFor returns the fee due on a transfer of the given amount, it never exceeds the amount
+func (f TransferFee) FeeFor(amount sdk.Int) sdk.Int {
+	fee := amount.MulRaw(int64(f.Rate)).QuoRaw(MaxTransferFeeRate)
+	if fee.LT(f.MinFee) {
+		fee = f.MinFee
+	}
+	if f.MaxFee.IsPositive() && fee.GT(f.MaxFee) {
+		fee = f.MaxFee
+	}
+	if fee.GT(amount) {
+		fee = amount
+	}
+	return fee
+}
+
+// IsExempt tells whether transfers from or to the address pay no fee
+func (f TransferFee) IsExempt(address sdk.AccAddress) bool {
+	if address.Equals(f.Recipient) {
+		return true
+	}
+	for _, exempt := range f.Exempt {
+		if address.Equals(exempt) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransferFee runs stateless checks on the parameters of a transfer fee
+func ValidateTransferFee(rate uint64, minFee, maxFee sdk.Int, recipient sdk.AccAddress) sdk.Error {
+	if rate > MaxTransferFeeRate {
+		return ErrInvalidTransferFee(DefaultCodespace,
+			fmt.Sprintf("Rate %d cannot be more than %d basis points", rate, MaxTransferFeeRate))
+	}
+	if isNilInt(minFee) || minFee.IsNegative() || isNilInt(maxFee) || maxFee.IsNegative() {
+		return ErrInvalidTransferFee(DefaultCodespace, "MinFee and MaxFee cannot be negative")
+	}
+	if maxFee.IsPositive() && maxFee.LT(minFee) {
+		return ErrInvalidTransferFee(DefaultCodespace,
+			fmt.Sprintf("MaxFee %s cannot be less than MinFee %s", maxFee, minFee))
+	}
+	if recipient.Empty() {
+		return ErrInvalidTransferFee(DefaultCodespace, "Recipient cannot be empty")
+	}
+	return nil
+}
+
+// Validate runs stateless checks on the transfer fee
+func (f TransferFee) Validate() sdk.Error {
+	if f.Symbol == "" {
+		return ErrInvalidTransferFee(DefaultCodespace, "Symbol cannot be empty")
+	}
+	if err := ValidateTransferFee(f.Rate, f.MinFee, f.MaxFee, f.Recipient); err != nil {
+		return err
+	}
+	if len(f.Exempt) > MaxTransferFeeExemptions {
+		return ErrInvalidTransferFee(DefaultCodespace,
+			fmt.Sprintf("cannot exempt more than %d addresses", MaxTransferFeeExemptions))
+	}
+	seen := make(map[string]bool, len(f.Exempt))
+	for _, exempt := range f.Exempt {
+		if exempt.Empty() || seen[exempt.String()] {
+			return ErrInvalidTransferFee(DefaultCodespace, "exempt addresses must be set and unique")
+		}
+		seen[exempt.String()] = true
+	}
+	if isNilInt(f.Collected) || f.Collected.IsNegative() {
+		return ErrInvalidTransferFee(DefaultCodespace, "Collected cannot be negative")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (f TransferFee) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Symbol: %s
+Rate: %d
+MinFee: %s
+MaxFee: %s
+Recipient: %s
+Exempt: %v
+Collected: %s`, f.Symbol, f.Rate, f.MinFee, f.MaxFee, f.Recipient, f.Exempt, f.Collected))
+}
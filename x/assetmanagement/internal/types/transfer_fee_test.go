@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferFeeFor(t *testing.T) {
+	_, _, recipient := KeyTestPubAddr()
+
+	// 1% with a floor of 5 and a cap of 50
+	fee := NewTransferFee("zap123", 100, 5, 50, recipient)
+	require.Equal(t, sdk.NewInt(10), fee.FeeFor(sdk.NewInt(1000)))
+	require.Equal(t, sdk.NewInt(5), fee.FeeFor(sdk.NewInt(100)))
+	require.Equal(t, sdk.NewInt(50), fee.FeeFor(sdk.NewInt(1000000)))
+	// never more than what is transferred
+	require.Equal(t, sdk.NewInt(3), fee.FeeFor(sdk.NewInt(3)))
+
+	uncapped := NewTransferFee("zap123", 100, 0, 0, recipient)
+	require.Equal(t, sdk.NewInt(10000), uncapped.FeeFor(sdk.NewInt(1000000)))
+	require.True(t, uncapped.FeeFor(sdk.NewInt(99)).IsZero())
+}
+
+func TestTransferFeeIsExempt(t *testing.T) {
+	_, _, recipient := KeyTestPubAddr()
+	_, _, exchange := KeyTestPubAddr()
+	_, _, holder := KeyTestPubAddr()
+
+	fee := NewTransferFee("zap123", 100, 0, 0, recipient)
+	fee.Exempt = []sdk.AccAddress{exchange}
+	require.True(t, fee.IsExempt(recipient))
+	require.True(t, fee.IsExempt(exchange))
+	require.False(t, fee.IsExempt(holder))
+}
+
+func TestValidateTransferFee(t *testing.T) {
+	_, _, recipient := KeyTestPubAddr()
+	require.Nil(t, NewTransferFee("zap123", 100, 5, 50, recipient).Validate())
+	require.Nil(t, NewTransferFee("zap123", 0, 0, 0, recipient).Validate())
+	require.Nil(t, NewTransferFee("zap123", MaxTransferFeeRate, 0, 0, recipient).Validate())
+
+	duplicate := NewTransferFee("zap123", 100, 0, 0, recipient)
+	duplicate.Exempt = []sdk.AccAddress{recipient, recipient}
+	negative := NewTransferFee("zap123", 100, 0, 0, recipient)
+	negative.Collected = sdk.NewInt(-1)
+
+	for _, fee := range []TransferFee{
+		NewTransferFee("", 100, 0, 0, recipient),
+		NewTransferFee("zap123", MaxTransferFeeRate+1, 0, 0, recipient),
+		NewTransferFee("zap123", 100, -1, 0, recipient),
+		NewTransferFee("zap123", 100, 10, 5, recipient),
+		NewTransferFee("zap123", 100, 0, 0, nil),
+		duplicate,
+		negative,
+	} {
+		require.Equal(t, CodeInvalidTransferFee, fee.Validate().Code(), fee.String())
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferMode restricts who the coins of a token can be moved between, it is chosen at issuance
+type TransferMode string
+
+const (
+	// TransferModeFree lets the coins move freely, tokens issued before transfer modes have an empty mode, which
+	// means the same
+	TransferModeFree TransferMode = "free"
+	// TransferModeNonTransferable only lets the owner hand out coins, holders can't move them, eg credentials
+	TransferModeNonTransferable TransferMode = "non_transferable"
+	// TransferModeOwnerOnly lets holders send coins to the owner only, eg for redemption
+	TransferModeOwnerOnly TransferMode = "owner_only"
+)
+
+// TransferModeFromString parses a transfer mode, an empty string is the free mode
+func TransferModeFromString(mode string) (TransferMode, error) {
+	switch TransferMode(mode) {
+	case "", TransferModeFree:
+		return TransferModeFree, nil
+	case TransferModeNonTransferable, TransferModeOwnerOnly:
+		return TransferMode(mode), nil
+	default:
+		return "", fmt.Errorf("'%s' is not a valid transfer mode, expected %s, %s or %s", mode, TransferModeFree,
+			TransferModeNonTransferable, TransferModeOwnerOnly)
+	}
+}
+
+// IsValid tells whether the mode is known
+func (m TransferMode) IsValid() bool {
+	_, err := TransferModeFromString(string(m))
+	return err == nil
+}
+
+// String implements fmt.Stringer
+func (m TransferMode) String() string {
+	if m == "" {
+		return string(TransferModeFree)
+	}
+	return string(m)
+}
+
+// CanTransfer tells whether the token's transfer mode lets coins move from one account to another. Movements made
+// by the issuer's own mechanisms, eg claim campaigns, vesting grants and clawbacks, aren't subject to it
+func (t Token) CanTransfer(from, to sdk.AccAddress) bool {
+	switch t.TransferMode {
+	case TransferModeNonTransferable:
+		return from.Equals(t.Owner)
+	case TransferModeOwnerOnly:
+		return from.Equals(t.Owner) || to.Equals(t.Owner)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferModeFromString(t *testing.T) {
+	for input, expected := range map[string]TransferMode{
+		"":                 TransferModeFree,
+		"free":             TransferModeFree,
+		"non_transferable": TransferModeNonTransferable,
+		"owner_only":       TransferModeOwnerOnly,
+	} {
+		mode, err := TransferModeFromString(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, mode)
+		require.True(t, TransferMode(input).IsValid())
+	}
+
+	_, err := TransferModeFromString("soulbound")
+	require.Error(t, err)
+	require.False(t, TransferMode("FREE").IsValid())
+	require.Equal(t, "free", TransferMode("").String())
+}
+
+func TestTokenCanTransfer(t *testing.T) {
+	_, _, owner := KeyTestPubAddr()
+	_, _, alice := KeyTestPubAddr()
+	_, _, bob := KeyTestPubAddr()
+	token := NewToken("Zap", "zap123", "ZAP", 1000, owner, false)
+
+	for _, mode := range []TransferMode{"", TransferModeFree} {
+		token.TransferMode = mode
+		require.True(t, token.CanTransfer(alice, bob))
+	}
+
+	token.TransferMode = TransferModeNonTransferable
+	require.True(t, token.CanTransfer(owner, alice))
+	require.False(t, token.CanTransfer(alice, bob))
+	require.False(t, token.CanTransfer(alice, owner))
+
+	token.TransferMode = TransferModeOwnerOnly
+	require.True(t, token.CanTransfer(owner, alice))
+	require.True(t, token.CanTransfer(alice, owner))
+	require.False(t, token.CanTransfer(alice, bob))
+}
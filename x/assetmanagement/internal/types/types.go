@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -15,9 +17,9 @@ type Freezer interface {
 	SetFrozenCoins(sdk.Coins) error
 
 	// Freeze coins by a certain amount. It will reduce amount of coins available from GetCoins()
-	FreezeCoins(sdk.Coins) error
+	FreezeCoins(sdk.Coins) sdk.Error
 	// Unfreeze coins by a certain amount. It will increase the amount of coins available from GetCoins()
-	UnfreezeCoins(sdk.Coins) error
+	UnfreezeCoins(sdk.Coins) sdk.Error
 }
 
 // CustomCoinAccount extends the built in account interface with extra abilities such as frozen coins
@@ -34,6 +36,32 @@ type Token struct {
 	OriginalSymbol string         `json:"original_symbol"` // token symbol eg FTM
 	TotalSupply    sdk.Coins      `json:"total_supply"`    // Total token supply
 	Mintable       bool           `json:"mintable"`
+	Clawbackable   bool           `json:"clawbackable"`   // can only be enabled at issuance
+	ClawbackAdmin  sdk.AccAddress `json:"clawback_admin"` // may claw back besides the owner, optional
+	TransferMode   TransferMode   `json:"transfer_mode"`  // can only be chosen at issuance
+	// MaxBalancePerAccount caps the free plus frozen balance of any holder but the owner, 0 for no cap
+	MaxBalancePerAccount int64 `json:"max_balance_per_account"`
+	// MaxHolders caps how many accounts may hold the token, the owner included, 0 for no cap
+	MaxHolders uint64 `json:"max_holders"`
+	// Disabled tokens can no longer be transferred, minted or handed out, eg once converted to a new token
+	Disabled bool        `json:"disabled"`
+	Status   TokenStatus `json:"status"` // empty for tokens issued before lifecycle statuses, which are active
+	// Paused tokens can't move until their owner resumes them, eg while they are redenominated
+	Paused bool `json:"paused"`
+	// Timelock holds the rule changes of the token back for this long, during which the owner can cancel them
+	Timelock time.Duration `json:"timelock"`
+}
+
+// reSymbol matches the coin denominations the bank module accepts, a token's coins are denominated in its symbol
+var reSymbol = regexp.MustCompile(`^[a-z][a-z0-9]{2,15}$`)
+
+// ValidateSymbol checks that a stored symbol can be used as a coin denomination
+func ValidateSymbol(symbol string) sdk.Error {
+	if !reSymbol.MatchString(symbol) {
+		return ErrInvalidSymbol(DefaultCodespace,
+			fmt.Sprintf("symbol '%s' must be 3 to 16 lowercase letters and digits, starting with a letter", symbol))
+	}
+	return nil
 }
 
 // NewToken returns a new token
@@ -45,9 +73,21 @@ func NewToken(name, symbol, originalSymbol string, totalSupply int64, owner sdk.
 		TotalSupply:    sdk.Coins{sdk.NewInt64Coin(symbol, totalSupply)},
 		Owner:          owner,
 		Mintable:       mintable,
+		TransferMode:   TransferModeFree,
+		Status:         TokenStatusActive,
 	}
 }
 
+// IsDeprecated tells whether the token is deprecated, so it can't be minted nor reach new holders
+func (t Token) IsDeprecated() bool {
+	return t.Status == TokenStatusDeprecated
+}
+
+// CanClawback tells whether an address may claw back coins of the token, that is the owner or the clawback admin
+func (t Token) CanClawback(address sdk.AccAddress) bool {
+	return t.Clawbackable && (address.Equals(t.Owner) || !t.ClawbackAdmin.Empty() && address.Equals(t.ClawbackAdmin))
+}
+
 // String implements fmt.Stringer
 func (t Token) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Owner: %s
@@ -55,5 +95,16 @@ Name: %s
 Symbol: %s
 Original Symbol: %s
 Total Supply %s
-Mintable: %v`, t.Owner, t.Name, t.Symbol, t.OriginalSymbol, t.TotalSupply, t.Mintable))
+Mintable: %v
+Clawbackable: %v
+Clawback Admin: %s
+Transfer Mode: %s
+Max Balance Per Account: %d
+Max Holders: %d
+Disabled: %v
+Status: %s
+Paused: %v
+Timelock: %s`, t.Owner, t.Name, t.Symbol, t.OriginalSymbol, t.TotalSupply, t.Mintable, t.Clawbackable,
+		t.ClawbackAdmin, t.TransferMode, t.MaxBalancePerAccount, t.MaxHolders, t.Disabled, t.Status, t.Paused,
+		t.Timelock))
 }
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingGrant locks coins of a token for a beneficiary. Nothing vests before the cliff, from then on the coins vest
+// linearly between the start and end time. A grant with the cliff at the end time vests everything at once
+type VestingGrant struct {
+	ID          uint64         `json:"id"`
+	Owner       sdk.AccAddress `json:"owner"` // the token owner who funded the grant
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Symbol      string         `json:"symbol"`
+	Total       sdk.Coins      `json:"total"`
+	Released    sdk.Coins      `json:"released"`
+	StartTime   time.Time      `json:"start_time"`
+	CliffTime   time.Time      `json:"cliff_time"`
+	EndTime     time.Time      `json:"end_time"`
+}
+
+// NewVestingGrant returns a new vesting grant with nothing released
+func NewVestingGrant(id uint64, owner, beneficiary sdk.AccAddress, symbol string, total int64,
+	startTime, cliffTime, endTime time.Time) VestingGrant {
+	return VestingGrant{
+		ID:          id,
+		Owner:       owner,
+		Beneficiary: beneficiary,
+		Symbol:      symbol,
+		Total:       sdk.NewCoins(sdk.NewInt64Coin(symbol, total)),
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		EndTime:     endTime,
+	}
+}
+
+// ValidateVestingSchedule checks that the start, cliff and end times are in order
+func ValidateVestingSchedule(startTime, cliffTime, endTime time.Time) sdk.Error {
+	if startTime.IsZero() || cliffTime.IsZero() || endTime.IsZero() {
+		return ErrInvalidVestingGrant(DefaultCodespace, "StartTime, CliffTime and EndTime cannot be empty")
+	}
+	if cliffTime.Before(startTime) || endTime.Before(cliffTime) || !endTime.After(startTime) {
+		return ErrInvalidVestingGrant(DefaultCodespace,
+			fmt.Sprintf("start %s, cliff %s and end %s are out of order", startTime, cliffTime, endTime))
+	}
+	return nil
+}
+
+// VestedCoins returns the coins vested at the given time, released or not
+func (g VestingGrant) VestedCoins(blockTime time.Time) sdk.Coins {
+	switch {
+	case blockTime.Before(g.CliffTime):
+		return sdk.NewCoins()
+	case !blockTime.Before(g.EndTime):
+		return g.Total
+	}
+
+	elapsed := sdk.NewInt(int64(blockTime.Sub(g.StartTime)))
+	duration := sdk.NewInt(int64(g.EndTime.Sub(g.StartTime)))
+	vested := sdk.NewCoins()
+	for _, coin := range g.Total {
+		vested = vested.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration))))
+	}
+	return vested
+}
+
+// ReleasableCoins returns the vested coins that haven't been released yet
+func (g VestingGrant) ReleasableCoins(blockTime time.Time) sdk.Coins {
+	return g.VestedCoins(blockTime).Sub(g.Released)
+}
+
+// Locked returns the coins of the grant still held in the vesting pool
+func (g VestingGrant) Locked() sdk.Coins {
+	return g.Total.Sub(g.Released)
+}
+
+// String implements fmt.Stringer
+func (g VestingGrant) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`ID: %d
+Owner: %s
+Beneficiary: %s
+Symbol: %s
+Total: %s
+Released: %s
+Start Time: %s
+Cliff Time: %s
+End Time: %s`, g.ID, g.Owner, g.Beneficiary, g.Symbol, g.Total, g.Released, g.StartTime, g.CliffTime, g.EndTime))
+}
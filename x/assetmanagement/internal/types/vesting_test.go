@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestVestedCoins(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, owner := KeyTestPubAddr()
+	_, _, beneficiary := KeyTestPubAddr()
+	grant := NewVestingGrant(1, owner, beneficiary, "zap123", 1000, start, start.Add(25*time.Hour),
+		start.Add(100*time.Hour))
+
+	require.True(t, grant.VestedCoins(start.Add(-time.Hour)).IsZero())
+	require.True(t, grant.VestedCoins(start.Add(24*time.Hour)).IsZero())
+	// at the cliff everything vested since the start is released at once
+	require.Equal(t, NewTestCoins("zap123", 250), grant.VestedCoins(start.Add(25*time.Hour)))
+	require.Equal(t, NewTestCoins("zap123", 333), grant.VestedCoins(start.Add(100*time.Hour/3)))
+	require.Equal(t, grant.Total, grant.VestedCoins(start.Add(100*time.Hour)))
+	require.Equal(t, grant.Total, grant.VestedCoins(start.Add(1000*time.Hour)))
+
+	grant.Released = NewTestCoins("zap123", 250)
+	require.Equal(t, NewTestCoins("zap123", 250), grant.ReleasableCoins(start.Add(50*time.Hour)))
+	require.Equal(t, NewTestCoins("zap123", 750), grant.Locked())
+	require.True(t, grant.ReleasableCoins(start.Add(25*time.Hour)).IsZero())
+}
+
+func TestValidateVestingSchedule(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Nil(t, ValidateVestingSchedule(start, start, start.Add(time.Hour)))
+	require.Nil(t, ValidateVestingSchedule(start, start.Add(time.Hour), start.Add(time.Hour)))
+
+	for _, times := range [][3]time.Time{
+		{start, start, start},
+		{start, start.Add(-time.Hour), start.Add(time.Hour)},
+		{start, start.Add(2 * time.Hour), start.Add(time.Hour)},
+		{time.Time{}, start, start.Add(time.Hour)},
+	} {
+		err := ValidateVestingSchedule(times[0], times[1], times[2])
+		require.NotNil(t, err)
+		require.Equal(t, CodeInvalidVestingGrant, err.Code())
+	}
+
+	msg := NewMsgCreateVestingGrant(sdk.AccAddress("owner"), sdk.AccAddress("beneficiary"), "zap123", 0,
+		start, start, start.Add(time.Hour))
+	require.Equal(t, CodeInvalidAmount, msg.ValidateBasic().Code())
+}
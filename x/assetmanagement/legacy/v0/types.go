@@ -0,0 +1,23 @@
+// Package v0 holds the assetmanagement genesis types of the first release, before frozen balances were exported
+package v0
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const ModuleName = "assetmanagement"
+
+type (
+	Token struct {
+		Owner          sdk.AccAddress `json:"owner"`
+		Name           string         `json:"name"`
+		Symbol         string         `json:"symbol"`
+		OriginalSymbol string         `json:"original_symbol"`
+		TotalSupply    sdk.Coins      `json:"total_supply"`
+		Mintable       bool           `json:"mintable"`
+	}
+
+	GenesisState struct {
+		TokenRecords []Token `json:"token_records"`
+	}
+)
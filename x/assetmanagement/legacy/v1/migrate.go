@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	v0 "github.com/dev10/fantom-asset-management/x/assetmanagement/legacy/v0"
+)
+
+// Migrate converts a v0 assetmanagement genesis to v1. v0 didn't keep token supplies in step with balances, so
+// every total supply is recomputed from what the accounts hold. Every account that isn't a module account gets
+// an empty frozen balance so it is restored as a CustomAccount rather than a BaseAccount
+func Migrate(oldGenState v0.GenesisState, accounts genaccounts.GenesisState) GenesisState {
+	held := sdk.NewCoins()
+	frozenBalances := []FrozenBalance{}
+	for _, account := range accounts {
+		held = held.Add(account.Coins)
+		if account.ModuleName == "" {
+			frozenBalances = append(frozenBalances, FrozenBalance{Address: account.Address, Coins: sdk.NewCoins()})
+		}
+	}
+
+	tokens := make([]Token, len(oldGenState.TokenRecords))
+	for i, token := range oldGenState.TokenRecords {
+		tokens[i] = Token{
+			Owner:          token.Owner,
+			Name:           token.Name,
+			Symbol:         token.Symbol,
+			OriginalSymbol: token.OriginalSymbol,
+			TotalSupply:    sdk.NewCoins(sdk.NewCoin(token.Symbol, held.AmountOf(token.Symbol))),
+			Mintable:       token.Mintable,
+		}
+	}
+
+	return GenesisState{TokenRecords: tokens, FrozenBalances: frozenBalances}
+}
+
+// MigrateAppState migrates an exported v0 app state. Besides the assetmanagement section, the supply total is
+// recomputed from the accounts because v0 issued tokens without going through the supply module, and the crisis
+// section that v0 didn't have is added
+func MigrateAppState(appState genutil.AppMap) genutil.AppMap {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+
+	var accounts genaccounts.GenesisState
+	cdc.MustUnmarshalJSON(appState[genaccounts.ModuleName], &accounts)
+
+	if appState[v0.ModuleName] != nil {
+		var oldGenState v0.GenesisState
+		cdc.MustUnmarshalJSON(appState[v0.ModuleName], &oldGenState)
+
+		delete(appState, v0.ModuleName) // delete old key in case the name changed
+		appState[ModuleName] = cdc.MustMarshalJSON(Migrate(oldGenState, accounts))
+	}
+
+	total := sdk.NewCoins()
+	for _, account := range accounts {
+		total = total.Add(account.Coins)
+	}
+	appState[supply.ModuleName] = cdc.MustMarshalJSON(supply.NewGenesisState(total))
+
+	if appState[crisis.ModuleName] == nil {
+		appState[crisis.ModuleName] = cdc.MustMarshalJSON(crisis.DefaultGenesisState())
+	}
+
+	return appState
+}
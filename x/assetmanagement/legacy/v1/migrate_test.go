@@ -0,0 +1,94 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	app "github.com/dev10/fantom-asset-management"
+	"github.com/dev10/fantom-asset-management/x/assetmanagement"
+	v1 "github.com/dev10/fantom-asset-management/x/assetmanagement/legacy/v1"
+)
+
+func TestMigrateAppState(t *testing.T) {
+	cdc := app.MakeCodec()
+
+	genDoc, err := tmtypes.GenesisDocFromFile("testdata/v0_genesis.json")
+	require.NoError(t, err)
+
+	var appState genutil.AppMap
+	require.NoError(t, cdc.UnmarshalJSON(genDoc.AppState, &appState))
+	require.Nil(t, appState[crisis.ModuleName])
+
+	migrated := v1.MigrateAppState(appState)
+	require.NoError(t, app.ValidateGenesis(cdc, app.GenesisState(migrated)))
+
+	var assets assetmanagement.GenesisState
+	cdc.MustUnmarshalJSON(migrated[assetmanagement.ModuleName], &assets)
+	require.Len(t, assets.TokenRecords, 2)
+	// the v0 record was out of step with the 800 held across both accounts
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("abcxyz", 800)), assets.TokenRecords[0].TotalSupply)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nnfk9q", 50)), assets.TokenRecords[1].TotalSupply)
+	require.Len(t, assets.FrozenBalances, 2)
+	for _, balance := range assets.FrozenBalances {
+		require.True(t, balance.Coins.Empty())
+	}
+
+	var supplyGenesis supply.GenesisState
+	cdc.MustUnmarshalJSON(migrated[supply.ModuleName], &supplyGenesis)
+	require.Equal(t, sdk.NewInt(800), supplyGenesis.Supply.AmountOf("abcxyz"))
+	require.Equal(t, sdk.NewInt(100000000), supplyGenesis.Supply.AmountOf("stake"))
+
+	require.NotNil(t, migrated[crisis.ModuleName])
+}
+
+// everything the genesis gained since v1 is optional, so a v1 genesis is read as is and round-trips through the
+// current app without a later migration
+func TestV1GenesisIsCurrent(t *testing.T) {
+	cdc := app.MakeCodec()
+
+	genDoc, err := tmtypes.GenesisDocFromFile("testdata/v1_genesis.json")
+	require.NoError(t, err)
+	var appState app.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(genDoc.AppState, &appState))
+	require.NoError(t, app.ValidateGenesis(cdc, appState))
+
+	fam := app.NewFantomAssetManagementApp(log.NewNopLogger(), dbm.NewMemDB(), 0)
+	fam.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: genDoc.AppState})
+	fam.Commit()
+
+	exported, _, err := fam.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+	var exportedState app.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(exported, &exportedState))
+	var accounts genaccounts.GenesisState
+	cdc.MustUnmarshalJSON(exportedState[genaccounts.ModuleName], &accounts)
+	var assets assetmanagement.GenesisState
+	cdc.MustUnmarshalJSON(exportedState[assetmanagement.ModuleName], &assets)
+	require.NoError(t, assetmanagement.ValidateGenesis(assets))
+	require.NoError(t, assetmanagement.ValidateGenesisAccounts(assets, accounts))
+	require.Len(t, assets.TokenRecords, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("abcxyz", 800)), assets.TokenRecords[0].TotalSupply)
+	for _, token := range assets.TokenRecords {
+		require.False(t, token.IsDeprecated())
+		require.False(t, token.Disabled)
+		require.Zero(t, token.Timelock)
+	}
+	require.Len(t, assets.FrozenBalances, 2)
+	require.Equal(t, uint64(1), assets.NextCampaignID)
+	require.Equal(t, uint64(1), assets.NextVestingGrantID)
+	require.Equal(t, uint64(1), assets.NextClawbackID)
+	require.Equal(t, uint64(1), assets.NextActionID)
+	require.Equal(t, uint64(1), assets.NextQueuedActionID)
+	require.Empty(t, assets.Grants)
+}
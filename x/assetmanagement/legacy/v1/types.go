@@ -0,0 +1,33 @@
+// Package v1 holds the assetmanagement genesis types that carry frozen balances, and the migration from v0.
+// The current genesis reads a v1 genesis as is, everything it gained since v1 is optional
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName     = "assetmanagement"
+	FrozenPoolName = "frozen_tokens_pool"
+)
+
+type (
+	Token struct {
+		Owner          sdk.AccAddress `json:"owner"`
+		Name           string         `json:"name"`
+		Symbol         string         `json:"symbol"`
+		OriginalSymbol string         `json:"original_symbol"`
+		TotalSupply    sdk.Coins      `json:"total_supply"`
+		Mintable       bool           `json:"mintable"`
+	}
+
+	FrozenBalance struct {
+		Address sdk.AccAddress `json:"address"`
+		Coins   sdk.Coins      `json:"coins"`
+	}
+
+	GenesisState struct {
+		TokenRecords   []Token         `json:"token_records"`
+		FrozenBalances []FrozenBalance `json:"frozen_balances"`
+	}
+)
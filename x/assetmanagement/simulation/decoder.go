@@ -0,0 +1,123 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding assetmanagement type
+func DecodeStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.HasPrefix(kvA.Key, assetmanagement.CampaignKeyPrefix):
+		var campaignA, campaignB assetmanagement.ClaimCampaign
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &campaignA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &campaignB)
+		return fmt.Sprintf("%v\n%v", campaignA, campaignB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.VestingGrantKeyPrefix):
+		var grantA, grantB assetmanagement.VestingGrant
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &grantA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &grantB)
+		return fmt.Sprintf("%v\n%v", grantA, grantB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.EmissionKeyPrefix):
+		var emissionA, emissionB assetmanagement.EmissionSchedule
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &emissionA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &emissionB)
+		return fmt.Sprintf("%v\n%v", emissionA, emissionB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.AllowanceKeyPrefix):
+		var allowanceA, allowanceB assetmanagement.Allowance
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &allowanceA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &allowanceB)
+		return fmt.Sprintf("%v\n%v", allowanceA, allowanceB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.ClawbackKeyPrefix):
+		var recordA, recordB assetmanagement.ClawbackRecord
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.RedenominationKeyPrefix):
+		var recordA, recordB assetmanagement.Redenomination
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.ConversionKeyPrefix):
+		var windowA, windowB assetmanagement.ConversionWindow
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &windowA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &windowB)
+		return fmt.Sprintf("%v\n%v", windowA, windowB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.ConversionQueueKeyPrefix):
+		return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.ArchivedTokenKeyPrefix):
+		var archivedA, archivedB assetmanagement.ArchivedToken
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &archivedA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &archivedB)
+		return fmt.Sprintf("%v\n%v", archivedA, archivedB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.CouncilKeyPrefix):
+		var councilA, councilB assetmanagement.Council
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &councilA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &councilB)
+		return fmt.Sprintf("%v\n%v", councilA, councilB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.ActionKeyPrefix):
+		var actionA, actionB assetmanagement.PendingAction
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &actionA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &actionB)
+		return fmt.Sprintf("%v\n%v", actionA, actionB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.QueuedActionKeyPrefix):
+		var actionA, actionB assetmanagement.QueuedAction
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &actionA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &actionB)
+		return fmt.Sprintf("%v\n%v", actionA, actionB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.GrantKeyPrefix):
+		var grantA, grantB assetmanagement.Grant
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &grantA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &grantB)
+		return fmt.Sprintf("%v\n%v", grantA, grantB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.TransferFeeKeyPrefix):
+		var feeA, feeB assetmanagement.TransferFee
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &feeA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &feeB)
+		return fmt.Sprintf("%v\n%v", feeA, feeB)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.ClaimedKeyPrefix),
+		bytes.HasPrefix(kvA.Key, assetmanagement.TokenVestingKeyPrefix),
+		bytes.HasPrefix(kvA.Key, assetmanagement.BeneficiaryKeyPrefix),
+		bytes.HasPrefix(kvA.Key, assetmanagement.SpenderKeyPrefix),
+		bytes.HasPrefix(kvA.Key, assetmanagement.GranteeKeyPrefix),
+		bytes.HasPrefix(kvA.Key, assetmanagement.HolderKeyPrefix):
+		return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+	case bytes.HasPrefix(kvA.Key, assetmanagement.NextCampaignIDKey),
+		bytes.HasPrefix(kvA.Key, assetmanagement.CampaignQueueKeyPrefix),
+		bytes.HasPrefix(kvA.Key, assetmanagement.NextVestingGrantIDKey),
+		bytes.HasPrefix(kvA.Key, assetmanagement.NextClawbackIDKey),
+		bytes.HasPrefix(kvA.Key, assetmanagement.NextActionIDKey),
+		bytes.HasPrefix(kvA.Key, assetmanagement.ActionQueueKeyPrefix),
+		bytes.HasPrefix(kvA.Key, assetmanagement.NextQueuedActionIDKey),
+		bytes.HasPrefix(kvA.Key, assetmanagement.TimelockQueueKeyPrefix),
+		bytes.HasPrefix(kvA.Key, assetmanagement.HolderCountKeyPrefix):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+	default:
+		var tokenA, tokenB assetmanagement.Token
+		cdcA.MustUnmarshalBinaryBare(kvA.Value, &tokenA)
+		cdcB.MustUnmarshalBinaryBare(kvB.Value, &tokenB)
+		return fmt.Sprintf("%v\n%v", tokenA, tokenB)
+	}
+}
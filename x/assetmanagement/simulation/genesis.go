@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement"
+)
+
+// RandomizedGenState generates a random set of tokens for genesis. Each token's supply is credited to its
+// owner in the genesis accounts, and added to the supply module's total when one has been set
+func RandomizedGenState(cdc *codec.Codec, r *rand.Rand, accs []simulation.Account, genesisState map[string]json.RawMessage) {
+	var genesisAccounts genaccounts.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[genaccounts.ModuleName], &genesisAccounts)
+
+	var supplyGenesis supply.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[supply.ModuleName], &supplyGenesis)
+
+	numTokens := r.Intn(10)
+	symbols := make(map[string]bool)
+	tokens := make([]assetmanagement.Token, 0, numTokens)
+	for i := 0; i < numTokens; i++ {
+		originalSymbol := RandomOriginalSymbol(r)
+		symbol := RandomSymbol(r, originalSymbol)
+		if symbols[symbol] {
+			continue
+		}
+		symbols[symbol] = true
+
+		ownerIndex := r.Intn(len(genesisAccounts))
+		owner := genesisAccounts[ownerIndex].Address
+		token := assetmanagement.NewToken(simulation.RandStringOfLength(r, 10), symbol, originalSymbol,
+			1+r.Int63n(1e12), owner, r.Intn(2) == 0)
+		token.Clawbackable = r.Intn(2) == 0
+		token.TransferMode = RandomTransferMode(r)
+		if r.Intn(3) == 0 {
+			token.MaxBalancePerAccount, token.MaxHolders = RandomHoldingLimits(r)
+		}
+
+		genesisAccounts[ownerIndex].Coins = genesisAccounts[ownerIndex].Coins.Add(token.TotalSupply)
+		if !supplyGenesis.Supply.Empty() {
+			supplyGenesis.Supply = supplyGenesis.Supply.Add(token.TotalSupply)
+		}
+		tokens = append(tokens, *token)
+	}
+
+	assetGenesis := assetmanagement.NewGenesisState(tokens, []assetmanagement.FrozenBalance{})
+
+	fmt.Printf("Selected randomly generated assetmanagement tokens:\n%s\n", codec.MustMarshalJSONIndent(cdc, assetGenesis))
+	genesisState[genaccounts.ModuleName] = cdc.MustMarshalJSON(genesisAccounts)
+	genesisState[supply.ModuleName] = cdc.MustMarshalJSON(supplyGenesis)
+	genesisState[assetmanagement.ModuleName] = cdc.MustMarshalJSON(assetGenesis)
+}
@@ -0,0 +1,1220 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/dev10/fantom-asset-management/x/assetmanagement"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgIssueToken          = "op_weight_msg_issue_token"
+	OpWeightMsgMintCoins           = "op_weight_msg_mint_coins"
+	OpWeightMsgBurnCoins           = "op_weight_msg_burn_coins"
+	OpWeightMsgFreezeCoins         = "op_weight_msg_freeze_coins"
+	OpWeightMsgUnfreezeCoins       = "op_weight_msg_unfreeze_coins"
+	OpWeightMsgDistribute          = "op_weight_msg_distribute"
+	OpWeightMsgCreateClaimCampaign = "op_weight_msg_create_claim_campaign"
+	OpWeightMsgCreateVestingGrant  = "op_weight_msg_create_vesting_grant"
+	OpWeightMsgSetEmission         = "op_weight_msg_set_emission"
+	OpWeightMsgSetEmissionPaused   = "op_weight_msg_set_emission_paused"
+	OpWeightMsgApprove             = "op_weight_msg_approve"
+	OpWeightMsgClawback            = "op_weight_msg_clawback"
+	OpWeightMsgSetClawbackAdmin    = "op_weight_msg_set_clawback_admin"
+	OpWeightMsgSetTransferFee      = "op_weight_msg_set_transfer_fee"
+	OpWeightMsgSetFeeExemption     = "op_weight_msg_set_fee_exemption"
+	OpWeightMsgSetHoldingLimits    = "op_weight_msg_set_holding_limits"
+	OpWeightMsgRedenominate        = "op_weight_msg_redenominate"
+	OpWeightMsgOpenConversion      = "op_weight_msg_open_conversion"
+	OpWeightMsgConvert             = "op_weight_msg_convert"
+	OpWeightMsgSetTokenStatus      = "op_weight_msg_set_token_status"
+	OpWeightMsgSetCouncil          = "op_weight_msg_set_council"
+	OpWeightMsgProposeAction       = "op_weight_msg_propose_action"
+	OpWeightMsgApproveAction       = "op_weight_msg_approve_action"
+	OpWeightMsgRevokeApproval      = "op_weight_msg_revoke_approval"
+	OpWeightMsgSetTimelock         = "op_weight_msg_set_timelock"
+	OpWeightMsgCancelQueuedAction  = "op_weight_msg_cancel_queued_action"
+	OpWeightMsgGrant               = "op_weight_msg_grant"
+	OpWeightMsgRevokeGrant         = "op_weight_msg_revoke_grant"
+	OpWeightMsgExec                = "op_weight_msg_exec"
+	OpWeightMsgTransferOwnership   = "op_weight_msg_transfer_ownership"
+	OpWeightMsgSetTokenMetadata    = "op_weight_msg_set_token_metadata"
+	OpWeightMsgSetTokenPaused      = "op_weight_msg_set_token_paused"
+)
+
+// WeightedOperations returns all the operations of the assetmanagement module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, k assetmanagement.Keeper) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var v int
+		appParams.GetOrGenerate(cdc, key, &v, nil,
+			func(_ *rand.Rand) {
+				v = defaultWeight
+			})
+		return v
+	}
+
+	return simulation.WeightedOperations{
+		{Weight: weight(OpWeightMsgIssueToken, 20), Op: SimulateMsgIssueToken(k)},
+		{Weight: weight(OpWeightMsgMintCoins, 50), Op: SimulateMsgMintCoins(k)},
+		{Weight: weight(OpWeightMsgBurnCoins, 50), Op: SimulateMsgBurnCoins(k)},
+		{Weight: weight(OpWeightMsgFreezeCoins, 80), Op: SimulateMsgFreezeCoins(k)},
+		{Weight: weight(OpWeightMsgUnfreezeCoins, 80), Op: SimulateMsgUnfreezeCoins(k)},
+		{Weight: weight(OpWeightMsgDistribute, 50), Op: SimulateMsgDistribute(k)},
+		{Weight: weight(OpWeightMsgCreateClaimCampaign, 20), Op: SimulateMsgCreateClaimCampaign(k)},
+		{Weight: weight(OpWeightMsgCreateVestingGrant, 20), Op: SimulateMsgCreateVestingGrant(k)},
+		{Weight: weight(OpWeightMsgSetEmission, 10), Op: SimulateMsgSetEmission(k)},
+		{Weight: weight(OpWeightMsgSetEmissionPaused, 10), Op: SimulateMsgSetEmissionPaused(k)},
+		{Weight: weight(OpWeightMsgApprove, 30), Op: SimulateMsgApprove(k)},
+		{Weight: weight(OpWeightMsgClawback, 10), Op: SimulateMsgClawback(k)},
+		{Weight: weight(OpWeightMsgSetClawbackAdmin, 5), Op: SimulateMsgSetClawbackAdmin(k)},
+		{Weight: weight(OpWeightMsgSetTransferFee, 10), Op: SimulateMsgSetTransferFee(k)},
+		{Weight: weight(OpWeightMsgSetFeeExemption, 5), Op: SimulateMsgSetTransferFeeExemption(k)},
+		{Weight: weight(OpWeightMsgSetHoldingLimits, 5), Op: SimulateMsgSetHoldingLimits(k)},
+		{Weight: weight(OpWeightMsgRedenominate, 3), Op: SimulateMsgRedenominate(k)},
+		{Weight: weight(OpWeightMsgOpenConversion, 3), Op: SimulateMsgOpenConversion(k)},
+		{Weight: weight(OpWeightMsgConvert, 20), Op: SimulateMsgConvert(k)},
+		{Weight: weight(OpWeightMsgSetTokenStatus, 2), Op: SimulateMsgSetTokenStatus(k)},
+		{Weight: weight(OpWeightMsgSetCouncil, 2), Op: SimulateMsgSetCouncil(k)},
+		{Weight: weight(OpWeightMsgProposeAction, 5), Op: SimulateMsgProposeAction(k)},
+		{Weight: weight(OpWeightMsgApproveAction, 10), Op: SimulateMsgApproveAction(k)},
+		{Weight: weight(OpWeightMsgRevokeApproval, 2), Op: SimulateMsgRevokeApproval(k)},
+		{Weight: weight(OpWeightMsgSetTimelock, 3), Op: SimulateMsgSetTimelock(k)},
+		{Weight: weight(OpWeightMsgCancelQueuedAction, 2), Op: SimulateMsgCancelQueuedAction(k)},
+		{Weight: weight(OpWeightMsgGrant, 10), Op: SimulateMsgGrant(k)},
+		{Weight: weight(OpWeightMsgRevokeGrant, 3), Op: SimulateMsgRevokeGrant(k)},
+		{Weight: weight(OpWeightMsgExec, 20), Op: SimulateMsgExec(k)},
+		{Weight: weight(OpWeightMsgTransferOwnership, 2), Op: SimulateMsgTransferOwnership(k)},
+		{Weight: weight(OpWeightMsgSetTokenMetadata, 3), Op: SimulateMsgSetTokenMetadata(k)},
+		{Weight: weight(OpWeightMsgSetTokenPaused, 3), Op: SimulateMsgSetTokenPaused(k)},
+	}
+}
+
+// SimulateMsgIssueToken generates a MsgIssueToken with random values
+func SimulateMsgIssueToken(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		owner := simulation.RandomAcc(r, accs)
+		originalSymbol := RandomOriginalSymbol(r)
+		symbol := RandomSymbol(r, originalSymbol)
+		if k.IsSymbolPresent(ctx, symbol) {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgIssueToken(owner.Address, simulation.RandStringOfLength(r, 10), symbol,
+			originalSymbol, 1+r.Int63n(1e12), r.Intn(2) == 0)
+		msg.Clawbackable = r.Intn(2) == 0
+		msg.TransferMode = RandomTransferMode(r)
+		if r.Intn(3) == 0 {
+			msg.MaxBalancePerAccount, msg.MaxHolders = RandomHoldingLimits(r)
+		}
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgMintCoins generates a MsgMintCoins for a random mintable token
+func SimulateMsgMintCoins(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var mintable []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			if token.Mintable {
+				mintable = append(mintable, token)
+			}
+			return false
+		})
+		if len(mintable) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := mintable[r.Intn(len(mintable))]
+		msg := assetmanagement.NewMsgMintCoins(1+r.Int63n(1e9), token.Symbol, token.Owner)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgBurnCoins generates a MsgBurnCoins for part of the owner's balance of a random token
+func SimulateMsgBurnCoins(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		amount, ok := randomAmount(r, k.CoinKeeper.GetCoins(ctx, token.Owner).AmountOf(token.Symbol))
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgBurnCoins(amount, token.Symbol, token.Owner)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgFreezeCoins generates a MsgFreezeCoins for part of a random account's free token balance
+func SimulateMsgFreezeCoins(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		acc := simulation.RandomAcc(r, accs)
+		coin, ok := randomTokenCoin(r, ctx, k, k.CoinKeeper.GetCoins(ctx, acc.Address))
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		amount, ok := randomAmount(r, coin.Amount)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgFreezeCoins(amount, coin.Denom, acc.Address)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgUnfreezeCoins generates a MsgUnfreezeCoins for part of a random account's frozen balance
+func SimulateMsgUnfreezeCoins(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		acc := simulation.RandomAcc(r, accs)
+		coin, ok := randomTokenCoin(r, ctx, k, k.GetFrozenCoins(ctx, acc.Address))
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		amount, ok := randomAmount(r, coin.Amount)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgUnfreezeCoins(amount, coin.Denom, acc.Address)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgDistribute generates a MsgDistribute splitting part of a random account's free token balance
+// between a few random accounts
+func SimulateMsgDistribute(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		acc := simulation.RandomAcc(r, accs)
+		coin, ok := randomTokenCoin(r, ctx, k, k.CoinKeeper.GetCoins(ctx, acc.Address))
+		if !ok || coin.Amount.LT(sdk.NewInt(5)) {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		count := 1 + r.Intn(5)
+		share, ok := randomAmount(r, coin.Amount.QuoRaw(int64(count)))
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		recipients := make([]assetmanagement.Recipient, count)
+		for i := range recipients {
+			recipients[i] = assetmanagement.NewRecipient(simulation.RandomAcc(r, accs).Address, share)
+		}
+
+		msg := assetmanagement.NewMsgDistribute(acc.Address, coin.Denom, recipients)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgCreateClaimCampaign generates a MsgCreateClaimCampaign paying a few random accounts from part of the
+// owner's balance of a random token. Claims by the recipients are scheduled for the following blocks
+func SimulateMsgCreateClaimCampaign(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		count := 1 + r.Intn(5)
+		share, ok := randomAmount(r, k.CoinKeeper.GetCoins(ctx, token.Owner).AmountOf(token.Symbol).QuoRaw(int64(count)))
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		recipients := make([]sdk.AccAddress, count)
+		leaves := make([][]byte, count)
+		for i := range recipients {
+			recipients[i] = simulation.RandomAcc(r, accs).Address
+			leaves[i] = assetmanagement.ClaimLeaf(recipients[i], share)
+		}
+		root, proofs := assetmanagement.BuildClaimTree(leaves)
+
+		id := k.GetNextCampaignID(ctx)
+		endTime := ctx.BlockTime().Add(time.Duration(1+r.Intn(48)) * time.Hour)
+		msg := assetmanagement.NewMsgCreateClaimCampaign(token.Owner, token.Symbol, hex.EncodeToString(root),
+			share*int64(count), endTime)
+		opMsg, _, err := deliver(ctx, handler, msg)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := make([]simulation.FutureOperation, count)
+		for i := range recipients {
+			futureOps[i] = simulation.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(10),
+				Op:          SimulateMsgClaim(k, id, recipients[i], share, proofs[i]),
+			}
+		}
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgClaim generates a MsgClaim for a recipient of a campaign. It fails if the campaign has ended or the
+// recipient was listed twice and already claimed
+func SimulateMsgClaim(k assetmanagement.Keeper, id uint64, claimant sdk.AccAddress, amount int64,
+	proof [][]byte) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		hexProof := make([]string, len(proof))
+		for i, node := range proof {
+			hexProof[i] = hex.EncodeToString(node)
+		}
+
+		msg := assetmanagement.NewMsgClaim(claimant, id, amount, hexProof)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgCreateVestingGrant generates a MsgCreateVestingGrant locking part of the owner's balance of a random
+// token for a random account. Claims by the beneficiary are scheduled for later blocks, some before the cliff
+func SimulateMsgCreateVestingGrant(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		total, ok := randomAmount(r, k.CoinKeeper.GetCoins(ctx, token.Owner).AmountOf(token.Symbol))
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		beneficiary := simulation.RandomAcc(r, accs).Address
+		startTime := ctx.BlockTime().Add(time.Duration(r.Intn(24)) * time.Hour)
+		duration := time.Duration(1+r.Intn(72)) * time.Hour
+		cliffTime := startTime.Add(time.Duration(r.Int63n(int64(duration))))
+		endTime := startTime.Add(duration)
+
+		id := k.GetNextVestingGrantID(ctx)
+		msg := assetmanagement.NewMsgCreateVestingGrant(token.Owner, beneficiary, token.Symbol, total,
+			startTime, cliffTime, endTime)
+		opMsg, _, err := deliver(ctx, handler, msg)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := make([]simulation.FutureOperation, 1+r.Intn(3))
+		for i := range futureOps {
+			futureOps[i] = simulation.FutureOperation{
+				BlockTime: ctx.BlockTime().Add(time.Duration(r.Int63n(int64(endTime.Sub(ctx.BlockTime()) * 2)))),
+				Op:        SimulateMsgClaimVested(k, id, beneficiary),
+			}
+		}
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgClaimVested generates a MsgClaimVested for the beneficiary of a grant. It fails if nothing vested since
+// the last claim
+func SimulateMsgClaimVested(k assetmanagement.Keeper, id uint64, beneficiary sdk.AccAddress) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		msg := assetmanagement.NewMsgClaimVested(beneficiary, id)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetEmission generates a MsgSetEmission for a random mintable token, emitting to a random account for
+// a while with a random decay and cap
+func SimulateMsgSetEmission(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var mintable []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			if token.Mintable {
+				mintable = append(mintable, token)
+			}
+			return false
+		})
+		if len(mintable) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := mintable[r.Intn(len(mintable))]
+		startHeight := ctx.BlockHeight() + r.Int63n(10)
+		if startHeight < 1 {
+			startHeight = 1
+		}
+		endHeight := startHeight + r.Int63n(100)
+		var decayInterval int64
+		decayRate := sdk.ZeroDec()
+		if r.Intn(2) == 0 {
+			decayInterval = 1 + r.Int63n(20)
+			decayRate = sdk.NewDecWithPrec(1+r.Int63n(50), 2)
+		}
+		var maxSupply int64
+		if supply := token.TotalSupply.AmountOf(token.Symbol); r.Intn(2) == 0 && supply.IsInt64() &&
+			supply.Int64() < 1e15 {
+			maxSupply = supply.Int64() + 1 + r.Int63n(1e9)
+		}
+
+		msg := assetmanagement.NewMsgSetEmission(token.Owner, token.Symbol, simulation.RandomAcc(r, accs).Address,
+			1+r.Int63n(1e6), startHeight, endHeight, decayInterval, decayRate, maxSupply)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetEmissionPaused generates a MsgSetEmissionPaused pausing or resuming a random emission schedule
+func SimulateMsgSetEmissionPaused(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var emissions []assetmanagement.EmissionSchedule
+		k.IterateEmissions(ctx, func(emission assetmanagement.EmissionSchedule) bool {
+			emissions = append(emissions, emission)
+			return false
+		})
+		if len(emissions) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		emission := emissions[r.Intn(len(emissions))]
+		owner, err := k.GetOwner(ctx, emission.Symbol)
+		if err != nil {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgSetEmissionPaused(owner, emission.Symbol, !emission.Paused)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgApprove generates a MsgApprove letting a random account spend part of another account's balance of a
+// random token, sometimes only for a short while. Transfers by the spender are scheduled for the following blocks,
+// some of them for more than is left of the allowance
+func SimulateMsgApprove(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		owner := simulation.RandomAcc(r, accs)
+		spender := simulation.RandomAcc(r, accs)
+		coin, ok := randomTokenCoin(r, ctx, k, k.CoinKeeper.GetCoins(ctx, owner.Address))
+		if !ok || owner.Address.Equals(spender.Address) {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		amount, ok := randomAmount(r, coin.Amount)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		var expiry time.Time
+		if r.Intn(2) == 0 {
+			expiry = ctx.BlockTime().Add(time.Duration(1+r.Intn(24)) * time.Hour)
+		}
+		msg := assetmanagement.NewMsgApprove(owner.Address, spender.Address, coin.Denom, amount, expiry)
+		opMsg, _, err := deliver(ctx, handler, msg)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := make([]simulation.FutureOperation, 1+r.Intn(3))
+		for i := range futureOps {
+			futureOps[i] = simulation.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(10),
+				Op: SimulateMsgTransferFrom(k, spender.Address, owner.Address, simulation.RandomAcc(r, accs).Address,
+					coin.Denom, 1+r.Int63n(amount)),
+			}
+		}
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgTransferFrom generates a MsgTransferFrom out of an allowance. It fails if the allowance expired, was
+// used up or the owner no longer holds enough free coins
+func SimulateMsgTransferFrom(k assetmanagement.Keeper, spender, from, to sdk.AccAddress, symbol string,
+	amount int64) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		msg := assetmanagement.NewMsgTransferFrom(spender, from, to, symbol, amount)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback taking part of a random account's free and frozen balance of a random
+// clawbackable token, by its owner or clawback admin. The coins go to the owner or are burned
+func SimulateMsgClawback(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		token, ok := randomClawbackableToken(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		holder := simulation.RandomAcc(r, accs).Address
+		balance := k.CoinKeeper.GetCoins(ctx, holder).AmountOf(token.Symbol).
+			Add(k.GetFrozenCoins(ctx, holder).AmountOf(token.Symbol))
+		amount, ok := randomAmount(r, balance)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		authority := token.Owner
+		if !token.ClawbackAdmin.Empty() && r.Intn(2) == 0 {
+			authority = token.ClawbackAdmin
+		}
+		var recipient sdk.AccAddress
+		if r.Intn(2) == 0 && !holder.Equals(token.Owner) {
+			recipient = token.Owner
+		}
+
+		msg := assetmanagement.NewMsgClawback(authority, token.Symbol, holder, recipient, amount,
+			simulation.RandStringOfLength(r, 1+r.Intn(assetmanagement.MaxClawbackReasonLength)))
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetClawbackAdmin generates a MsgSetClawbackAdmin handing the clawback role of a random clawbackable
+// token to a random account, or taking it back
+func SimulateMsgSetClawbackAdmin(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		token, ok := randomClawbackableToken(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		var admin sdk.AccAddress
+		if r.Intn(3) != 0 {
+			admin = simulation.RandomAcc(r, accs).Address
+		}
+
+		msg := assetmanagement.NewMsgSetClawbackAdmin(token.Owner, token.Symbol, admin)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetTransferFee generates a MsgSetTransferFee charging a random fee, sometimes none at all, on the
+// transfers of a random token
+func SimulateMsgSetTransferFee(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		var rate uint64
+		var minFee, maxFee int64
+		if r.Intn(4) != 0 {
+			rate = uint64(r.Int63n(500))
+			minFee = r.Int63n(10)
+			if r.Intn(2) == 0 {
+				maxFee = minFee + r.Int63n(1e6)
+			}
+		}
+
+		msg := assetmanagement.NewMsgSetTransferFee(token.Owner, token.Symbol, rate, minFee, maxFee,
+			simulation.RandomAcc(r, accs).Address)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetTransferFeeExemption generates a MsgSetTransferFeeExemption exempting a random account from the
+// transfer fee of a random token, or ending its exemption
+func SimulateMsgSetTransferFeeExemption(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var fees []assetmanagement.TransferFee
+		k.IterateTransferFees(ctx, func(fee assetmanagement.TransferFee) bool {
+			fees = append(fees, fee)
+			return false
+		})
+		if len(fees) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		fee := fees[r.Intn(len(fees))]
+		owner, err := k.GetOwner(ctx, fee.Symbol)
+		if err != nil {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgSetTransferFeeExemption(owner, fee.Symbol, simulation.RandomAcc(r, accs).Address,
+			r.Intn(3) != 0)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetHoldingLimits generates a MsgSetHoldingLimits changing or removing the holding limits of a random
+// token
+func SimulateMsgSetHoldingLimits(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		var maxBalance int64
+		var maxHolders uint64
+		if r.Intn(4) != 0 {
+			maxBalance, maxHolders = RandomHoldingLimits(r)
+		}
+		msg := assetmanagement.NewMsgSetHoldingLimits(token.Owner, token.Symbol, maxBalance, maxHolders)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgRedenominate generates a MsgRedenominate splitting or merging the coins of a random paused token by a
+// small ratio
+func SimulateMsgRedenominate(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			if token.Paused {
+				tokens = append(tokens, token)
+			}
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		numerator, denominator := uint64(1+r.Intn(10)), uint64(1+r.Intn(10))
+		if numerator == denominator {
+			denominator++
+		}
+		msg := assetmanagement.NewMsgRedenominate(token.Owner, token.Symbol, numerator, denominator)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgOpenConversion generates a MsgOpenConversion from a random token to another mintable token of the same
+// owner, open for a few hours
+func SimulateMsgOpenConversion(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			if !token.Disabled {
+				tokens = append(tokens, token)
+			}
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		source := tokens[r.Intn(len(tokens))]
+		var targets []assetmanagement.Token
+		for _, token := range tokens {
+			if token.Mintable && token.Symbol != source.Symbol && token.Owner.Equals(source.Owner) {
+				targets = append(targets, token)
+			}
+		}
+		if len(targets) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		target := targets[r.Intn(len(targets))]
+		deadline := ctx.BlockTime().Add(time.Duration(1+r.Intn(12)) * time.Hour)
+		msg := assetmanagement.NewMsgOpenConversion(source.Owner, source.Symbol, target.Symbol,
+			uint64(1+r.Intn(10)), uint64(1+r.Intn(10)), deadline)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgConvert generates a MsgConvert for part of the free balance of a random holder of the source token of a
+// random open conversion window
+func SimulateMsgConvert(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var windows []assetmanagement.ConversionWindow
+		k.IterateConversionWindows(ctx, func(window assetmanagement.ConversionWindow) bool {
+			if window.IsOpen(ctx.BlockTime()) {
+				windows = append(windows, window)
+			}
+			return false
+		})
+		if len(windows) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		window := windows[r.Intn(len(windows))]
+		var holders []sdk.AccAddress
+		k.IterateHolders(ctx, window.Source, func(address sdk.AccAddress) bool {
+			holders = append(holders, address)
+			return false
+		})
+		if len(holders) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		holder := holders[r.Intn(len(holders))]
+		amount, ok := randomAmount(r, k.CoinKeeper.GetCoins(ctx, holder).AmountOf(window.Source))
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgConvert(holder, window.Source, amount)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetTokenStatus generates a MsgSetTokenStatus retiring a random token once its supply is all burnt, or
+// deprecating it before that
+func SimulateMsgSetTokenStatus(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		status := assetmanagement.TokenStatusDeprecated
+		if token.TotalSupply.AmountOf(token.Symbol).IsZero() {
+			status = assetmanagement.TokenStatusRetired
+		}
+		msg := assetmanagement.NewMsgSetTokenStatus(token.Owner, token.Symbol, status)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetCouncil generates a MsgSetCouncil handing a random token that has no council yet over to a few
+// random accounts
+func SimulateMsgSetCouncil(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			if _, err := k.GetCouncil(ctx, token.Symbol); err != nil {
+				tokens = append(tokens, token)
+			}
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		size := 1 + r.Intn(5)
+		if size > len(accs) {
+			size = len(accs)
+		}
+		var members []sdk.AccAddress
+		for _, i := range r.Perm(len(accs))[:size] {
+			members = append(members, accs[i].Address)
+		}
+		threshold := uint64(1 + r.Intn(len(members)))
+		msg := assetmanagement.NewMsgSetCouncil(token.Owner, token.Symbol, members, threshold)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgProposeAction generates a MsgProposeAction of a random council member minting, limiting or deprecating
+// the council's token
+func SimulateMsgProposeAction(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var councils []assetmanagement.Council
+		k.IterateCouncils(ctx, func(council assetmanagement.Council) bool {
+			councils = append(councils, council)
+			return false
+		})
+		if len(councils) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		council := councils[r.Intn(len(councils))]
+		var action sdk.Msg
+		switch r.Intn(3) {
+		case 0:
+			action = assetmanagement.NewMsgMintCoins(1+r.Int63n(1e9), council.Symbol, council.Address())
+		case 1:
+			maxBalance, maxHolders := RandomHoldingLimits(r)
+			action = assetmanagement.NewMsgSetHoldingLimits(council.Address(), council.Symbol, maxBalance, maxHolders)
+		default:
+			action = assetmanagement.NewMsgSetTokenStatus(council.Address(), council.Symbol,
+				assetmanagement.TokenStatusDeprecated)
+		}
+		proposer := council.Members[r.Intn(len(council.Members))]
+		expiry := ctx.BlockTime().Add(time.Duration(1+r.Intn(48)) * time.Hour)
+		msg := assetmanagement.NewMsgProposeAction(proposer, council.Symbol, action, expiry)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgApproveAction generates a MsgApproveAction of a council member who hasn't approved a random pending
+// action yet
+func SimulateMsgApproveAction(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		action, ok := randomPendingAction(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		council, err := k.GetCouncil(ctx, action.Symbol)
+		if err != nil {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		var members []sdk.AccAddress
+		for _, member := range council.Members {
+			if !action.HasApproved(member) {
+				members = append(members, member)
+			}
+		}
+		if len(members) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgApproveAction(members[r.Intn(len(members))], action.ID)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgRevokeApproval generates a MsgRevokeApproval of a random approval of a random pending action
+func SimulateMsgRevokeApproval(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		action, ok := randomPendingAction(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgRevokeApproval(action.Approvals[r.Intn(len(action.Approvals))], action.ID)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetTimelock generates a MsgSetTimelock delaying the rule changes of a random token by up to two days, or
+// turning its timelock off
+func SimulateMsgSetTimelock(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		delay := time.Duration(r.Intn(49)) * time.Hour
+		msg := assetmanagement.NewMsgSetTimelock(token.Owner, token.Symbol, delay)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgCancelQueuedAction generates a MsgCancelQueuedAction of the owner who queued a random timelocked action
+func SimulateMsgCancelQueuedAction(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var actions []assetmanagement.QueuedAction
+		k.IterateQueuedActions(ctx, func(action assetmanagement.QueuedAction) bool {
+			actions = append(actions, action)
+			return false
+		})
+		if len(actions) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		action := actions[r.Intn(len(actions))]
+		msg := assetmanagement.NewMsgCancelQueuedAction(action.Owner, action.ID)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// simulatedGrantTypes are the message types the simulation issues grants for, SimulateMsgExec knows how to build them
+var simulatedGrantTypes = []string{
+	assetmanagement.MsgMintCoins{}.Type(),
+	assetmanagement.MsgBurnCoins{}.Type(),
+	assetmanagement.MsgFreezeCoins{}.Type(),
+	assetmanagement.MsgUnfreezeCoins{}.Type(),
+	assetmanagement.MsgSetEmissionPaused{}.Type(),
+}
+
+// SimulateMsgTransferOwnership generates a MsgTransferOwnership handing a random token over to a random account
+func SimulateMsgTransferOwnership(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		newOwner := simulation.RandomAcc(r, accs)
+		msg := assetmanagement.NewMsgTransferOwnership(token.Owner, token.Symbol, newOwner.Address)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetTokenMetadata generates a MsgSetTokenMetadata renaming a random token
+func SimulateMsgSetTokenMetadata(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		msg := assetmanagement.NewMsgSetTokenMetadata(token.Owner, token.Symbol, simulation.RandStringOfLength(r, 10),
+			RandomOriginalSymbol(r))
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSetTokenPaused generates a MsgSetTokenPaused pausing a random token, or resuming it if it is paused
+func SimulateMsgSetTokenPaused(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		msg := assetmanagement.NewMsgSetTokenPaused(token.Owner, token.Symbol, !token.Paused)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgGrant generates a MsgGrant of the owner of a random token letting a random account sign one of its
+// messages over the token, with a random spend limit and expiry
+func SimulateMsgGrant(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var tokens []assetmanagement.Token
+		k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+			tokens = append(tokens, token)
+			return false
+		})
+		if len(tokens) == 0 {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		token := tokens[r.Intn(len(tokens))]
+		grantee := simulation.RandomAcc(r, accs)
+		if grantee.Address.Equals(token.Owner) {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+		msgType := simulatedGrantTypes[r.Intn(len(simulatedGrantTypes))]
+		var spendLimit int64
+		if msgType != (assetmanagement.MsgSetEmissionPaused{}).Type() && r.Intn(2) == 0 {
+			spendLimit = 1 + r.Int63n(1e10)
+		}
+		var expiry time.Time
+		if r.Intn(2) == 0 {
+			expiry = ctx.BlockTime().Add(time.Duration(1+r.Intn(48)) * time.Hour)
+		}
+
+		msg := assetmanagement.NewMsgGrant(token.Owner, grantee.Address, token.Symbol, msgType, spendLimit, expiry)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgRevokeGrant generates a MsgRevokeGrant of a random grant
+func SimulateMsgRevokeGrant(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		grant, ok := randomGrant(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgRevokeGrant(grant.Granter, grant.Grantee, grant.Symbol, grant.MsgType)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgExec generates a MsgExec of the grantee of a random grant running a message of its granter within the
+// spend limit of the grant
+func SimulateMsgExec(k assetmanagement.Keeper) simulation.Operation {
+	handler := assetmanagement.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		grant, ok := randomGrant(r, ctx, k)
+		if !ok || grant.IsExpired(ctx.BlockTime()) {
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		// what the granter could move, capped by what the grant lets the grantee spend
+		limit := func(max sdk.Int) sdk.Int {
+			if grant.IsLimited() && grant.SpendLimit.LT(max) {
+				return grant.SpendLimit
+			}
+			return max
+		}
+		var inner sdk.Msg
+		switch grant.MsgType {
+		case assetmanagement.MsgMintCoins{}.Type():
+			amount, ok := randomAmount(r, limit(sdk.NewInt(1e9)))
+			if !ok {
+				return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+			}
+			inner = assetmanagement.NewMsgMintCoins(amount, grant.Symbol, grant.Granter)
+		case assetmanagement.MsgBurnCoins{}.Type(), assetmanagement.MsgFreezeCoins{}.Type():
+			amount, ok := randomAmount(r, limit(k.CoinKeeper.GetCoins(ctx, grant.Granter).AmountOf(grant.Symbol)))
+			if !ok {
+				return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+			}
+			if grant.MsgType == (assetmanagement.MsgBurnCoins{}).Type() {
+				inner = assetmanagement.NewMsgBurnCoins(amount, grant.Symbol, grant.Granter)
+			} else {
+				inner = assetmanagement.NewMsgFreezeCoins(amount, grant.Symbol, grant.Granter)
+			}
+		case assetmanagement.MsgUnfreezeCoins{}.Type():
+			amount, ok := randomAmount(r, limit(k.GetFrozenCoins(ctx, grant.Granter).AmountOf(grant.Symbol)))
+			if !ok {
+				return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+			}
+			inner = assetmanagement.NewMsgUnfreezeCoins(amount, grant.Symbol, grant.Granter)
+		case assetmanagement.MsgSetEmissionPaused{}.Type():
+			inner = assetmanagement.NewMsgSetEmissionPaused(grant.Granter, grant.Symbol, r.Intn(2) == 0)
+		default:
+			return simulation.NoOpMsg(assetmanagement.ModuleName), nil, nil
+		}
+
+		msg := assetmanagement.NewMsgExec(grant.Grantee, []sdk.Msg{inner})
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// RandomHoldingLimits returns a random balance limit and holder limit, either may be off
+func RandomHoldingLimits(r *rand.Rand) (int64, uint64) {
+	var maxBalance int64
+	var maxHolders uint64
+	if r.Intn(2) == 0 {
+		maxBalance = 1 + r.Int63n(1e10)
+	}
+	if r.Intn(2) == 0 {
+		maxHolders = 1 + uint64(r.Intn(20))
+	}
+	return maxBalance, maxHolders
+}
+
+// RandomOriginalSymbol returns a random upper case symbol, eg ABC
+func RandomOriginalSymbol(r *rand.Rand) string {
+	return strings.ToUpper(simulation.RandStringOfLength(r, 3))
+}
+
+// RandomSymbol returns a unique looking symbol for an original symbol the same way the clients do,
+// but drawing from the simulation's source of randomness
+func RandomSymbol(r *rand.Rand, originalSymbol string) string {
+	return strings.ToLower(originalSymbol + simulation.RandStringOfLength(r, 3))
+}
+
+// RandomTransferMode returns a random transfer mode, most tokens move freely so that the other operations have
+// something to work with
+func RandomTransferMode(r *rand.Rand) assetmanagement.TransferMode {
+	switch r.Intn(6) {
+	case 0:
+		return assetmanagement.TransferModeNonTransferable
+	case 1:
+		return assetmanagement.TransferModeOwnerOnly
+	default:
+		return assetmanagement.TransferModeFree
+	}
+}
+
+// deliver runs the message against a cached context that is only written when the handler succeeds
+func deliver(ctx sdk.Context, handler sdk.Handler, msg sdk.Msg) (
+	simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+	if err := msg.ValidateBasic(); err != nil {
+		return simulation.NoOpMsg(assetmanagement.ModuleName), nil, err
+	}
+
+	ctx, write := ctx.CacheContext()
+	ok := handler(ctx, msg).IsOK()
+	if ok {
+		write()
+	}
+
+	return simulation.NewOperationMsg(msg, ok, ""), nil, nil
+}
+
+// randomClawbackableToken picks one of the tokens issued with clawback enabled
+func randomClawbackableToken(r *rand.Rand, ctx sdk.Context, k assetmanagement.Keeper) (assetmanagement.Token, bool) {
+	var tokens []assetmanagement.Token
+	k.IterateTokens(ctx, func(token assetmanagement.Token) bool {
+		if token.Clawbackable {
+			tokens = append(tokens, token)
+		}
+		return false
+	})
+	if len(tokens) == 0 {
+		return assetmanagement.Token{}, false
+	}
+	return tokens[r.Intn(len(tokens))], true
+}
+
+// randomTokenCoin picks one of the given coins that was issued by the module
+func randomTokenCoin(r *rand.Rand, ctx sdk.Context, k assetmanagement.Keeper, coins sdk.Coins) (sdk.Coin, bool) {
+	var tokens sdk.Coins
+	for _, coin := range coins {
+		if k.IsSymbolPresent(ctx, coin.Denom) {
+			tokens = append(tokens, coin)
+		}
+	}
+	if len(tokens) == 0 {
+		return sdk.Coin{}, false
+	}
+	return tokens[r.Intn(len(tokens))], true
+}
+
+// randomPendingAction picks one of the actions waiting for the approval of a council
+func randomPendingAction(r *rand.Rand, ctx sdk.Context, k assetmanagement.Keeper) (assetmanagement.PendingAction, bool) {
+	var actions []assetmanagement.PendingAction
+	k.IteratePendingActions(ctx, func(action assetmanagement.PendingAction) bool {
+		actions = append(actions, action)
+		return false
+	})
+	if len(actions) == 0 {
+		return assetmanagement.PendingAction{}, false
+	}
+	return actions[r.Intn(len(actions))], true
+}
+
+func randomGrant(r *rand.Rand, ctx sdk.Context, k assetmanagement.Keeper) (assetmanagement.Grant, bool) {
+	var grants []assetmanagement.Grant
+	k.IterateGrants(ctx, func(grant assetmanagement.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	if len(grants) == 0 {
+		return assetmanagement.Grant{}, false
+	}
+	return grants[r.Intn(len(grants))], true
+}
+
+// randomAmount picks a positive int64 amount no larger than max
+func randomAmount(r *rand.Rand, max sdk.Int) (int64, bool) {
+	if !max.IsPositive() {
+		return 0, false
+	}
+	if !max.IsInt64() {
+		return 1 + r.Int63n(1e12), true
+	}
+	return 1 + r.Int63n(max.Int64()), true
+}